@@ -519,8 +519,9 @@ func (*s3bp) HeadObj(_ context.Context, lom *core.LOM, oreq *http.Request) (oa *
 		return nil, 0, err
 	}
 	headOutput, err = svc.HeadObject(context.Background(), &s3.HeadObjectInput{
-		Bucket: aws.String(cloudBck.Name),
-		Key:    aws.String(lom.ObjName),
+		Bucket:       aws.String(cloudBck.Name),
+		Key:          aws.String(lom.ObjName),
+		ChecksumMode: types.ChecksumModeEnabled,
 	})
 	if err != nil {
 		ecode, err = awsErrorToAISError(err, cloudBck, lom.ObjName)
@@ -541,6 +542,9 @@ func (*s3bp) HeadObj(_ context.Context, lom *core.LOM, oreq *http.Request) (oa *
 	if v, ok := h.EncodeCksum(headOutput.ETag); ok {
 		oa.SetCustomKey(cmn.MD5ObjMD, v)
 	}
+	if cksum := _crc64(headOutput.ChecksumCRC64NVME); cksum != nil {
+		oa.SetCustomKey(cmn.CRC64ObjMD, cksum.Val())
+	}
 
 	// AIS custom (see also: PutObject, GetObjReader)
 	if cksumType, ok := headOutput.Metadata[cos.S3MetadataChecksumType]; ok {
@@ -636,6 +640,7 @@ func (*s3bp) GetObjReader(ctx context.Context, lom *core.LOM, offset, length int
 			return res
 		}
 	} else {
+		input.ChecksumMode = types.ChecksumModeEnabled
 		obj, err = svc.GetObject(ctx, &input)
 		if err != nil {
 			res.ErrCode, res.Err = awsErrorToAISError(err, cloudBck, lom.ObjName)
@@ -645,6 +650,10 @@ func (*s3bp) GetObjReader(ctx context.Context, lom *core.LOM, offset, length int
 		lom.SetCustomKey(cmn.SourceObjMD, apc.AWS)
 
 		res.ExpCksum = _getCustom(lom, obj)
+		if cksum := _crc64(obj.ChecksumCRC64NVME); cksum != nil {
+			lom.SetCustomKey(cmn.CRC64ObjMD, cksum.Val())
+			res.ExpCksum = cksum // precedence over md5 (ditto)
+		}
 
 		md := obj.Metadata
 		if cksumType, ok := md[cos.S3MetadataChecksumType]; ok {
@@ -686,6 +695,19 @@ func _getCustom(lom *core.LOM, obj *s3.GetObjectOutput) (md5 *cos.Cksum) {
 	return md5
 }
 
+// full-object CRC64NVME (always the case with S3), if available
+func _crc64(v *string) *cos.Cksum {
+	if v == nil || *v == "" {
+		return nil
+	}
+	cksum, err := aiss3.DecodeCksum(cos.ChecksumCRC64NVME, *v)
+	if err != nil {
+		nlog.Warningln(err)
+		return nil
+	}
+	return cksum
+}
+
 //
 // PUT OBJECT
 //
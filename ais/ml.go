@@ -41,7 +41,9 @@ import (
 //   DT: Assemble() → Use pre-existing basewi state
 // -----------------------------------------------------------------------
 
-// TODO: add support for apc.ColocTwo
+// Colocation (apc.QparamColoc and/or apc.MossReq.Colocation):
+// - ColocOne: proxy selects DT that has most of the requested entries
+// - ColocTwo: ColocOne + targets index and cache hot shards (see xact/xs/moss_shards.go)
 
 func (p *proxy) mlHandler(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
@@ -596,6 +598,9 @@ func (t *target) mossparse(w http.ResponseWriter, r *http.Request, ctx *mossCtx)
 		t.writeErr(w, r, err)
 		return err
 	}
+	if coloc := apc.ColocLevel(dpq.coloc); coloc > ctx.req.Colocation && coloc <= apc.ColocTwo {
+		ctx.req.Colocation = coloc // (via query param)
+	}
 	if ctx.req.OutputFormat == "" {
 		ctx.req.OutputFormat = archive.ExtTar // default
 	} else {
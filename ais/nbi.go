@@ -7,6 +7,7 @@ package ais
 import (
 	"maps"
 	"net/http"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -15,10 +16,17 @@ import (
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/xact/xs"
 
 	jsoniter "github.com/json-iterator/go"
 )
 
+const (
+	nbiIval   = 2 * time.Minute
+	nbiHkName = "nbi-resync" + hk.NameSuffix
+)
+
 //
 // proxy --------------------------------------------
 //
@@ -46,12 +54,21 @@ func (p *proxy) bgetNBI(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryB
 		}
 	}
 
-	// bcast
+	merged, err := p.collectNBI(r.URL.Path, bck, msg)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	p.writeJSON(w, r, merged, msg.Action)
+}
+
+// bcast apc.ActShowNBI and merge per-target results
+func (p *proxy) collectNBI(path string, bck *meta.Bck, msg *apc.ActMsg) (apc.NBIInfoMap, error) {
 	args := allocBcArgs()
 	amsg := p.newAmsg(msg, nil /*bmd*/)
 	args.req = cmn.HreqArgs{
 		Method: http.MethodGet,
-		Path:   r.URL.Path,
+		Path:   path,
 		Body:   cos.MustMarshal(amsg),
 		Header: http.Header{cos.HdrContentType: []string{cos.ContentJSON}},
 	}
@@ -59,21 +76,18 @@ func (p *proxy) bgetNBI(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryB
 	results := p.bcastGroup(args)
 	freeBcArgs(args)
 
-	// merge results
 	merged := make(apc.NBIInfoMap)
 	for _, res := range results {
 		if res.err != nil {
 			err := res.toErr()
 			freeBcastRes(results)
-			p.writeErr(w, r, err)
-			return
+			return nil, err
 		}
 
 		var infos apc.NBIInfoMap
 		if err := jsoniter.Unmarshal(res.bytes, &infos); err != nil {
 			freeBcastRes(results)
-			p.writeErr(w, r, err)
-			return
+			return nil, err
 		}
 		for k, info := range infos {
 			existing, ok := merged[k]
@@ -84,6 +98,7 @@ func (p *proxy) bgetNBI(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryB
 			existing.Size += info.Size
 			existing.Ntotal += info.Ntotal
 			existing.Chunks += info.Chunks
+			existing.Changes += info.Changes
 
 			if info.Started != 0 && (existing.Started == 0 || info.Started < existing.Started) {
 				existing.Started = info.Started
@@ -96,7 +111,93 @@ func (p *proxy) bgetNBI(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryB
 	}
 	freeBcastRes(results)
 
-	p.writeJSON(w, r, merged, msg.Action)
+	// mark the latest inventory of each bucket
+	for _, infos := range groupNBI(merged) {
+		infos.Latest().Latest = true
+	}
+	return merged, nil
+}
+
+func groupNBI(merged apc.NBIInfoMap) map[string]apc.NBIInfoMap {
+	out := make(map[string]apc.NBIInfoMap, 4)
+	for k, info := range merged {
+		infos, ok := out[info.Bucket]
+		if !ok {
+			infos = make(apc.NBIInfoMap, 2)
+			out[info.Bucket] = infos
+		}
+		infos[k] = info
+	}
+	return out
+}
+
+func (p *proxy) nbiInit() {
+	hk.Reg(nbiHkName, p.nbiHousekeep, nbiIval)
+}
+
+// primary only: re-create (re-sync) scheduled inventories that are due (see apc.NBISchedule)
+func (p *proxy) nbiHousekeep(int64) time.Duration {
+	smap := p.owner.smap.get()
+	if !p.ClusterStarted() || !smap.IsPrimary(p.si) {
+		return nbiIval
+	}
+	bmd := p.owner.bmd.get()
+	if _, present := bmd.Get(meta.SysBckNBI()); !present {
+		return nbiIval // no inventories
+	}
+	merged, err := p.collectNBI(apc.URLPathBuckets.S, meta.CloneBck(&cmn.Bck{}), &apc.ActMsg{Action: apc.ActShowNBI})
+	if err != nil {
+		nlog.Warningln(p.String(), "failed to collect inventories:", err)
+		return nbiIval
+	}
+	var (
+		now = time.Now().UnixNano()
+		onl = true
+	)
+	for cname, infos := range groupNBI(merged) {
+		latest := infos.Latest()
+		if !latest.Schedule.IsSet() || !latest.Schedule.IsDue(latest, now) {
+			continue
+		}
+		b, _, err := cmn.ParseBckObjectURI(cname, cmn.ParseURIOpts{})
+		if err != nil {
+			nlog.Errorln(p.String(), "resync inventory:", err)
+			continue
+		}
+		bck := meta.CloneBck(&b)
+		if err := bck.Init(p.owner.bmd); err != nil {
+			nlog.Warningln(p.String(), "resync inventory:", err) // e.g., bucket destroyed in the meantime
+			continue
+		}
+		// still running (previous re-sync or user-initiated)
+		if p.notifs.find(nlFilter{Kind: apc.ActCreateNBI, Bck: bck, OnlyRunning: &onl}) != nil {
+			continue
+		}
+		go p.resyncNBI(bck, latest)
+	}
+	return nbiIval
+}
+
+func (p *proxy) resyncNBI(bck *meta.Bck, latest *apc.NBIInfo) {
+	cimsg := &apc.CreateNBIMsg{
+		Name:          apc.NBISeriesName(latest.Series, time.Now()),
+		NamesPerChunk: latest.NamesPerChunk,
+		Schedule:      latest.Schedule,
+		Keep:          int(latest.Keep),
+		Series:        latest.Series,
+		Incremental:   latest.Schedule.Incremental,
+	}
+	cimsg.Prefix = latest.Prefix
+	cimsg.Props = latest.Props
+	cimsg.Flags = latest.Flags
+	msg := &apc.ActMsg{Action: apc.ActCreateNBI, Value: cimsg}
+	xid, err := p.createNBI(msg, bck)
+	if err != nil {
+		nlog.Warningln(p.String(), "resync", bck.Cname(""), "inventory", latest.Series+":", err)
+		return
+	}
+	nlog.Infoln(p.String(), "resync", bck.Cname(""), "inventory", latest.Series, "=>", cimsg.Name, "xid", xid,
+		"(changes:", latest.Changes, "since", time.Unix(0, latest.Finished).Format(time.RFC3339)+")")
 }
 
 // currently, create/destroy inventory requires admin perm
@@ -138,6 +239,7 @@ func (t *target) bgetNBI(w http.ResponseWriter, r *http.Request, qbck *cmn.Query
 			t.writeErr(w, r, err)
 			return
 		}
+		setNBIChanges(info, bck)
 		if msg.Name == "" {
 			t.writeJSON(w, r, info, msg.Action)
 			return
@@ -175,6 +277,7 @@ func (t *target) bgetNBI(w http.ResponseWriter, r *http.Request, qbck *cmn.Query
 		if err != nil || len(info) == 0 {
 			return false
 		}
+		setNBIChanges(info, bck)
 		if merged == nil {
 			merged = make(apc.NBIInfoMap)
 		}
@@ -197,6 +300,13 @@ func (t *target) bgetNBI(w http.ResponseWriter, r *http.Request, qbck *cmn.Query
 	t.writeJSON(w, r, merged, msg.Action)
 }
 
+func setNBIChanges(infos apc.NBIInfoMap, bck *meta.Bck) {
+	changes := xs.NBIChanges(bck)
+	for _, info := range infos {
+		info.Changes = changes
+	}
+}
+
 func (t *target) destroyNBI(w http.ResponseWriter, r *http.Request, bck *meta.Bck, msg *actMsgExt) {
 	nlp := newBckNLP(bck)
 	if !nlp.TryLock(cmn.Rom.MaxKeepalive()) {
@@ -22,6 +22,7 @@ import (
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/api/env"
+	"github.com/NVIDIA/aistore/bnotif"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/atomic"
@@ -62,6 +63,7 @@ type (
 		htrun // common w/ target
 
 		notifs notifs
+		quotas quotas
 		reg    struct {
 			pool nodeRegPool
 			mu   sync.RWMutex
@@ -211,6 +213,9 @@ func (p *proxy) Run() error {
 
 	p.notifs.init(p)
 	p.ic.init(p)
+	p.nbiInit()
+	p.quotaInit()
+	bnotif.Init(config)
 
 	p.initRecvHandlers()
 
@@ -223,7 +228,10 @@ func (p *proxy) Run() error {
 	}
 
 	p.initDsort(config) // note: conditional linkage
-	return p.htrun.run(config)
+	err := p.htrun.run(config)
+
+	bnotif.Stop() // spool pending bucket notifications
+	return err
 }
 
 // register API handlers
@@ -727,6 +735,13 @@ func (p *proxy) bgetObjects(w http.ResponseWriter, r *http.Request, qbck *cmn.Qu
 		p.writeErr(w, r, err)
 		return
 	}
+	if lsmsg.Filter != nil {
+		if err := lsmsg.Filter.Validate(); err != nil {
+			p.statsT.IncBck(stats.ErrListCount, bck.Bucket())
+			p.writeErr(w, r, err)
+			return
+		}
+	}
 
 	bckArgs := allocBctx()
 	{
@@ -895,6 +910,16 @@ func (p *proxy) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiRe
 		p.writeErr(w, r, err)
 		return
 	}
+	// (appending and uploading parts do not create new objects)
+	nobjs := int64(1)
+	if appendTyProvided || apireq.dpq.get(apc.QparamMptUploadID) != "" {
+		nobjs = 0
+	}
+	if err := p.quotaCheck(bck, r.ContentLength, nobjs); err != nil {
+		p.statsT.IncWith(errcnt, vlabs)
+		p.writeErr(w, r, err)
+		return
+	}
 
 	if nodeID == "" {
 		tsi, netPub, err = smap.HrwMultiHome(bck.MakeUname(objName))
@@ -935,6 +960,9 @@ func (p *proxy) httpobjdelete(w http.ResponseWriter, r *http.Request) {
 		bckArgs.perms = apc.AceObjDELETE
 		bckArgs.createAIS = false
 	}
+	if cos.IsParseBool(r.URL.Query().Get(apc.QparamBypassGovernance)) {
+		bckArgs.perms |= apc.AceObjUpdate
+	}
 	bck, objName, err := p._parseReqTry(w, r, bckArgs)
 	if err != nil {
 		return
@@ -1518,6 +1546,11 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			}
 			nlog.Infof(warnDstNotExist, p, bckTo, bckFrom)
 		}
+		// (copying itself is not accounted for - the job won't start if destination's quota is exhausted)
+		if err := p.quotaCheck(bckTo, 0, 0); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
 
 		// start x-tcb or x-tco
 		if v := query.Get(apc.QparamFltPresence); v != "" {
@@ -1603,6 +1636,10 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 				nlog.Infof(warnDstNotExist, p, bckTo, bck)
 			}
 		}
+		if err := p.quotaCheck(bckTo, 0, 0); err != nil { // (see x-tcb above)
+			p.writeErr(w, r, err)
+			return
+		}
 
 		xid, err = p.tcobjs(bck, bckTo, msg, tcomsg)
 		if err != nil {
@@ -1982,6 +2019,12 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 		if err := p.checkAccess(w, r, bck, apc.AccessRW); err != nil {
 			return
 		}
+		if msg.Action == apc.ActMptComplete {
+			if err := p.quotaCheck(bck, 0, 1); err != nil {
+				p.writeErr(w, r, err)
+				return
+			}
+		}
 		p.redirectAction(w, r, bck, apireq.items[1], msg)
 	case apc.ActCheckLock:
 		if err := p.checkAccess(w, r, bck, apc.AccessRO); err != nil {
@@ -2478,6 +2521,9 @@ func (p *proxy) httpdaeget(w http.ResponseWriter, r *http.Request) {
 	case apc.WhatSysInfo:
 		p.writeJSON(w, r, apc.GetMemCPU(), what)
 
+	case whatQuotaUsage:
+		p.writeJSON(w, r, p.quotas.snap(), what)
+
 	case apc.WhatSmap:
 		const retries = 16
 		var (
@@ -90,6 +90,8 @@ func (p *proxy) httpcluget(w http.ResponseWriter, r *http.Request) {
 		p.qcluSysinfo(w, r, what, query)
 	case apc.WhatMountpaths:
 		p.qcluMountpaths(w, r, what, query)
+	case apc.WhatQuarantine:
+		p.qcluQuarantine(w, r, what, query)
 	case apc.WhatBackends:
 		config := cmn.GCO.Get()
 		out := make([]string, 0, len(config.Backend.Providers))
@@ -320,6 +322,14 @@ func (p *proxy) qcluMountpaths(w http.ResponseWriter, r *http.Request, what stri
 	p.writeJSON(w, r, out, what)
 }
 
+func (p *proxy) qcluQuarantine(w http.ResponseWriter, r *http.Request, what string, query url.Values) {
+	targetQuarantine, erred := p._queryTs(w, r, query)
+	if targetQuarantine == nil || erred {
+		return
+	}
+	p.writeJSON(w, r, targetQuarantine, what)
+}
+
 // helper methods for querying targets
 
 func (p *proxy) _queryTs(w http.ResponseWriter, r *http.Request, query url.Values) (cos.JSONRawMsgs, bool) {
@@ -1022,7 +1032,7 @@ func (p *proxy) _syncFinal(ctx *smapModifier, clone *smapX) {
 // - cluster membership, including maintenance and decommission
 // - rebalance
 // - set-primary
-// +gen:endpoint PUT /v1/cluster[apc.QparamTransient=bool] action=[apc.ActSetConfig=cmn.ConfigToSet|apc.ActResetConfig=apc.ActMsg|apc.ActRotateLogs=apc.ActMsg|apc.ActShutdownCluster=apc.ActMsg|apc.ActDecommissionCluster=apc.ActValRmNode|apc.ActStartMaintenance=apc.ActValRmNode|apc.ActDecommissionNode=apc.ActValRmNode|apc.ActShutdownNode=apc.ActValRmNode|apc.ActRmNodeUnsafe=apc.ActValRmNode|apc.ActStopMaintenance=apc.ActMsg|apc.ActResetStats=apc.ActMsg|apc.ActClearLcache=apc.ActMsg|apc.ActXactStart=apc.ActMsg|apc.ActXactStop=apc.ActMsg|apc.ActReloadBackendCreds=apc.ActMsg|apc.ActBumpMetasync=apc.ActMsg]
+// +gen:endpoint PUT /v1/cluster[apc.QparamTransient=bool] action=[apc.ActSetConfig=cmn.ConfigToSet|apc.ActResetConfig=apc.ActMsg|apc.ActRotateLogs=apc.ActMsg|apc.ActShutdownCluster=apc.ActMsg|apc.ActDecommissionCluster=apc.ActValRmNode|apc.ActStartMaintenance=apc.ActValRmNode|apc.ActDecommissionNode=apc.ActValRmNode|apc.ActShutdownNode=apc.ActValRmNode|apc.ActRmNodeUnsafe=apc.ActValRmNode|apc.ActStopMaintenance=apc.ActMsg|apc.ActResetStats=apc.ActMsg|apc.ActClearLcache=apc.ActMsg|apc.ActXactStart=apc.ActMsg|apc.ActXactStop=apc.ActMsg|apc.ActReloadBackendCreds=apc.ActMsg|apc.ActRestoreQuarantined=apc.QuarantineMsg|apc.ActPurgeQuarantined=apc.QuarantineMsg|apc.ActBumpMetasync=apc.ActMsg]
 // +gen:payload apc.ActDecommissionCluster={"action": "decommission", "value": {"sid": "target_id", "skip_rebalance": false, "rm_user_data": true}}
 // +gen:payload apc.ActResetStats={"action": "reset-stats", "value": false}
 // Administrative cluster operations: configuration changes, node management, log rotation, shutdown/decommission operations.
@@ -1152,6 +1162,9 @@ func (p *proxy) cluputMsg(w http.ResponseWriter, r *http.Request) {
 	case apc.ActXactStop:
 		p.xstop(w, r, msg)
 
+	case apc.ActRestoreQuarantined, apc.ActPurgeQuarantined:
+		p.quarantineAct(w, r, msg)
+
 	case apc.ActReloadBackendCreds:
 		if msg.Name != "" {
 			normp := apc.NormalizeProvider(msg.Name)
@@ -1416,6 +1429,20 @@ func (p *proxy) xstart(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg)
 		xargs.ID = cos.GenUUID() // assign UUID
 		args._selected(tsi)
 		args.req.Body = cos.MustMarshal(apc.ActMsg{Action: msg.Action, Value: xargs})
+	case apc.ActStoreCleanup:
+		if rargs := xargs.Report; rargs != nil {
+			if err := rargs.Validate(); err != nil {
+				freeBcArgs(args)
+				p.writeErr(w, r, err)
+				return
+			}
+			if err := meta.CloneBck(&rargs.Bck).Init(p.owner.bmd); err != nil {
+				freeBcArgs(args)
+				p.writeErr(w, r, err)
+				return
+			}
+		}
+		fallthrough
 	default:
 		// all targets, one common UUID for all
 		args.to = core.Targets
@@ -1568,6 +1595,40 @@ func (p *proxy) reloadCreds(w http.ResponseWriter, r *http.Request, msg *apc.Act
 	nlog.Infoln("reloaded", tag)
 }
 
+// +gen:payload apc.ActRestoreQuarantined={"action": "restore-quarantined", "value": {"ids": ["quarantined_id"]}}
+// +gen:payload apc.ActPurgeQuarantined={"action": "purge-quarantined", "value": {"older_than": 86400000000000}}
+func (p *proxy) quarantineAct(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
+	var qmsg apc.QuarantineMsg
+	if err := cos.MorphMarshal(msg.Value, &qmsg); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return
+	}
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodPut, Path: apc.URLPathDae.S, Body: cos.MustMarshal(msg)}
+	args.to = core.Targets
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	var total int
+	for _, res := range results {
+		if res.err != nil {
+			err := res.errorf("node %s failed to %s", res.si, msg.Action)
+			p.writeErr(w, r, err)
+			freeBcastRes(results)
+			return
+		}
+		var n int
+		if err := jsoniter.Unmarshal(res.bytes, &n); err != nil {
+			p.writeErr(w, r, err)
+			freeBcastRes(results)
+			return
+		}
+		total += n
+	}
+	freeBcastRes(results)
+	p.writeJSON(w, r, total, msg.Action)
+}
+
 func (p *proxy) rebalanceCluster(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
 	// note operational priority over config-disabled `errRebalanceDisabled`
 	if err := p.canRebalance(); err != nil && err != errRebalanceDisabled {
@@ -117,6 +117,16 @@ func (p *proxy) httpetlput(w http.ResponseWriter, r *http.Request) {
 		p.writeErr(w, r, err)
 		return
 	}
+	// process runtime: must be explicitly allowed (K8s or no K8s)
+	if err := etl.CheckProcess(initMsg); err != nil {
+		p.writeErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	// (ETL process and WebAssembly runtimes do not require K8s)
+	if !k8s.IsK8s() && etl.NeedsK8s(initMsg) {
+		p.writeErr(w, r, k8s.ErrK8sRequired)
+		return
+	}
 
 	// must be new
 	etlMD := p.owner.etl.get()
@@ -505,9 +515,6 @@ func (p *proxy) stopETL(w http.ResponseWriter, r *http.Request, msg etl.InitMsg)
 }
 
 func (p *proxy) etlExists(etlName string) error {
-	if !k8s.IsK8s() {
-		return k8s.ErrK8sRequired
-	}
 	if err := k8s.ValidateEtlName(etlName); err != nil {
 		return err
 	}
@@ -536,7 +543,7 @@ func (ef *_etlFinalizer) cb(nl nl.Listener) {
 		return
 	}
 
-	if err := nl.Err(); err != nil {
+	if err := nl.Err(); err != nil && etl.NeedsK8s(ef.msg) { // (process and wasm runtimes: nothing to clean up)
 		// TODO: record nl.Err() and show on listETL call
 		for _, pod := range entry.PodMap {
 			nlog.Warningf("%s finalizer triggered with error: %v, removing pod/svc: %s/%s", ef.msg.Cname(), nl.Err(), pod.PodName, pod.SvcName)
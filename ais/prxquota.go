@@ -0,0 +1,310 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/bnotif"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// Bucket quotas (see cmn.QuotaConf):
+// - primary periodically runs bucket summary (present objects) for each bucket with quota;
+// - other proxies periodically fetch the resulting usage from the primary;
+// - in between, each proxy adds accepted writes (PUT, APPEND, multipart upload, S3 copy)
+//   to its usage estimate and rejects those that would exceed the hard quota;
+// - writes of unknown size (no Content-Length) are rejected if the bucket has hard size quota;
+// - bucket-to-bucket copy (and transform) jobs do not start if destination's quota
+//   is already exceeded;
+// - soft-quota crossings are logged and counted by each proxy; primary also delivers
+//   them as bucket notifications (cmn.NotifQuotaSoft).
+// Until the first summary completes, bucket usage is considered zero.
+
+const (
+	quotaIval        = 2 * time.Minute
+	quotaPoll        = 2 * time.Second
+	quotaSummTimeout = 10 * time.Minute
+	quotaHkName      = "bucket-quota" + hk.NameSuffix
+
+	whatQuotaUsage = "quota_usage" // internal (non-primary => primary)
+)
+
+type (
+	quotaUsage struct {
+		Size int64 `json:"size,string"`
+		Objs int64 `json:"objs,string"`
+	}
+	quotaSnap map[uint64]quotaUsage // bucket ID => usage
+
+	quotaEntry struct {
+		size atomic.Int64
+		objs atomic.Int64
+		soft atomic.Bool // soft quota exceeded (alert raised)
+	}
+	quotas struct {
+		m       sync.Map // bucket ID => *quotaEntry
+		running atomic.Bool
+	}
+)
+
+func (q *quotas) get(bid uint64) *quotaEntry {
+	if v, ok := q.m.Load(bid); ok {
+		return v.(*quotaEntry)
+	}
+	v, _ := q.m.LoadOrStore(bid, &quotaEntry{})
+	return v.(*quotaEntry)
+}
+
+func (q *quotas) snap() quotaSnap {
+	snap := make(quotaSnap, 4)
+	q.m.Range(func(k, v any) bool {
+		e := v.(*quotaEntry)
+		snap[k.(uint64)] = quotaUsage{Size: e.size.Load(), Objs: e.objs.Load()}
+		return true
+	})
+	return snap
+}
+
+func (p *proxy) quotaInit() {
+	hk.Reg(quotaHkName, p.quotaHousekeep, quotaIval)
+}
+
+func (p *proxy) quotaHousekeep(int64) time.Duration {
+	if !p.ClusterStarted() || !p.quotas.running.CAS(false, true) {
+		return quotaIval
+	}
+	go p.quotaRefresh()
+	return quotaIval
+}
+
+func (p *proxy) quotaRefresh() {
+	defer p.quotas.running.Store(false)
+
+	var (
+		bmd    = p.owner.bmd.get()
+		smap   = p.owner.smap.get()
+		bcks   = make([]*meta.Bck, 0, 4)
+		snap   quotaSnap
+		err    error
+		active = make(map[uint64]struct{}, 4)
+	)
+	bmd.Range(nil, nil, func(bck *meta.Bck) bool {
+		if bck.Props.Quota.IsActive() {
+			bcks = append(bcks, bck)
+			active[bck.Props.BID] = struct{}{}
+		}
+		return false
+	})
+	// forget buckets that no longer exist or have no quota
+	p.quotas.m.Range(func(k, _ any) bool {
+		if _, ok := active[k.(uint64)]; !ok {
+			p.quotas.m.Delete(k)
+		}
+		return true
+	})
+	if len(bcks) == 0 {
+		return
+	}
+
+	// usage estimates prior to summarizing (or pulling): writes accepted in the meantime
+	// are not lost - instead, they may get counted twice, until the next refresh
+	prev := p.quotas.snap()
+
+	if smap.IsPrimary(p.si) {
+		snap = make(quotaSnap, len(bcks))
+		for _, bck := range bcks {
+			u, err := p.quotaSumm(bck)
+			if err != nil {
+				nlog.Warningln(p.String(), "failed to summarize", bck.Cname(""), "usage:", err)
+				continue
+			}
+			snap[bck.Props.BID] = u
+		}
+	} else if snap, err = p.quotaPull(smap); err != nil {
+		nlog.Warningln(p.String(), "failed to fetch bucket quota usage from primary:", err)
+		return
+	}
+
+	for _, bck := range bcks {
+		u, ok := snap[bck.Props.BID]
+		if !ok {
+			continue
+		}
+		var (
+			e    = p.quotas.get(bck.Props.BID)
+			u0   = prev[bck.Props.BID]
+			size = e.size.Add(u.Size - u0.Size)
+			objs = e.objs.Add(u.Objs - u0.Objs)
+		)
+		if !bck.Props.Quota.SoftExceeded(size, objs) {
+			e.soft.Store(false) // re-arm
+		} else if e.soft.CAS(false, true) {
+			p.quotaAlert(bck, size, objs)
+		}
+	}
+}
+
+// run bucket summary (present objects only) and wait for it to finish
+func (p *proxy) quotaSumm(bck *meta.Bck) (u quotaUsage, _ error) {
+	var (
+		qbck = (*cmn.QueryBcks)(bck)
+		msg  = &apc.BsummCtrlMsg{ObjCached: true, BckPresent: true, DontAddRemote: true}
+	)
+	if err := p.bsummNew(qbck, msg); err != nil {
+		return u, err
+	}
+	for total := time.Duration(0); total < quotaSummTimeout; total += quotaPoll {
+		time.Sleep(quotaPoll)
+		summaries, status, err := p.bsummCollect(qbck, msg)
+		if err != nil {
+			return u, err
+		}
+		if status != http.StatusOK {
+			continue
+		}
+		for _, res := range summaries {
+			if res.Bck.Equal(bck.Bucket()) {
+				u.Size, u.Objs = int64(res.TotalSize.PresentObjs), int64(res.ObjCount.Present)
+				break
+			}
+		}
+		return u, nil
+	}
+	return u, fmt.Errorf("timed out waiting for %s", msg.UUID)
+}
+
+// non-primary: get current usage from the primary
+func (p *proxy) quotaPull(smap *smapX) (quotaSnap, error) {
+	cargs := allocCargs()
+	{
+		cargs.si = smap.Primary
+		cargs.req = cmn.HreqArgs{
+			Method: http.MethodGet,
+			Path:   apc.URLPathDae.S,
+			Query:  url.Values{apc.QparamWhat: []string{whatQuotaUsage}},
+		}
+		cargs.timeout = cmn.Rom.CplaneOperation()
+		cargs.cresv = cresjGeneric[quotaSnap]{}
+	}
+	res := p.call(cargs, smap)
+	freeCargs(cargs)
+	if res.err != nil {
+		err := res.toErr()
+		freeCR(res)
+		return nil, err
+	}
+	snap := *res.v.(*quotaSnap)
+	freeCR(res)
+	return snap, nil
+}
+
+// Enforce the bucket's hard quota and account for the write;
+// `size` is the number of bytes to write (negative when unknown),
+// `objs` - the number of objects to create.
+// With zero size and objs, fails only if the quota is already exceeded.
+// Unknown size is rejected (ErrQuotaSizeUnknown) when the bucket has hard size quota.
+func (p *proxy) quotaCheck(bck *meta.Bck, size, objs int64) error {
+	if bck.Props == nil { // (e.g., destination bucket to be created)
+		return nil
+	}
+	quota := &bck.Props.Quota
+	if !quota.IsActive() {
+		return nil
+	}
+	if size < 0 && quota.SizeHard > 0 {
+		p.statsT.IncBck(stats.ErrQuotaCount, bck.Bucket())
+		return fmt.Errorf("%s: %w", bck.Cname(""), cmn.ErrQuotaSizeUnknown)
+	}
+	e := p.quotas.get(bck.Props.BID)
+	usedSize, usedObjs, err := e.add(quota, bck.Cname(""), max(size, 0), objs)
+	if err != nil {
+		p.statsT.IncBck(stats.ErrQuotaCount, bck.Bucket())
+		return err
+	}
+	if quota.SoftExceeded(usedSize, usedObjs) && e.soft.CAS(false, true) {
+		p.quotaAlert(bck, usedSize, usedObjs)
+	}
+	return nil
+}
+
+// check-and-add (CAS) so that concurrent writes do not overshoot hard limits;
+// size is added first and rolled back if the number of objects would exceed its limit
+func (e *quotaEntry) add(quota *cmn.QuotaConf, cname string, size, objs int64) (usedSize, usedObjs int64, err error) {
+	for {
+		usedSize = e.size.Load()
+		if err = quota.Check(cname, usedSize, 0, size, 0); err != nil {
+			return 0, 0, err
+		}
+		if e.size.CAS(usedSize, usedSize+size) {
+			usedSize += size
+			break
+		}
+	}
+	for {
+		usedObjs = e.objs.Load()
+		if err = quota.Check(cname, 0, usedObjs, 0, objs); err != nil {
+			e.size.Sub(size)
+			return 0, 0, err
+		}
+		if e.objs.CAS(usedObjs, usedObjs+objs) {
+			return usedSize, usedObjs + objs, nil
+		}
+	}
+}
+
+// S3 copy: size of the source object (via HEAD), to enforce destination's hard size quota
+func (p *proxy) quotaSrcSize(bckDst, bckSrc *meta.Bck, objName string, tsi *meta.Snode, smap *smapX) (int64, error) {
+	if bckDst.Props == nil || bckDst.Props.Quota.SizeHard == 0 {
+		return 0, nil
+	}
+	cargs := allocCargs()
+	{
+		cargs.si = tsi
+		cargs.req = cmn.HreqArgs{
+			Method: http.MethodHead,
+			Path:   apc.URLPathObjects.Join(bckSrc.Name, objName),
+			Query:  bckSrc.NewQuery(),
+		}
+		cargs.timeout = cmn.Rom.CplaneOperation()
+	}
+	res := p.call(cargs, smap)
+	freeCargs(cargs)
+	if res.err != nil {
+		err := res.toErr()
+		freeCR(res)
+		return 0, err
+	}
+	var (
+		size int64 // (not set when zero - see cmn.ToHeader)
+		err  error
+	)
+	if v := res.header.Get(cos.HdrContentLength); v != "" {
+		size, err = strconv.ParseInt(v, 10, 64)
+	}
+	freeCR(res)
+	return size, err
+}
+
+func (p *proxy) quotaAlert(bck *meta.Bck, size, objs int64) {
+	nlog.Warningln(p.String(), bck.Cname(""), "soft quota exceeded: [", bck.Props.Quota.String(), "], used",
+		cos.IEC(size, 2), "in", objs, "objects")
+	p.statsT.IncBck(stats.QuotaSoftCount, bck.Bucket())
+	if smap := p.owner.smap.get(); smap.IsPrimary(p.si) {
+		bnotif.NotifyBck(bck, cmn.NotifQuotaSoft, size)
+	}
+}
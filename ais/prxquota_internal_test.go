@@ -0,0 +1,59 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// concurrent writes must not overshoot hard limits
+func TestQuotaAddConcurrent(t *testing.T) {
+	const (
+		nworkers = 64
+		objSize  = cos.KiB
+		objsHard = 10
+		sizeHard = 20 * objSize
+	)
+	var (
+		quota  = &cmn.QuotaConf{SizeHard: sizeHard, ObjsHard: objsHard}
+		e      = &quotaEntry{}
+		nok    atomic.Int64
+		wg     sync.WaitGroup
+		cname  = "ais://quota"
+		starts = make(chan struct{})
+	)
+	for range nworkers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-starts
+			if _, _, err := e.add(quota, cname, objSize, 1); err == nil {
+				nok.Inc()
+			} else if !cmn.IsErrQuotaExceeded(err) {
+				t.Error(err)
+			}
+		}()
+	}
+	close(starts)
+	wg.Wait()
+
+	tassert.Errorf(t, nok.Load() == objsHard, "expected %d writes accepted, got %d", objsHard, nok.Load())
+	tassert.Errorf(t, e.objs.Load() == objsHard, "expected %d objects, got %d", objsHard, e.objs.Load())
+	// rejected (objects) writes must not leave their size behind
+	tassert.Errorf(t, e.size.Load() == objsHard*objSize, "expected size %d, got %d", objsHard*objSize, e.size.Load())
+
+	// size limit
+	quota.ObjsHard = 0
+	for range 2 * nworkers {
+		e.add(quota, cname, objSize, 1)
+	}
+	tassert.Errorf(t, e.size.Load() == sizeHard, "expected size %d, got %d", sizeHard, e.size.Load())
+}
@@ -46,6 +46,9 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		return
 	}
+	if len(apiItems) > 0 && r.Method != http.MethodOptions && r.Header.Get(s3.HdrOrigin) != "" {
+		p.corsS3(w, r, apiItems[0])
+	}
 
 	switch r.Method {
 	case http.MethodHead:
@@ -74,16 +77,56 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 		}
 
 		var (
-			q            = r.URL.Query()
-			_, lifecycle = q[s3.QparamLifecycle]
-			_, policy    = q[s3.QparamPolicy]
-			_, cors      = q[s3.QparamCORS]
-			_, acl       = q[s3.QparamACL]
+			q      = r.URL.Query()
+			_, acl = q[s3.QparamACL]
 		)
-		if lifecycle || policy || cors || acl {
+		// (object ACLs are not supported)
+		if acl && len(apiItems) > 1 {
 			p.unsupported(w, r, apiItems[0])
 			return
 		}
+		if q.Has(s3.QparamTagging) && len(apiItems) > 1 {
+			// perms: apc.AceObjHEAD
+			p.objTaggingS3(w, r, apiItems, apc.AceObjHEAD)
+			return
+		}
+		if (q.Has(s3.QparamRetention) || q.Has(s3.QparamLegalHold)) && len(apiItems) > 1 {
+			// perms: apc.AceObjHEAD
+			p.objLockS3(w, r, apiItems, apc.AceObjHEAD)
+			return
+		}
+		if len(apiItems) == 1 {
+			switch {
+			case q.Has(s3.QparamCORS):
+				// perms: apc.AceBckHEAD
+				p.getBckCORSS3(w, r, apiItems[0])
+				return
+			case q.Has(s3.QparamNotification):
+				// perms: apc.AceBckHEAD
+				p.getBckNotifS3(w, r, apiItems[0])
+				return
+			case q.Has(s3.QparamObjectLock):
+				// perms: apc.AceBckHEAD
+				p.getBckObjLockS3(w, r, apiItems[0])
+				return
+			case q.Has(s3.QparamTagging):
+				// perms: apc.AceBckHEAD
+				p.getBckTaggingS3(w, r, apiItems[0])
+				return
+			case q.Has(s3.QparamLifecycle):
+				// perms: apc.AceBckHEAD
+				p.getBckLifecycleS3(w, r, apiItems[0])
+				return
+			case q.Has(s3.QparamPolicy):
+				// perms: apc.AceBckHEAD
+				p.getBckPolicyS3(w, r, apiItems[0])
+				return
+			case acl:
+				// perms: apc.AceBckHEAD
+				p.getBckACLS3(w, r, apiItems[0])
+				return
+			}
+		}
 		listMultipart := q.Has(s3.QparamMptUploads)
 		if len(apiItems) == 1 && !listMultipart {
 			_, versioning := q[s3.QparamVersioning]
@@ -110,12 +153,52 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 				p.putBckVersioningS3(w, r, apiItems[0])
 				return
 			}
+			switch {
+			case q.Has(s3.QparamCORS):
+				// perms: apc.AcePATCH
+				p.putBckCORSS3(w, r, apiItems[0])
+				return
+			case q.Has(s3.QparamNotification):
+				// perms: apc.AcePATCH
+				p.putBckNotifS3(w, r, apiItems[0])
+				return
+			case q.Has(s3.QparamObjectLock):
+				// perms: apc.AcePATCH
+				p.putBckObjLockS3(w, r, apiItems[0])
+				return
+			case q.Has(s3.QparamTagging):
+				// perms: apc.AcePATCH
+				p.putBckTaggingS3(w, r, apiItems[0])
+				return
+			case q.Has(s3.QparamLifecycle):
+				// perms: apc.AcePATCH
+				p.putBckLifecycleS3(w, r, apiItems[0])
+				return
+			case q.Has(s3.QparamPolicy):
+				// perms: apc.AceBckSetACL
+				p.putBckPolicyS3(w, r, apiItems[0])
+				return
+			case q.Has(s3.QparamACL):
+				// perms: apc.AceBckSetACL
+				p.putBckACLS3(w, r, apiItems[0])
+				return
+			}
 			// perms: apc.AceCreateBucket
 			p.putBckS3(w, r, apiItems[0])
 			return
 		}
-		// perms: apc.AcePUT
-		p.putObjS3(w, r, apiItems)
+		q := r.URL.Query()
+		switch {
+		case q.Has(s3.QparamTagging):
+			// perms: apc.AcePUT
+			p.objTaggingS3(w, r, apiItems, apc.AcePUT)
+		case q.Has(s3.QparamRetention) || q.Has(s3.QparamLegalHold):
+			// perms: apc.AcePUT (and apc.AceObjUpdate to bypass governance)
+			p.objLockS3(w, r, apiItems, apc.AcePUT)
+		default:
+			// perms: apc.AcePUT
+			p.putObjS3(w, r, apiItems)
+		}
 	case http.MethodPost:
 		q := r.URL.Query()
 		if q.Has(s3.QparamMptUploadID) || q.Has(s3.QparamMptUploads) {
@@ -144,15 +227,46 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 				p.delMultipleObjs(w, r, apiItems[0])
 				return
 			}
+			switch {
+			case q.Has(s3.QparamCORS):
+				// perms: apc.AcePATCH
+				p.delBckCORSS3(w, r, apiItems[0])
+				return
+			case q.Has(s3.QparamTagging):
+				// perms: apc.AcePATCH
+				p.delBckTaggingS3(w, r, apiItems[0])
+				return
+			case q.Has(s3.QparamLifecycle):
+				// perms: apc.AcePATCH
+				p.delBckLifecycleS3(w, r, apiItems[0])
+				return
+			case q.Has(s3.QparamPolicy):
+				// perms: apc.AceBckSetACL
+				p.delBckPolicyS3(w, r, apiItems[0])
+				return
+			}
 			// perms: apc.AceDestroyBucket
 			p.delBckS3(w, r, apiItems[0])
 			return
 		}
+		if r.URL.Query().Has(s3.QparamTagging) {
+			// perms: apc.AcePUT
+			p.objTaggingS3(w, r, apiItems, apc.AcePUT)
+			return
+		}
 		// perms: apc.AceObjDELETE
 		p.delObjS3(w, r, apiItems)
+	case http.MethodOptions:
+		if len(apiItems) == 0 {
+			cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodHead,
+				http.MethodPost, http.MethodPut, http.MethodOptions)
+			return
+		}
+		// CORS preflight (no auth)
+		p.preflightS3(w, r, apiItems[0])
 	default:
 		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodHead,
-			http.MethodPost, http.MethodPut)
+			http.MethodPost, http.MethodPut, http.MethodOptions)
 	}
 }
 
@@ -191,6 +305,11 @@ func (p *proxy) putBckS3(w http.ResponseWriter, r *http.Request, bucket string)
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
+	if s3.IsBckObjLockEnabled(r.Header) {
+		bargs := bckPropsArgs{bck: bck}
+		bck.Props = bargs.inheritMerge()
+		bck.Props.ObjectLock.Enabled = true
+	}
 	if err := p.createBucket(&msg, bck, nil); err != nil {
 		s3.WriteErr(w, r, err, crerrStatus(err))
 	}
@@ -243,6 +362,12 @@ func (p *proxy) handleMptUpload(w http.ResponseWriter, r *http.Request, items []
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
+	if r.URL.Query().Has(s3.QparamMptUploadID) {
+		if err := p.quotaCheck(bck, 0, 1); err != nil { // (complete; parts are accounted for in directPutObjS3)
+			s3.WriteErr(w, r, err, 0)
+			return
+		}
+	}
 
 	smap := p.owner.smap.get()
 	tsi, netPub, err := smap.HrwMultiHome(bck.MakeUname(objName))
@@ -346,7 +471,7 @@ func (p *proxy) headBckS3(w http.ResponseWriter, r *http.Request, bucket string)
 	w.Header().Set(cos.S3HdrBckRegion, s3.AISRegion)
 }
 
-// +gen:endpoint GET /s3/{bucket-name} [s3.QparamMaxKeys=string,s3.QparamPrefix=string,s3.QparamContinuationToken=string,s3.QparamStartAfter=string,s3.QparamDelimiter=string]
+// +gen:endpoint GET /s3/{bucket-name} [s3.QparamListType=string,s3.QparamMaxKeys=string,s3.QparamPrefix=string,s3.QparamContinuationToken=string,s3.QparamStartAfter=string,s3.QparamDelimiter=string,s3.QparamEncodingType=string,s3.QparamFetchOwner=string,s3.QparamMarker=string]
 // List objects in an S3 bucket
 func (p *proxy) listObjectsS3(w http.ResponseWriter, r *http.Request, bucket string, q url.Values) {
 	bck := p.initByNameOnly(w, r, bucket)
@@ -364,6 +489,19 @@ func (p *proxy) listObjectsS3(w http.ResponseWriter, r *http.Request, bucket str
 		return
 	}
 
+	// ListObjectsV2 ("list-type=2") or ListObjects (V1), optional:
+	// - "max-keys"
+	// - "prefix"
+	// - "delimiter" (any string)
+	// - "encoding-type" ("url")
+	// - V2: "start-after", "continuation-token", "fetch-owner"
+	// - V1: "marker"
+	lctx, err := s3.NewLsoCtx(bucket, q)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+
 	// e.g. <LastModified>2009-10-12T17:50:30.000Z</LastModified>
 	lsmsg := &apc.LsoMsg{TimeFormat: time.RFC3339, Flags: apc.LsIsS3}
 
@@ -371,22 +509,16 @@ func (p *proxy) listObjectsS3(w http.ResponseWriter, r *http.Request, bucket str
 	// NOTE (s3 api limitation): hard-coded props w/ apc.GetPropsCustom always included
 	//
 	lsmsg.AddProps(apc.GetPropsSize, apc.GetPropsChecksum, apc.GetPropsAtime, apc.GetPropsCustom)
+	if err := lctx.InitMsg(lsmsg, bck.IsRemote()); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
 	amsg.Value = lsmsg
 
-	// as per API_ListObjectsV2.html, optional:
-	// - "max-keys"
-	// - "prefix"
-	// - "start-after"
-	// - "delimiter" (TODO: limited support: no recursion)
-	// - "continuation-token" (NOTE: base64 encoded, as in: base64.StdEncoding.DecodeString(token)
-	// TODO:
-	// - "fetch-owner"
-	// - "encoding-type"
-	s3.FillLsoMsg(q, lsmsg)
-
-	lst, err := p.lsAllPagesS3(bck, amsg, lsmsg, r.Header)
+	err = p.lsPagesS3(bck, amsg, lsmsg, r.Header, lctx)
+	resp := lctx.Result()
 	if cmn.Rom.V(5, cos.ModS3) {
-		nlog.Infoln("lsoS3", bck.Cname(""), len(lst.Entries), err)
+		nlog.Infoln("lsoS3", bck.Cname(""), resp.KeyCount, err)
 	}
 	if err != nil {
 		s3.WriteErr(w, r, err, 0)
@@ -401,28 +533,24 @@ func (p *proxy) listObjectsS3(w http.ResponseWriter, r *http.Request, bucket str
 	// - the implication: if, when working with very large remote datasets, list-objects performance
 	//   becomes an issue - consider using native API.
 
-	resp := s3.NewListObjectResult(bucket)
-	resp.ContinuationToken = lsmsg.ContinuationToken
-	resp.FromLsoResult(lst)
 	sgl := p.gmm.NewSGL(0)
 	resp.MustMarshal(sgl)
 	w.Header().Set(cos.HdrContentType, cos.ContentXML)
 	sgl.WriteTo2(w)
 	sgl.Free()
-
-	// GC
-	clear(lst.Entries)
-	lst.Entries = lst.Entries[:0]
-	lst.Entries = nil
 }
 
-func (p *proxy) lsAllPagesS3(bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg, hdr http.Header) (lst *cmn.LsoRes, _ error) {
+// read native pages until the S3 page is full (see s3.LsoCtx)
+func (p *proxy) lsPagesS3(bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg, hdr http.Header, lctx *s3.LsoCtx) error {
 	smap := p.owner.smap.get()
 	for pageNum := 1; ; pageNum++ {
-		beg := mono.NanoTime()
+		var (
+			beg   = mono.NanoTime()
+			token = lsmsg.ContinuationToken
+		)
 		page, err := p.lsPage(bck, amsg, lsmsg, hdr, smap)
 		if err != nil {
-			return lst, err
+			return err
 		}
 
 		vlabs := map[string]string{stats.VlabBucket: bck.Cname("")}
@@ -431,32 +559,58 @@ func (p *proxy) lsAllPagesS3(bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg,
 			cos.NamedVal64{Name: stats.ListLatency, Value: mono.SinceNano(beg), VarLabs: vlabs},
 		)
 		if pageNum == 1 {
-			lst = page
 			lsmsg.UUID = page.UUID
-			debug.Assert(cos.IsValidUUID(lst.UUID), lst.UUID)
+			debug.Assert(cos.IsValidUUID(page.UUID), page.UUID)
 		} else {
-			lst.Entries = append(lst.Entries, page.Entries...)
-			lst.ContinuationToken = page.ContinuationToken
-			debug.Assert(lst.UUID == page.UUID, lst.UUID, page.UUID)
-			lst.Flags |= page.Flags
+			debug.Assert(lsmsg.UUID == page.UUID, lsmsg.UUID, page.UUID)
 		}
-		if page.ContinuationToken == "" { // listed all pages
-			break
+		done := lctx.Add(page, token)
+
+		// GC
+		clear(page.Entries)
+		page.Entries = nil
+
+		if done {
+			return nil
 		}
 		lsmsg.ContinuationToken = page.ContinuationToken
 		amsg.Value = lsmsg
 	}
-	return lst, nil
 }
 
 // +gen:endpoint PUT /s3/{bucket-name}/{object-name}
 // Upload or copy an S3 object
 func (p *proxy) putObjS3(w http.ResponseWriter, r *http.Request, items []string) {
-	if r.Header.Get(cos.S3HdrObjSrc) == "" {
+	switch {
+	case r.Header.Get(cos.S3HdrObjSrc) == "":
 		p.directPutObjS3(w, r, items)
+	case r.URL.Query().Has(s3.QparamMptUploadID):
+		p.copyPartS3(w, r, items)
+	default:
+		p.copyObjS3(w, r, items)
+	}
+}
+
+// PUT /s3/<bucket-name>/<object-name>?partNumber=N&uploadId=ID - with HeaderObjSrc in the request header
+// (UploadPartCopy): unlike p.copyObjS3, redirect to the target that owns the destination
+// (and the upload) - the latter will then read the source from wherever it resides
+func (p *proxy) copyPartS3(w http.ResponseWriter, r *http.Request, items []string) {
+	src := r.Header.Get(cos.S3HdrObjSrc)
+	src = strings.Trim(src, "/")
+	parts := strings.SplitN(src, "/", 2)
+	if len(parts) < 2 {
+		s3.WriteErr(w, r, errS3Obj, 0)
 		return
 	}
-	p.copyObjS3(w, r, items)
+	bckSrc := p.initByNameOnly(w, r, parts[0])
+	if bckSrc == nil {
+		return
+	}
+	if err := p.access(r.Context(), r.Header, bckSrc, apc.AceGET); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	p.directPutObjS3(w, r, items)
 }
 
 // PUT /s3/<bucket-name>/<object-name> - with HeaderObjSrc in the request header
@@ -495,6 +649,15 @@ func (p *proxy) copyObjS3(w http.ResponseWriter, r *http.Request, items []string
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
+	size, err := p.quotaSrcSize(bckDst, bckSrc, objName, tsi, smap)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	if err := p.quotaCheck(bckDst, size, 1); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
 	if cmn.Rom.V(5, cos.ModS3) {
 		nlog.Infoln("COPY:", r.Method, bckSrc.Cname(objName), "=>", bckDst.Cname(""), items, tsi.StringEx())
 	}
@@ -523,6 +686,14 @@ func (p *proxy) directPutObjS3(w http.ResponseWriter, r *http.Request, items []s
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
+	nobjs := int64(1)
+	if r.URL.Query().Has(s3.QparamMptUploadID) {
+		nobjs = 0 // (upload part)
+	}
+	if err := p.quotaCheck(bck, r.ContentLength, nobjs); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
 
 	smap := p.owner.smap.get()
 	tsi, netPub, err := smap.HrwMultiHome(bck.MakeUname(objName))
@@ -676,7 +847,11 @@ func (p *proxy) delObjS3(w http.ResponseWriter, r *http.Request, items []string)
 	if bck == nil {
 		return
 	}
-	if err := p.access(r.Context(), r.Header, bck, apc.AceObjDELETE); err != nil {
+	ace := apc.AceObjDELETE
+	if s3.IsBypassGovernance(r.Header) {
+		ace |= apc.AceObjUpdate
+	}
+	if err := p.access(r.Context(), r.Header, bck, ace); err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
@@ -700,6 +875,58 @@ func (p *proxy) delObjS3(w http.ResponseWriter, r *http.Request, items []string)
 	p.s3Redirect(w, r, tsi, redurl, bck.Name)
 }
 
+// +gen:endpoint GET /s3/{bucket-name}/{object-name} [s3.QparamTagging=string]
+// +gen:endpoint PUT /s3/{bucket-name}/{object-name} [s3.QparamTagging=string] payload=s3-tagging
+// +gen:endpoint DELETE /s3/{bucket-name}/{object-name} [s3.QparamTagging=string]
+// +gen:payload s3-tagging=<Tagging><TagSet><Tag><Key>split</Key><Value>train</Value></Tag></TagSet></Tagging>
+// Get, set, or remove S3 object tags (persisted in the object's custom metadata)
+func (p *proxy) objTaggingS3(w http.ResponseWriter, r *http.Request, items []string, ace apc.AccessAttrs) {
+	p._redirectObjMetaS3(w, r, items, ace, "tagging")
+}
+
+// +gen:endpoint GET /s3/{bucket-name}/{object-name} [s3.QparamRetention=string]
+// +gen:endpoint PUT /s3/{bucket-name}/{object-name} [s3.QparamRetention=string] payload=s3-retention
+// +gen:endpoint GET /s3/{bucket-name}/{object-name} [s3.QparamLegalHold=string]
+// +gen:endpoint PUT /s3/{bucket-name}/{object-name} [s3.QparamLegalHold=string] payload=s3-legal-hold
+// +gen:payload s3-retention=<Retention><Mode>GOVERNANCE</Mode><RetainUntilDate>2030-01-01T00:00:00Z</RetainUntilDate></Retention>
+// +gen:payload s3-legal-hold=<LegalHold><Status>ON</Status></LegalHold>
+// Get or set S3 object retention and legal hold (object lock)
+func (p *proxy) objLockS3(w http.ResponseWriter, r *http.Request, items []string, ace apc.AccessAttrs) {
+	if r.Method == http.MethodPut && s3.IsBypassGovernance(r.Header) {
+		ace |= apc.AceObjUpdate
+	}
+	p._redirectObjMetaS3(w, r, items, ace, "object-lock")
+}
+
+// object metadata (tags, object lock) is handled by the object's HRW target
+func (p *proxy) _redirectObjMetaS3(w http.ResponseWriter, r *http.Request, items []string, ace apc.AccessAttrs, tag string) {
+	bck := p.initByNameOnly(w, r, items[0] /*bucket*/)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Context(), r.Header, bck, ace); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	objName := s3.ObjName(items)
+	if err := cos.ValidOname(objName); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	smap := p.owner.smap.get()
+	tsi, err := smap.HrwName2T(bck.MakeUname(objName))
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	if cmn.Rom.V(5, cos.ModS3) {
+		nlog.Infoln(r.Method, tag, bck.Cname(objName), "=>", tsi.StringEx())
+	}
+	started := time.Now()
+	redurl := p.redurl(r, tsi, smap.Version, started.UnixNano(), cmn.NetIntraControl, "")
+	p.s3Redirect(w, r, tsi, redurl, bck.Name)
+}
+
 // +gen:endpoint GET /s3/{bucket-name} [s3.QparamVersioning=string]
 // Get S3 bucket versioning configuration
 func (p *proxy) getBckVersioningS3(w http.ResponseWriter, r *http.Request, bucket string) {
@@ -715,7 +942,7 @@ func (p *proxy) getBckVersioningS3(w http.ResponseWriter, r *http.Request, bucke
 	sgl.Free()
 }
 
-// GET /s3/<bucket-name>?lifecycle|cors|policy|acl
+// GET /s3/<bucket-name>/<object-name>?acl
 func (p *proxy) unsupported(w http.ResponseWriter, r *http.Request, bucket string) {
 	if _, ecode, err := meta.InitByNameOnly(bucket, p.owner.bmd); err != nil {
 		s3.WriteErr(w, r, err, ecode)
@@ -757,6 +984,484 @@ func (p *proxy) putBckVersioningS3(w http.ResponseWriter, r *http.Request, bucke
 	}
 }
 
+// +gen:endpoint GET /s3/{bucket-name} [s3.QparamLifecycle=string]
+// Get S3 bucket lifecycle configuration
+func (p *proxy) getBckLifecycleS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Context(), r.Header, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	if !bck.Props.Lifecycle.IsActive() {
+		s3.WriteErr(w, r, s3.NewErrNoSuchLifecycle(bucket), 0)
+		return
+	}
+	resp := s3.NewLifecycleConfiguration(&bck.Props.Lifecycle)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// +gen:endpoint PUT /s3/{bucket-name} [s3.QparamLifecycle=string] payload=s3-lifecycle
+// +gen:payload s3-lifecycle=<LifecycleConfiguration><Rule><ID>expire</ID><Filter><Prefix>logs/</Prefix></Filter><Status>Enabled</Status><Expiration><Days>30</Days></Expiration></Rule></LifecycleConfiguration>
+// Configure S3 bucket lifecycle (replaces existing rules, if any)
+func (p *proxy) putBckLifecycleS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Context(), r.Header, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	lcy, err := s3.DecodeLifecycle(r.Body)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	conf, err := lcy.ToNative()
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	propsToUpdate := cmn.BpropsToSet{
+		Lifecycle: &cmn.LifecycleConfToSet{Rules: &conf.Rules, Enabled: &conf.Enabled},
+	}
+	p._setBpropsS3(w, r, msg, bck, &propsToUpdate)
+}
+
+// +gen:endpoint DELETE /s3/{bucket-name} [s3.QparamLifecycle=string]
+// Remove S3 bucket lifecycle configuration
+func (p *proxy) delBckLifecycleS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Context(), r.Header, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	var (
+		enabled bool
+		rules   = []cmn.LifecycleRule{} // (non-nil to override)
+	)
+	propsToUpdate := cmn.BpropsToSet{
+		Lifecycle: &cmn.LifecycleConfToSet{Rules: &rules, Enabled: &enabled},
+	}
+	if p._setBpropsS3(w, r, msg, bck, &propsToUpdate) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// +gen:endpoint GET /s3/{bucket-name} [s3.QparamPolicy=string]
+// Get S3 bucket policy (synthesized from the bucket's access attributes)
+func (p *proxy) getBckPolicyS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Context(), r.Header, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	if bck.Props.Access == apc.AccessAll {
+		s3.WriteErr(w, r, s3.NewErrNoSuchPolicy(bucket), 0)
+		return
+	}
+	policy := s3.NewBucketPolicy(bucket, bck.Props.Access)
+	w.Header().Set(cos.HdrContentType, cos.ContentJSON)
+	w.Write(cos.MustMarshal(policy))
+}
+
+// +gen:endpoint PUT /s3/{bucket-name} [s3.QparamPolicy=string] payload=s3-policy
+// +gen:payload s3-policy={"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":["s3:GetObject","s3:ListBucket"],"Resource":["arn:aws:s3:::bucket-name","arn:aws:s3:::bucket-name/*"]}]}
+// Configure S3 bucket policy (translated into bucket access attributes)
+func (p *proxy) putBckPolicyS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Context(), r.Header, bck, apc.AceBckSetACL); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	body, err := cos.ReadAllN(r.Body, r.ContentLength)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	access, err := s3.ParsePolicy(body, bucket)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	if p._setBpropsS3(w, r, msg, bck, &cmn.BpropsToSet{Access: &access}) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// +gen:endpoint DELETE /s3/{bucket-name} [s3.QparamPolicy=string]
+// Remove S3 bucket policy (i.e., restore default bucket access)
+func (p *proxy) delBckPolicyS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Context(), r.Header, bck, apc.AceBckSetACL); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	access := apc.AccessAll
+	if p._setBpropsS3(w, r, msg, bck, &cmn.BpropsToSet{Access: &access}) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// +gen:endpoint GET /s3/{bucket-name} [s3.QparamACL=string]
+// Get S3 bucket ACL (derived from the bucket's access attributes)
+func (p *proxy) getBckACLS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Context(), r.Header, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	resp := s3.NewAccessControlPolicy(bck.Props.Access)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// +gen:endpoint PUT /s3/{bucket-name} [s3.QparamACL=string]
+// Configure S3 bucket ACL: canned (x-amz-acl header) or AllUsers group grants
+func (p *proxy) putBckACLS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Context(), r.Header, bck, apc.AceBckSetACL); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	access, err := s3.ACLFromRequest(r)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	p._setBpropsS3(w, r, msg, bck, &cmn.BpropsToSet{Access: &access})
+}
+
+// +gen:endpoint GET /s3/{bucket-name} [s3.QparamCORS=string]
+// Get S3 bucket CORS configuration
+func (p *proxy) getBckCORSS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Context(), r.Header, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	if !bck.Props.CORS.IsActive() {
+		s3.WriteErr(w, r, s3.NewErrNoSuchCORS(bucket), 0)
+		return
+	}
+	resp := s3.NewCORSConfiguration(&bck.Props.CORS)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// +gen:endpoint PUT /s3/{bucket-name} [s3.QparamCORS=string] payload=s3-cors
+// +gen:payload s3-cors=<CORSConfiguration><CORSRule><AllowedOrigin>https://example.com</AllowedOrigin><AllowedMethod>GET</AllowedMethod><AllowedMethod>PUT</AllowedMethod><AllowedHeader>*</AllowedHeader></CORSRule></CORSConfiguration>
+// Configure S3 bucket CORS (replaces existing rules, if any)
+func (p *proxy) putBckCORSS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Context(), r.Header, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	cc, err := s3.DecodeCORS(r.Body)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	conf, err := cc.ToNative()
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	p._setBpropsS3(w, r, msg, bck, &cmn.BpropsToSet{CORS: &cmn.CORSConfToSet{Rules: &conf.Rules}})
+}
+
+// +gen:endpoint DELETE /s3/{bucket-name} [s3.QparamCORS=string]
+// Remove S3 bucket CORS configuration
+func (p *proxy) delBckCORSS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Context(), r.Header, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	rules := []cmn.CORSRule{} // (non-nil to override)
+	if p._setBpropsS3(w, r, msg, bck, &cmn.BpropsToSet{CORS: &cmn.CORSConfToSet{Rules: &rules}}) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// +gen:endpoint GET /s3/{bucket-name} [s3.QparamNotification=string]
+// Get S3 bucket notification configuration (empty if none)
+func (p *proxy) getBckNotifS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Context(), r.Header, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	resp := s3.NewNotificationConfiguration(&bck.Props.Notif)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// +gen:endpoint PUT /s3/{bucket-name} [s3.QparamNotification=string] payload=s3-notification
+// +gen:payload s3-notification=<NotificationConfiguration><TopicConfiguration><Id>new-images</Id><Topic>https://hooks.example.com/ais</Topic><Event>s3:ObjectCreated:*</Event><Filter><S3Key><FilterRule><Name>suffix</Name><Value>.jpg</Value></FilterRule></S3Key></Filter></TopicConfiguration></NotificationConfiguration>
+// Configure S3 bucket notifications (replaces existing rules, if any; empty configuration removes all)
+func (p *proxy) putBckNotifS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Context(), r.Header, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	nc, err := s3.DecodeNotification(r.Body)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	conf, err := nc.ToNative()
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	p._setBpropsS3(w, r, msg, bck, &cmn.BpropsToSet{Notif: &cmn.NotifConfToSet{Rules: &conf.Rules}})
+}
+
+// +gen:endpoint GET /s3/{bucket-name} [s3.QparamTagging=string]
+// Get S3 bucket tags
+func (p *proxy) getBckTaggingS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Context(), r.Header, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	if len(bck.Props.Tags) == 0 {
+		s3.WriteErr(w, r, s3.NewErrNoSuchTagSet(bucket), 0)
+		return
+	}
+	resp := s3.NewTagging(bck.Props.Tags)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// +gen:endpoint PUT /s3/{bucket-name} [s3.QparamTagging=string] payload=s3-tagging
+// Set S3 bucket tags (replaces existing tag set, if any)
+func (p *proxy) putBckTaggingS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Context(), r.Header, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	tagging, err := s3.DecodeTagging(r.Body)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	tags := tagging.ToNative()
+	if err := tags.ValidateAsProps(); err != nil {
+		s3.WriteErr(w, r, s3.NewErrInvalidTag(err), 0)
+		return
+	}
+	p._setBpropsS3(w, r, msg, bck, &cmn.BpropsToSet{Tags: &tags})
+}
+
+// +gen:endpoint DELETE /s3/{bucket-name} [s3.QparamTagging=string]
+// Remove S3 bucket tags
+func (p *proxy) delBckTaggingS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Context(), r.Header, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	tags := cmn.Tags{} // (non-nil to override)
+	if p._setBpropsS3(w, r, msg, bck, &cmn.BpropsToSet{Tags: &tags}) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// +gen:endpoint GET /s3/{bucket-name} [s3.QparamObjectLock=string]
+// Get S3 bucket object lock configuration
+func (p *proxy) getBckObjLockS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Context(), r.Header, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	if !bck.Props.ObjectLock.Enabled {
+		s3.WriteErr(w, r, s3.NewErrNoSuchObjLockConf(bucket), 0)
+		return
+	}
+	resp := s3.NewObjectLockConfiguration(&bck.Props.ObjectLock)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// +gen:endpoint PUT /s3/{bucket-name} [s3.QparamObjectLock=string] payload=s3-object-lock
+// +gen:payload s3-object-lock=<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled><Rule><DefaultRetention><Mode>GOVERNANCE</Mode><Days>30</Days></DefaultRetention></Rule></ObjectLockConfiguration>
+// Enable S3 object lock and configure default retention (once enabled, object lock cannot be disabled)
+func (p *proxy) putBckObjLockS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Context(), r.Header, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	olc, err := s3.DecodeObjectLockConfiguration(r.Body)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	conf, err := olc.ToNative()
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	propsToUpdate := cmn.BpropsToSet{
+		ObjectLock: &cmn.ObjectLockConfToSet{Enabled: &conf.Enabled, Mode: &conf.Mode, Days: &conf.Days, Years: &conf.Years},
+	}
+	p._setBpropsS3(w, r, msg, bck, &propsToUpdate)
+}
+
+// OPTIONS /s3/<bucket-name>[/<object-name>]
+// CORS preflight
+func (p *proxy) preflightS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := s3.Preflight(w.Header(), r, &bck.Props.CORS); err != nil {
+		s3.WriteErr(w, r, err, 0)
+	}
+}
+
+// cross-origin (browser) request: add CORS response headers, if configured
+// (non-existing bucket will fail later on)
+func (p *proxy) corsS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	if bck, _, err := meta.InitByNameOnly(bucket, p.owner.bmd); err == nil {
+		s3.SetCORSHeaders(w.Header(), r, &bck.Props.CORS)
+	}
+}
+
+func (p *proxy) _setBpropsS3(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg, bck *meta.Bck, propsToUpdate *cmn.BpropsToSet) bool {
+	// make and validate new props
+	nprops, err := p.makeNewBckProps(bck, propsToUpdate)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return false
+	}
+	if _, err := p.setBprops(msg, bck, nprops); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return false
+	}
+	return true
+}
+
 //
 // misc. utils
 //
@@ -780,6 +1485,7 @@ func (p *proxy) s3Redirect(w http.ResponseWriter, r *http.Request, si *meta.Snod
 		// forward using pub net
 		parsedURL, err := url.Parse(si.URL(cmn.NetPublic))
 		debug.AssertNoErr(err)
+		s3.DelCORSHeaders(w.Header()) // (target will set its own)
 		p.reverseRequest(w, r, si.ID(), parsedURL)
 		return
 	}
@@ -5,6 +5,7 @@
 package ais
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -16,6 +17,7 @@ import (
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/bnotif"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
@@ -468,6 +470,7 @@ func (p *proxy) setBprops(msg *apc.ActMsg, bck *meta.Bck, nprops *cmn.Bprops) (s
 			bargs.hdr = remoteBckProps
 		}
 		nprops = bargs.inheritMerge()
+		nprops.ObjectLock = bprops.ObjectLock // (once enabled, cannot be disabled)
 	default:
 		return "", fmt.Errorf(fmtErrInvaldAction, msg.Action, []string{apc.ActSetBprops, apc.ActResetBprops})
 	}
@@ -1103,6 +1106,9 @@ func (p *proxy) makeNewBckProps(bck *meta.Bck, propsToUpdate *cmn.BpropsToSet, c
 	)
 	nprops = bprops.Clone()
 	nprops.Apply(propsToUpdate)
+	if bprops.ObjectLock.Enabled && !nprops.ObjectLock.Enabled {
+		return nil, fmt.Errorf("%s: %s: once enabled, object lock cannot be disabled", p.si, bck)
+	}
 	if bck.IsCloud() {
 		bv, nv := bck.VersionConf().Enabled, nprops.Versioning.Enabled
 		if bv != nv {
@@ -1162,6 +1168,12 @@ func (p *proxy) makeNewBckProps(bck *meta.Bck, propsToUpdate *cmn.BpropsToSet, c
 		}
 	}
 
+	if propsToUpdate.Notif != nil && cfg.Webhook.IsActive() {
+		if err := p.checkWebhooks(&nprops.Notif, &cfg.Webhook); err != nil {
+			return nil, err
+		}
+	}
+
 	err := nprops.Validate(targetCnt)
 	if err == nil {
 		return nprops, nil // ok
@@ -1174,6 +1186,18 @@ func (p *proxy) makeNewBckProps(bck *meta.Bck, propsToUpdate *cmn.BpropsToSet, c
 	return nprops, err
 }
 
+// webhook hosts vs cluster config (see cmn.WebhookConf)
+func (*proxy) checkWebhooks(conf *cmn.NotifConf, whconf *cmn.WebhookConf) error {
+	ctx, cancel := context.WithTimeout(context.Background(), cmn.Rom.CplaneOperation())
+	defer cancel()
+	for i := range conf.Rules {
+		if err := bnotif.CheckURL(ctx, whconf, conf.Rules[i].URL); err != nil {
+			return fmt.Errorf("invalid notification rule #%d: %v", i+1, err)
+		}
+	}
+	return nil
+}
+
 func _versioning(v bool) string {
 	if v {
 		return "enabled"
@@ -0,0 +1,196 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Bucket ACL emulation: GetBucketAcl and PutBucketAcl map onto native bucket access
+// attributes (`Bprops.Access`), which is a bucket-wide mask of permitted operations
+// that applies to all clients (and further restricts AuthN-granted permissions, if any).
+//
+// Hence, only grants to everyone (AllUsers group) can be expressed, as follows:
+// - private (no AllUsers grants)  <=> apc.AccessAll (default)
+// - public-read                   <=> apc.AccessRO
+// - public-read-write             <=> apc.AccessRW
+//
+// Grants to specific users, emails, and other groups are not supported.
+// See https://docs.aws.amazon.com/AmazonS3/latest/userguide/acl-overview.html
+
+const (
+	HdrAmzACL = "X-Amz-Acl"
+
+	HdrAmzGrantPrefix = "X-Amz-Grant-"
+
+	ACLPrivate         = "private"
+	ACLPublicRead      = "public-read"
+	ACLPublicReadWrite = "public-read-write"
+
+	aclRead        = "READ"
+	aclWrite       = "WRITE"
+	aclReadACP     = "READ_ACP"
+	aclWriteACP    = "WRITE_ACP"
+	aclFullControl = "FULL_CONTROL"
+
+	aclGroupAllUsers = "http://acs.amazonaws.com/groups/global/AllUsers"
+
+	aclGranteeUser  = "CanonicalUser"
+	aclGranteeGroup = "Group"
+
+	xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+)
+
+type (
+	AccessControlPolicy struct {
+		XMLName xml.Name `xml:"AccessControlPolicy"`
+		Ns      string   `xml:"xmlns,attr,omitempty"`
+		Owner   BckOwner `xml:"Owner"`
+		Grants  []Grant  `xml:"AccessControlList>Grant"`
+	}
+	Grant struct {
+		Grantee    Grantee `xml:"Grantee"`
+		Permission string  `xml:"Permission"`
+	}
+	Grantee struct {
+		// (encoding/xml can't read and write namespaced attributes with the same field)
+		XsiNs   string `xml:"xmlns:xsi,attr,omitempty"`
+		XsiType string `xml:"xsi:type,attr,omitempty"`
+		Type    string `xml:"type,attr,omitempty"`
+
+		ID          string `xml:"ID,omitempty"`
+		DisplayName string `xml:"DisplayName,omitempty"`
+		Email       string `xml:"EmailAddress,omitempty"`
+		URI         string `xml:"URI,omitempty"`
+	}
+)
+
+func aisOwner() BckOwner { return BckOwner{ID: "1", Name: AISServer} } // (compare w/ NewListBucketResult)
+
+func NewAccessControlPolicy(access apc.AccessAttrs) *AccessControlPolicy {
+	owner := aisOwner()
+	acp := &AccessControlPolicy{
+		Ns:    s3Namespace,
+		Owner: owner,
+		Grants: []Grant{{
+			Grantee:    Grantee{XsiNs: xsiNamespace, XsiType: aclGranteeUser, ID: owner.ID, DisplayName: owner.Name},
+			Permission: aclFullControl,
+		}},
+	}
+	if access == apc.AccessAll {
+		return acp // private
+	}
+	all := Grantee{XsiNs: xsiNamespace, XsiType: aclGranteeGroup, URI: aclGroupAllUsers}
+	if access.Has(apc.AccessRO) {
+		acp.Grants = append(acp.Grants, Grant{Grantee: all, Permission: aclRead})
+	}
+	if access.Has(apc.AccessRW) {
+		acp.Grants = append(acp.Grants, Grant{Grantee: all, Permission: aclWrite})
+	}
+	if access.Has(apc.AceBckHEAD) && !access.Has(apc.AccessRO) {
+		acp.Grants = append(acp.Grants, Grant{Grantee: all, Permission: aclReadACP})
+	}
+	if access.Has(apc.AceBckSetACL) {
+		acp.Grants = append(acp.Grants, Grant{Grantee: all, Permission: aclWriteACP})
+	}
+	return acp
+}
+
+func (acp *AccessControlPolicy) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(acp)
+	debug.AssertNoErr(err)
+}
+
+// PutBucketAcl: either canned ACL (header) or access control policy (body)
+func ACLFromRequest(r *http.Request) (apc.AccessAttrs, error) {
+	for name := range r.Header {
+		if strings.HasPrefix(name, HdrAmzGrantPrefix) {
+			return 0, NewErrNotImplemented(fmt.Errorf("explicit grants (%s) are not supported - use canned ACL or AllUsers group grants", name))
+		}
+	}
+	if canned := r.Header.Get(HdrAmzACL); canned != "" {
+		return CannedACL(canned)
+	}
+	return decodeACL(r.Body)
+}
+
+func CannedACL(canned string) (apc.AccessAttrs, error) {
+	switch canned {
+	case ACLPrivate:
+		return apc.AccessAll, nil
+	case ACLPublicRead:
+		return apc.AccessRO, nil
+	case ACLPublicReadWrite:
+		return apc.AccessRW, nil
+	default:
+		return 0, NewErrNotImplemented(fmt.Errorf("canned ACL %q is not supported (expecting one of: %s, %s, %s)",
+			canned, ACLPrivate, ACLPublicRead, ACLPublicReadWrite))
+	}
+}
+
+func decodeACL(body io.Reader) (apc.AccessAttrs, error) {
+	acp := &AccessControlPolicy{}
+	if err := xml.NewDecoder(body).Decode(acp); err != nil {
+		if err == io.EOF {
+			return 0, NewErrMalformedACL(fmt.Errorf("expecting either %s header or access control policy", HdrAmzACL))
+		}
+		return 0, NewErrMalformedACL(err)
+	}
+	return acp.ToAccess()
+}
+
+func (acp *AccessControlPolicy) ToAccess() (apc.AccessAttrs, error) {
+	var (
+		access  apc.AccessAttrs
+		grouped bool
+	)
+	for i := range acp.Grants {
+		g := &acp.Grants[i]
+		typ := g.Grantee.Type
+		if typ == "" {
+			typ = g.Grantee.XsiType
+		}
+		switch {
+		case typ == aclGranteeUser && g.Permission == aclFullControl:
+			// (the owner - nothing to do)
+			continue
+		case typ == aclGranteeGroup && g.Grantee.URI == aclGroupAllUsers:
+		default:
+			who := g.Grantee.URI
+			if who == "" {
+				who = typ + ":" + g.Grantee.ID + g.Grantee.Email
+			}
+			return 0, NewErrNotImplemented(fmt.Errorf("grant %s to %q is not supported - bucket access applies to all users", g.Permission, who))
+		}
+		grouped = true
+		switch g.Permission {
+		case aclRead:
+			access |= apc.AccessRO
+		case aclWrite:
+			access |= apc.AccessRW &^ apc.AccessRO
+		case aclReadACP:
+			access |= apc.AceBckHEAD
+		case aclWriteACP:
+			access |= apc.AceBckSetACL | apc.AcePATCH
+		case aclFullControl:
+			access = apc.AccessAll
+		default:
+			return 0, NewErrMalformedACL(fmt.Errorf("invalid permission %q", g.Permission))
+		}
+	}
+	if !grouped {
+		return apc.AccessAll, nil // private
+	}
+	return access, nil
+}
@@ -0,0 +1,121 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+)
+
+// S3 additional checksums: `x-amz-checksum-<algorithm>` request and response headers
+// carrying base64-encoded big-endian digests (compare with AIS hex-encoded cos.Cksum)
+// - https://docs.aws.amazon.com/AmazonS3/latest/userguide/checking-object-integrity.html
+
+const (
+	HdrCksumCRC32C    = "x-amz-checksum-crc32c"
+	HdrCksumCRC64NVME = "x-amz-checksum-crc64nvme"
+	HdrCksumSHA256    = "x-amz-checksum-sha256"
+	HdrCksumType      = "x-amz-checksum-type"
+
+	CksumTypeFullObject = "FULL_OBJECT"
+)
+
+// S3 header <=> AIS checksum type
+var cksumHdrs = [...]struct {
+	hdr, ty string
+}{
+	{HdrCksumCRC64NVME, cos.ChecksumCRC64NVME},
+	{HdrCksumCRC32C, cos.ChecksumCRC32C},
+	{HdrCksumSHA256, cos.ChecksumSHA256},
+}
+
+func cksumHdr(ty string) string {
+	for _, c := range cksumHdrs {
+		if c.ty == ty {
+			return c.hdr
+		}
+	}
+	return ""
+}
+
+// returns the first supported `x-amz-checksum-*` request header (converted), or nil
+func CksumFromHeader(hdr http.Header) (*cos.Cksum, error) {
+	for _, c := range cksumHdrs {
+		if v := hdr.Get(c.hdr); v != "" {
+			return DecodeCksum(c.ty, v)
+		}
+	}
+	return nil, nil
+}
+
+// base64 => cos.Cksum
+func DecodeCksum(ty, b64 string) (*cos.Cksum, error) {
+	b, err := base64.StdEncoding.DecodeString(b64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s checksum %q: %w", ty, b64, err)
+	}
+	cksum := cos.NewCksum(ty, hex.EncodeToString(b))
+	if err := cksum.Validate(); err != nil {
+		return nil, err
+	}
+	return cksum, nil
+}
+
+// cos.Cksum => base64 (empty when the type has no S3 equivalent)
+func EncodeCksum(cksum *cos.Cksum) (hdr, b64 string) {
+	if cos.NoneC(cksum) {
+		return "", ""
+	}
+	if hdr = cksumHdr(cksum.Ty()); hdr == "" {
+		return "", ""
+	}
+	b, err := hex.DecodeString(cksum.Val())
+	if err != nil {
+		return "", ""
+	}
+	return hdr, base64.StdEncoding.EncodeToString(b)
+}
+
+// object checksum to report via `x-amz-checksum-*`:
+// the object's own (if S3-supported), or else CRC64 that was provided by the client (or backend)
+func ObjCksum(lom *core.LOM) *cos.Cksum {
+	if cksum := lom.Checksum(); !cos.NoneC(cksum) && cksumHdr(cksum.Ty()) != "" {
+		return cksum
+	}
+	if v, ok := lom.GetCustomKey(cmn.CRC64ObjMD); ok && v != "" {
+		return cos.NewCksum(cos.ChecksumCRC64NVME, v)
+	}
+	return nil
+}
+
+// full-object checksum of the completed multipart upload
+func (r *CompleteMptUploadResult) SetCksum(lom *core.LOM) {
+	hdr, v := EncodeCksum(ObjCksum(lom))
+	switch hdr {
+	case HdrCksumCRC32C:
+		r.ChecksumCRC32C = v
+	case HdrCksumCRC64NVME:
+		r.ChecksumCRC64NVME = v
+	case HdrCksumSHA256:
+		r.ChecksumSHA256 = v
+	default:
+		return
+	}
+	r.ChecksumType = CksumTypeFullObject
+}
+
+// set `x-amz-checksum-*` and `x-amz-checksum-type` response headers, if available
+func SetCksumHeaders(hdr http.Header, lom *core.LOM) {
+	if h, v := EncodeCksum(ObjCksum(lom)); h != "" {
+		hdr.Set(h, v)
+		hdr.Set(HdrCksumType, CksumTypeFullObject)
+	}
+}
@@ -1,6 +1,6 @@
 // Package s3 provides Amazon S3 compatibility layer
 /*
- * Copyright (c) 2018-2025, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2018-2026, NVIDIA CORPORATION. All rights reserved.
  */
 package s3
 
@@ -14,6 +14,11 @@ const (
 	QparamVersioning        = "versioning" // Configure or retrieve bucket versioning settings
 	QparamLifecycle         = "lifecycle"
 	QparamCORS              = "cors"
+	QparamTagging           = "tagging"
+	QparamObjectLock        = "object-lock"
+	QparamRetention         = "retention"
+	QparamLegalHold         = "legal-hold"
+	QparamNotification      = "notification"
 	QparamPolicy            = "policy"
 	QparamACL               = "acl"
 	QparamMultiDelete       = "delete"             // Delete multiple objects in a single request
@@ -22,6 +27,10 @@ const (
 	QparamContinuationToken = "continuation-token" // Pagination token for continued listing
 	QparamStartAfter        = "start-after"        // Start listing after this object key
 	QparamDelimiter         = "delimiter"          // Delimiter for grouping object keys
+	QparamEncodingType      = "encoding-type"      // Encode object keys in the response ("url")
+	QparamFetchOwner        = "fetch-owner"        // Include object owner (ListObjectsV2)
+	QparamListType          = "list-type"          // "2" for ListObjectsV2 (ListObjects otherwise)
+	QparamMarker            = "marker"             // ListObjects (V1): start listing after this key
 
 	// multipart
 	QparamMptUploads        = "uploads"    // Start multipart upload or list active uploads
@@ -0,0 +1,181 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Bucket CORS configuration: S3 PutBucketCors/GetBucketCors/DeleteBucketCors that map
+// onto native cmn.CORSConf one-to-one; preflight (OPTIONS) requests and CORS response
+// headers for actual cross-origin requests.
+// See https://docs.aws.amazon.com/AmazonS3/latest/userguide/cors.html
+
+const (
+	HdrOrigin = "Origin"
+
+	hdrACRequestMethod    = "Access-Control-Request-Method"
+	hdrACRequestHeaders   = "Access-Control-Request-Headers"
+	hdrACAllowOrigin      = "Access-Control-Allow-Origin"
+	hdrACAllowMethods     = "Access-Control-Allow-Methods"
+	hdrACAllowHeaders     = "Access-Control-Allow-Headers"
+	hdrACAllowCredentials = "Access-Control-Allow-Credentials"
+	hdrACExposeHeaders    = "Access-Control-Expose-Headers"
+	hdrACMaxAge           = "Access-Control-Max-Age"
+	hdrVary               = "Vary"
+)
+
+type (
+	CORSConfiguration struct {
+		XMLName xml.Name   `xml:"CORSConfiguration"`
+		Ns      string     `xml:"xmlns,attr,omitempty"`
+		Rules   []CORSRule `xml:"CORSRule"`
+	}
+	CORSRule struct {
+		ID             string   `xml:"ID,omitempty"`
+		AllowedHeaders []string `xml:"AllowedHeader,omitempty"`
+		AllowedMethods []string `xml:"AllowedMethod"`
+		AllowedOrigins []string `xml:"AllowedOrigin"`
+		ExposeHeaders  []string `xml:"ExposeHeader,omitempty"`
+		MaxAgeSeconds  int      `xml:"MaxAgeSeconds,omitempty"`
+	}
+)
+
+func DecodeCORS(r io.Reader) (*CORSConfiguration, error) {
+	cc := &CORSConfiguration{}
+	if err := xml.NewDecoder(r).Decode(cc); err != nil {
+		return nil, NewErrMalformedXML(fmt.Errorf("malformed CORS configuration: %w", err))
+	}
+	return cc, nil
+}
+
+func NewCORSConfiguration(conf *cmn.CORSConf) *CORSConfiguration {
+	cc := &CORSConfiguration{Ns: s3Namespace, Rules: make([]CORSRule, 0, len(conf.Rules))}
+	for i := range conf.Rules {
+		src := &conf.Rules[i]
+		cc.Rules = append(cc.Rules, CORSRule{
+			ID:             src.ID,
+			AllowedHeaders: src.AllowedHeaders,
+			AllowedMethods: src.AllowedMethods,
+			AllowedOrigins: src.AllowedOrigins,
+			ExposeHeaders:  src.ExposeHeaders,
+			MaxAgeSeconds:  src.MaxAge,
+		})
+	}
+	return cc
+}
+
+func (cc *CORSConfiguration) ToNative() (*cmn.CORSConf, error) {
+	if len(cc.Rules) == 0 {
+		return nil, NewErrMalformedXML(errors.New("malformed CORS configuration: expecting at least one rule"))
+	}
+	conf := &cmn.CORSConf{Rules: make([]cmn.CORSRule, 0, len(cc.Rules))}
+	for i := range cc.Rules {
+		rule := &cc.Rules[i]
+		conf.Rules = append(conf.Rules, cmn.CORSRule{
+			ID:             rule.ID,
+			AllowedOrigins: rule.AllowedOrigins,
+			AllowedMethods: rule.AllowedMethods,
+			AllowedHeaders: rule.AllowedHeaders,
+			ExposeHeaders:  rule.ExposeHeaders,
+			MaxAge:         rule.MaxAgeSeconds,
+		})
+	}
+	if err := conf.ValidateAsProps(); err != nil {
+		return nil, NewErrMalformedXML(err)
+	}
+	return conf, nil
+}
+
+func (cc *CORSConfiguration) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(cc)
+	debug.AssertNoErr(err)
+}
+
+// OPTIONS (preflight): on success, sets response headers and returns nil
+func Preflight(h http.Header, r *http.Request, conf *cmn.CORSConf) error {
+	var (
+		origin = r.Header.Get(HdrOrigin)
+		method = r.Header.Get(hdrACRequestMethod)
+	)
+	if origin == "" || method == "" {
+		return NewErrBadRequest(fmt.Errorf("insufficient information: preflight request requires %s and %s headers",
+			HdrOrigin, hdrACRequestMethod))
+	}
+	if !conf.IsActive() {
+		return NewErrCORSForbidden(errors.New("CORS is not enabled for this bucket"))
+	}
+	var reqHeaders []string
+	if s := r.Header.Get(hdrACRequestHeaders); s != "" {
+		for hdr := range strings.SplitSeq(s, ",") {
+			if hdr = strings.TrimSpace(hdr); hdr != "" {
+				reqHeaders = append(reqHeaders, hdr)
+			}
+		}
+	}
+	rule := conf.Match(origin, method, reqHeaders)
+	if rule == nil {
+		return NewErrCORSForbidden(fmt.Errorf("CORS request (origin %q, method %s) is not allowed", origin, method))
+	}
+	setAllowOrigin(h, origin, rule)
+	h.Set(hdrACAllowMethods, strings.Join(rule.AllowedMethods, ", "))
+	if len(reqHeaders) > 0 {
+		h.Set(hdrACAllowHeaders, strings.Join(reqHeaders, ", "))
+	}
+	if len(rule.ExposeHeaders) > 0 {
+		h.Set(hdrACExposeHeaders, strings.Join(rule.ExposeHeaders, ", "))
+	}
+	if rule.MaxAge > 0 {
+		h.Set(hdrACMaxAge, strconv.Itoa(rule.MaxAge))
+	}
+	return nil
+}
+
+// actual (non-preflight) cross-origin request: sets response headers if permitted
+// (otherwise, the request proceeds as usual, and it is up to the browser to block the response)
+func SetCORSHeaders(h http.Header, r *http.Request, conf *cmn.CORSConf) {
+	origin := r.Header.Get(HdrOrigin)
+	if origin == "" || !conf.IsActive() {
+		return
+	}
+	rule := conf.Match(origin, r.Method, nil)
+	if rule == nil {
+		return
+	}
+	setAllowOrigin(h, origin, rule)
+	if len(rule.ExposeHeaders) > 0 {
+		h.Set(hdrACExposeHeaders, strings.Join(rule.ExposeHeaders, ", "))
+	}
+}
+
+// remove CORS response headers (e.g., prior to reverse-proxying the request
+// to another node that will set its own)
+func DelCORSHeaders(h http.Header) {
+	for _, k := range []string{hdrACAllowOrigin, hdrACAllowMethods, hdrACAllowHeaders, hdrACAllowCredentials,
+		hdrACExposeHeaders, hdrACMaxAge, hdrVary} {
+		h.Del(k)
+	}
+}
+
+func setAllowOrigin(h http.Header, origin string, rule *cmn.CORSRule) {
+	h.Set(hdrVary, HdrOrigin)
+	if rule.AnyOrigin() {
+		h.Set(hdrACAllowOrigin, "*")
+		return
+	}
+	h.Set(hdrACAllowOrigin, origin)
+	h.Set(hdrACAllowCredentials, "true")
+}
@@ -0,0 +1,149 @@
+// Package s3_test provides tests for the Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+const corsXML = `<CORSConfiguration>
+  <CORSRule>
+    <ID>uploads</ID>
+    <AllowedOrigin>https://*.example.com</AllowedOrigin>
+    <AllowedMethod>PUT</AllowedMethod>
+    <AllowedMethod>POST</AllowedMethod>
+    <AllowedHeader>Content-*</AllowedHeader>
+    <AllowedHeader>x-amz-date</AllowedHeader>
+    <ExposeHeader>ETag</ExposeHeader>
+    <MaxAgeSeconds>3000</MaxAgeSeconds>
+  </CORSRule>
+  <CORSRule>
+    <AllowedOrigin>*</AllowedOrigin>
+    <AllowedMethod>GET</AllowedMethod>
+    <AllowedMethod>HEAD</AllowedMethod>
+  </CORSRule>
+</CORSConfiguration>`
+
+func TestCORSConfiguration(t *testing.T) {
+	cc, err := s3.DecodeCORS(strings.NewReader(corsXML))
+	tassert.CheckFatal(t, err)
+	conf, err := cc.ToNative()
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(conf.Rules) == 2, "expecting 2 rules, got %d", len(conf.Rules))
+
+	rule := &conf.Rules[0]
+	tassert.Errorf(t, rule.ID == "uploads" && rule.MaxAge == 3000, "invalid rule: %+v", rule)
+	tassert.Errorf(t, len(rule.AllowedMethods) == 2 && len(rule.AllowedHeaders) == 2 && len(rule.ExposeHeaders) == 1,
+		"invalid rule: %+v", rule)
+
+	// native => S3 => native
+	b, err := xml.Marshal(s3.NewCORSConfiguration(conf))
+	tassert.CheckFatal(t, err)
+	cc, err = s3.DecodeCORS(strings.NewReader(string(b)))
+	tassert.CheckFatal(t, err)
+	conf2, err := cc.ToNative()
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(conf2.Rules) == 2 && conf2.Rules[0].ID == "uploads" && conf2.Rules[1].AllowedOrigins[0] == "*",
+		"round trip: %+v", conf2)
+
+	// invalid
+	for _, bad := range []string{
+		`<CORSConfiguration></CORSConfiguration>`,
+		`<CORSConfiguration><CORSRule><AllowedMethod>GET</AllowedMethod></CORSRule></CORSConfiguration>`,
+		`<CORSConfiguration><CORSRule><AllowedOrigin>*</AllowedOrigin></CORSRule></CORSConfiguration>`,
+		`<CORSConfiguration><CORSRule><AllowedOrigin>*</AllowedOrigin><AllowedMethod>PATCH</AllowedMethod></CORSRule></CORSConfiguration>`,
+		`<CORSConfiguration><CORSRule><AllowedOrigin>https://*.*.com</AllowedOrigin><AllowedMethod>GET</AllowedMethod></CORSRule></CORSConfiguration>`,
+		`<CORSConfiguration><CORSRule>`,
+	} {
+		cc, err := s3.DecodeCORS(strings.NewReader(bad))
+		if err == nil {
+			_, err = cc.ToNative()
+		}
+		tassert.Errorf(t, err != nil, "expecting %s to fail", bad)
+	}
+}
+
+func TestCORSPreflight(t *testing.T) {
+	cc, err := s3.DecodeCORS(strings.NewReader(corsXML))
+	tassert.CheckFatal(t, err)
+	conf, err := cc.ToNative()
+	tassert.CheckFatal(t, err)
+
+	preflight := func(origin, method, headers string) (http.Header, error) {
+		r := httptest.NewRequest(http.MethodOptions, "/s3/abc/obj", http.NoBody)
+		if origin != "" {
+			r.Header.Set("Origin", origin)
+		}
+		if method != "" {
+			r.Header.Set("Access-Control-Request-Method", method)
+		}
+		if headers != "" {
+			r.Header.Set("Access-Control-Request-Headers", headers)
+		}
+		h := make(http.Header)
+		return h, s3.Preflight(h, r, conf)
+	}
+
+	// first rule
+	h, err := preflight("https://app.example.com", http.MethodPut, "content-type, X-Amz-Date")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, h.Get("Access-Control-Allow-Origin") == "https://app.example.com", "allow-origin: %v", h)
+	tassert.Errorf(t, h.Get("Access-Control-Allow-Methods") == "PUT, POST", "allow-methods: %v", h)
+	tassert.Errorf(t, h.Get("Access-Control-Allow-Headers") == "content-type, X-Amz-Date", "allow-headers: %v", h)
+	tassert.Errorf(t, h.Get("Access-Control-Expose-Headers") == "ETag", "expose-headers: %v", h)
+	tassert.Errorf(t, h.Get("Access-Control-Max-Age") == "3000", "max-age: %v", h)
+	tassert.Errorf(t, h.Get("Vary") == "Origin", "vary: %v", h)
+
+	// second rule (any origin)
+	h, err = preflight("http://localhost:3000", http.MethodGet, "")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, h.Get("Access-Control-Allow-Origin") == "*", "allow-origin: %v", h)
+	tassert.Errorf(t, h.Get("Access-Control-Allow-Credentials") == "", "unexpected allow-credentials: %v", h)
+
+	// not allowed
+	for _, args := range [][3]string{
+		{"http://localhost:3000", http.MethodPut, ""},                       // method
+		{"https://app.example.com", http.MethodPut, "Authorization"},        // header
+		{"https://example.com", http.MethodPut, ""},                         // origin (does not match wildcard)
+		{"https://app.example.com", http.MethodDelete, ""},                  // method
+		{"", http.MethodGet, ""},                                            // missing origin
+		{"https://app.example.com", "", ""},                                 // missing method
+		{"https://app.example.com.evil.org", http.MethodPost, "x-amz-date"}, // suffix
+	} {
+		_, err := preflight(args[0], args[1], args[2])
+		tassert.Errorf(t, err != nil, "expecting preflight %v to fail", args)
+	}
+}
+
+func TestCORSActualRequest(t *testing.T) {
+	cc, err := s3.DecodeCORS(strings.NewReader(corsXML))
+	tassert.CheckFatal(t, err)
+	conf, err := cc.ToNative()
+	tassert.CheckFatal(t, err)
+
+	r := httptest.NewRequest(http.MethodPut, "/s3/abc/obj", http.NoBody)
+	r.Header.Set("Origin", "https://app.example.com")
+	h := make(http.Header)
+	s3.SetCORSHeaders(h, r, conf)
+	tassert.Errorf(t, h.Get("Access-Control-Allow-Origin") == "https://app.example.com", "allow-origin: %v", h)
+	tassert.Errorf(t, h.Get("Access-Control-Allow-Credentials") == "true", "allow-credentials: %v", h)
+	tassert.Errorf(t, h.Get("Access-Control-Expose-Headers") == "ETag", "expose-headers: %v", h)
+
+	s3.DelCORSHeaders(h)
+	tassert.Errorf(t, len(h) == 0, "expecting no headers, got %v", h)
+
+	// not matching: no headers
+	r = httptest.NewRequest(http.MethodDelete, "/s3/abc/obj", http.NoBody)
+	r.Header.Set("Origin", "https://app.example.com")
+	s3.SetCORSHeaders(h, r, conf)
+	tassert.Errorf(t, len(h) == 0, "expecting no headers, got %v", h)
+}
@@ -73,6 +73,21 @@ func WriteErr(w http.ResponseWriter, r *http.Request, err error, ecode int) {
 		ok        bool
 		allocated bool
 	)
+	if ec := asErrCoded(err); ec != nil && ecode == 0 {
+		ecode = ec.status
+	}
+	if ecode == 0 && errors.Is(err, core.ErrSSECKey) {
+		ecode = http.StatusBadRequest
+	}
+	if ecode == 0 && cmn.IsErrObjLocked(err) {
+		ecode = http.StatusForbidden
+	}
+	if ecode == 0 && cmn.IsErrQuotaExceeded(err) {
+		ecode = http.StatusForbidden // (rather than 507 - S3 clients retry 5xx)
+	}
+	if ecode == 0 && errors.Is(err, cmn.ErrQuotaSizeUnknown) {
+		ecode = http.StatusLengthRequired
+	}
 	if in, ok = err.(*cmn.ErrHTTP); !ok {
 		in = cmn.InitErrHTTP(r, err, ecode)
 		allocated = true
@@ -85,6 +100,18 @@ func WriteErr(w http.ResponseWriter, r *http.Request, err error, ecode int) {
 		out.Code = "NoSuchBucket"
 	case isErrNoSuchUpload(err):
 		out.Code = "NoSuchUpload"
+	case cos.IsErrBadCksum(err):
+		out.Code = "BadDigest"
+	case errors.Is(err, core.ErrSSECKey):
+		out.Code = "InvalidRequest"
+	case cmn.IsErrObjLocked(err):
+		out.Code = "AccessDenied"
+	case cmn.IsErrQuotaExceeded(err):
+		out.Code = "QuotaExceeded"
+	case errors.Is(err, cmn.ErrQuotaSizeUnknown):
+		out.Code = "MissingContentLength"
+	case asErrCoded(err) != nil:
+		out.Code = asErrCoded(err).code
 	case in.TypeCode != "":
 		out.Code = in.TypeCode
 	default:
@@ -136,3 +163,93 @@ func isErrNoSuchUpload(err error) bool {
 	var errMpt *errNoSuchUpload
 	return errors.As(err, &errMpt)
 }
+
+// S3 error with a specific (named) code, e.g. "NoSuchLifecycleConfiguration"
+// see https://docs.aws.amazon.com/AmazonS3/latest/API/ErrorResponses.html#ErrorCodeList
+type errCoded struct {
+	err    error
+	code   string
+	status int
+}
+
+func NewErrNoSuchLifecycle(bucket string) error {
+	err := fmt.Errorf("bucket %q: the lifecycle configuration does not exist", bucket)
+	return &errCoded{code: "NoSuchLifecycleConfiguration", err: err, status: http.StatusNotFound}
+}
+
+func NewErrNoSuchPolicy(bucket string) error {
+	err := fmt.Errorf("bucket %q: the bucket policy does not exist", bucket)
+	return &errCoded{code: "NoSuchBucketPolicy", err: err, status: http.StatusNotFound}
+}
+
+func NewErrNoSuchCORS(bucket string) error {
+	err := fmt.Errorf("bucket %q: the CORS configuration does not exist", bucket)
+	return &errCoded{code: "NoSuchCORSConfiguration", err: err, status: http.StatusNotFound}
+}
+
+func NewErrNoSuchTagSet(bucket string) error {
+	err := fmt.Errorf("bucket %q: the tag set does not exist", bucket)
+	return &errCoded{code: "NoSuchTagSet", err: err, status: http.StatusNotFound}
+}
+
+func NewErrNoSuchObjLockConf(bucket string) error {
+	err := fmt.Errorf("bucket %q: object lock configuration does not exist", bucket)
+	return &errCoded{code: "ObjectLockConfigurationNotFoundError", err: err, status: http.StatusNotFound}
+}
+
+func NewErrNoSuchObjRetention(cname string) error {
+	err := fmt.Errorf("%s: object retention does not exist", cname)
+	return &errCoded{code: "NoSuchObjectLockConfiguration", err: err, status: http.StatusNotFound}
+}
+
+func NewErrObjLockNotEnabled() error {
+	err := errors.New("bucket is missing object lock configuration")
+	return &errCoded{code: "InvalidRequest", err: err, status: http.StatusBadRequest}
+}
+
+func NewErrInvalidTag(err error) error {
+	return &errCoded{code: "InvalidTag", err: err, status: http.StatusBadRequest}
+}
+
+func NewErrCORSForbidden(err error) error {
+	return &errCoded{code: "AccessForbidden", err: err, status: http.StatusForbidden}
+}
+
+func NewErrBadRequest(err error) error {
+	return &errCoded{code: "BadRequest", err: err, status: http.StatusBadRequest}
+}
+
+func NewErrMalformedXML(err error) error {
+	return &errCoded{code: "MalformedXML", err: err, status: http.StatusBadRequest}
+}
+
+func NewErrMalformedPolicy(err error) error {
+	return &errCoded{code: "MalformedPolicy", err: err, status: http.StatusBadRequest}
+}
+
+func NewErrMalformedACL(err error) error {
+	return &errCoded{code: "MalformedACLError", err: err, status: http.StatusBadRequest}
+}
+
+func NewErrInvalidArgument(err error) error {
+	return &errCoded{code: "InvalidArgument", err: err, status: http.StatusBadRequest}
+}
+
+func NewErrInvalidRange(err error) error {
+	return &errCoded{code: "InvalidRange", err: err, status: http.StatusRequestedRangeNotSatisfiable}
+}
+
+func NewErrNotImplemented(err error) error {
+	return &errCoded{code: "NotImplemented", err: err, status: http.StatusNotImplemented}
+}
+
+func (e *errCoded) Error() string { return e.err.Error() }
+func (e *errCoded) Unwrap() error { return e.err }
+
+func asErrCoded(err error) *errCoded {
+	var ec *errCoded
+	if errors.As(err, &ec) {
+		return ec
+	}
+	return nil
+}
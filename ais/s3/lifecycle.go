@@ -0,0 +1,202 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Bucket lifecycle configuration: a subset of S3 PutBucketLifecycleConfiguration
+// that maps onto native cmn.LifecycleConf, as follows:
+// - Expiration.Days                                => expiration (delete)
+// - Transition.Days (any storage class)            => evict (remote buckets only)
+// - AbortIncompleteMultipartUpload.DaysAfterInitiation => abort_mpt
+// Not supported: expiration and transition dates, filtering by tags and/or object size,
+// noncurrent versions, and expired-object delete markers.
+// See https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketLifecycleConfiguration.html
+
+const (
+	lcyEnabled  = "Enabled"
+	lcyDisabled = "Disabled"
+
+	lcyDay = 24 * time.Hour
+)
+
+type (
+	LifecycleConfiguration struct {
+		XMLName xml.Name        `xml:"LifecycleConfiguration"`
+		Ns      string          `xml:"xmlns,attr,omitempty"`
+		Rules   []LifecycleRule `xml:"Rule"`
+	}
+	LifecycleRule struct {
+		ID                             string                    `xml:"ID,omitempty"`
+		Filter                         *LifecycleFilter          `xml:"Filter,omitempty"`
+		Prefix                         *string                   `xml:"Prefix,omitempty"` // deprecated (in favor of Filter.Prefix)
+		Status                         string                    `xml:"Status"`
+		Expiration                     *LifecycleExpiration      `xml:"Expiration,omitempty"`
+		Transitions                    []LifecycleTransition     `xml:"Transition,omitempty"`
+		AbortIncompleteMultipartUpload *LifecycleAbortMpt        `xml:"AbortIncompleteMultipartUpload,omitempty"`
+		NoncurrentVersionExpiration    *lifecycleUnsupportedXML  `xml:"NoncurrentVersionExpiration,omitempty"`
+		NoncurrentVersionTransitions   []lifecycleUnsupportedXML `xml:"NoncurrentVersionTransition,omitempty"`
+	}
+	LifecycleFilter struct {
+		Prefix                string                   `xml:"Prefix,omitempty"`
+		Tag                   *lifecycleUnsupportedXML `xml:"Tag,omitempty"`
+		And                   *lifecycleUnsupportedXML `xml:"And,omitempty"`
+		ObjectSizeGreaterThan *int64                   `xml:"ObjectSizeGreaterThan,omitempty"`
+		ObjectSizeLessThan    *int64                   `xml:"ObjectSizeLessThan,omitempty"`
+	}
+	LifecycleExpiration struct {
+		Days                      int    `xml:"Days,omitempty"`
+		Date                      string `xml:"Date,omitempty"`
+		ExpiredObjectDeleteMarker *bool  `xml:"ExpiredObjectDeleteMarker,omitempty"`
+	}
+	LifecycleTransition struct {
+		Days         int    `xml:"Days,omitempty"`
+		Date         string `xml:"Date,omitempty"`
+		StorageClass string `xml:"StorageClass,omitempty"`
+	}
+	LifecycleAbortMpt struct {
+		DaysAfterInitiation int `xml:"DaysAfterInitiation"`
+	}
+
+	// placeholder for recognized-but-unsupported elements
+	lifecycleUnsupportedXML struct {
+		Inner []byte `xml:",innerxml"`
+	}
+)
+
+func DecodeLifecycle(r io.Reader) (*LifecycleConfiguration, error) {
+	lcy := &LifecycleConfiguration{}
+	if err := xml.NewDecoder(r).Decode(lcy); err != nil {
+		return nil, fmt.Errorf("malformed lifecycle configuration: %w", err)
+	}
+	return lcy, nil
+}
+
+func NewLifecycleConfiguration(conf *cmn.LifecycleConf) *LifecycleConfiguration {
+	lcy := &LifecycleConfiguration{Ns: s3Namespace, Rules: make([]LifecycleRule, 0, len(conf.Rules))}
+	for i := range conf.Rules {
+		var (
+			src  = &conf.Rules[i]
+			rule = LifecycleRule{ID: src.ID, Filter: &LifecycleFilter{Prefix: src.Prefix}, Status: lcyEnabled}
+		)
+		if src.Disabled {
+			rule.Status = lcyDisabled
+		}
+		if src.Expiration > 0 {
+			rule.Expiration = &LifecycleExpiration{Days: toDays(src.Expiration)}
+		}
+		if src.Evict > 0 {
+			rule.Transitions = []LifecycleTransition{{Days: toDays(src.Evict)}}
+		}
+		if src.AbortMpt > 0 {
+			rule.AbortIncompleteMultipartUpload = &LifecycleAbortMpt{DaysAfterInitiation: toDays(src.AbortMpt)}
+		}
+		lcy.Rules = append(lcy.Rules, rule)
+	}
+	return lcy
+}
+
+// (rounding up)
+func toDays(d cos.Duration) int { return int((d.D() + lcyDay - 1) / lcyDay) }
+
+func (lcy *LifecycleConfiguration) ToNative() (*cmn.LifecycleConf, error) {
+	if len(lcy.Rules) == 0 {
+		return nil, errors.New("malformed lifecycle configuration: expecting at least one rule")
+	}
+	conf := &cmn.LifecycleConf{Enabled: true, Rules: make([]cmn.LifecycleRule, 0, len(lcy.Rules))}
+	for i := range lcy.Rules {
+		rule, err := lcy.Rules[i].toNative(i)
+		if err != nil {
+			return nil, err
+		}
+		conf.Rules = append(conf.Rules, rule)
+	}
+	return conf, conf.ValidateAsProps()
+}
+
+func (lcy *LifecycleConfiguration) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(lcy)
+	debug.AssertNoErr(err)
+}
+
+func (rule *LifecycleRule) toNative(idx int) (out cmn.LifecycleRule, _ error) {
+	out.ID = rule.ID
+	if out.ID == "" {
+		out.ID = "rule-" + strconv.Itoa(idx+1) // (S3 generates one when not specified)
+	}
+	switch rule.Status {
+	case lcyEnabled:
+	case lcyDisabled:
+		out.Disabled = true
+	default:
+		return out, fmt.Errorf("lifecycle rule %q: invalid status %q (expecting %q or %q)", out.ID, rule.Status, lcyEnabled, lcyDisabled)
+	}
+
+	// filter
+	switch {
+	case rule.Filter != nil && rule.Prefix != nil:
+		return out, fmt.Errorf("lifecycle rule %q: Filter and (deprecated) Prefix are mutually exclusive", out.ID)
+	case rule.Prefix != nil:
+		out.Prefix = *rule.Prefix
+	case rule.Filter != nil:
+		f := rule.Filter
+		if f.Tag != nil || f.And != nil || f.ObjectSizeGreaterThan != nil || f.ObjectSizeLessThan != nil {
+			return out, errLcyUnsupp(out.ID, "filtering by tags and/or object size")
+		}
+		out.Prefix = f.Prefix
+	}
+
+	// actions
+	if rule.NoncurrentVersionExpiration != nil || len(rule.NoncurrentVersionTransitions) > 0 {
+		return out, errLcyUnsupp(out.ID, "noncurrent version actions")
+	}
+	if e := rule.Expiration; e != nil {
+		switch {
+		case e.Date != "":
+			return out, errLcyUnsupp(out.ID, "expiration date")
+		case e.ExpiredObjectDeleteMarker != nil:
+			return out, errLcyUnsupp(out.ID, "expired object delete marker")
+		case e.Days <= 0:
+			return out, fmt.Errorf("lifecycle rule %q: expiration days must be a positive integer", out.ID)
+		}
+		out.Expiration = cos.Duration(time.Duration(e.Days) * lcyDay)
+	}
+	for _, t := range rule.Transitions {
+		switch {
+		case t.Date != "":
+			return out, errLcyUnsupp(out.ID, "transition date")
+		case t.Days <= 0:
+			return out, fmt.Errorf("lifecycle rule %q: transition days must be a positive integer", out.ID)
+		}
+		// multiple transitions (storage classes) collapse into a single eviction - the earliest
+		if d := cos.Duration(time.Duration(t.Days) * lcyDay); out.Evict == 0 || d < out.Evict {
+			out.Evict = d
+		}
+	}
+	if a := rule.AbortIncompleteMultipartUpload; a != nil {
+		if a.DaysAfterInitiation <= 0 {
+			return out, fmt.Errorf("lifecycle rule %q: days after initiation must be a positive integer", out.ID)
+		}
+		out.AbortMpt = cos.Duration(time.Duration(a.DaysAfterInitiation) * lcyDay)
+	}
+	return out, nil
+}
+
+func errLcyUnsupp(id, what string) error {
+	return fmt.Errorf("lifecycle rule %q: %s not supported", id, what)
+}
@@ -0,0 +1,107 @@
+// Package s3_test provides tests for the Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+const day = 24 * time.Hour
+
+func TestLifecycleToNative(t *testing.T) {
+	body := `<LifecycleConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <Rule>
+    <ID>expire-logs</ID>
+    <Filter><Prefix>logs/</Prefix></Filter>
+    <Status>Enabled</Status>
+    <Expiration><Days>30</Days></Expiration>
+    <AbortIncompleteMultipartUpload><DaysAfterInitiation>7</DaysAfterInitiation></AbortIncompleteMultipartUpload>
+  </Rule>
+  <Rule>
+    <Prefix>cache/</Prefix>
+    <Status>Disabled</Status>
+    <Transition><Days>90</Days><StorageClass>GLACIER</StorageClass></Transition>
+    <Transition><Days>10</Days><StorageClass>STANDARD_IA</StorageClass></Transition>
+  </Rule>
+</LifecycleConfiguration>`
+
+	lcy, err := s3.DecodeLifecycle(strings.NewReader(body))
+	tassert.CheckFatal(t, err)
+	conf, err := lcy.ToNative()
+	tassert.CheckFatal(t, err)
+
+	tassert.Fatalf(t, conf.Enabled && conf.IsActive(), "expected active lifecycle")
+	tassert.Fatalf(t, len(conf.Rules) == 2, "expected 2 rules, got %d", len(conf.Rules))
+
+	r0 := conf.Rules[0]
+	tassert.Errorf(t, r0.ID == "expire-logs" && r0.Prefix == "logs/" && !r0.Disabled, "unexpected rule: %+v", r0)
+	tassert.Errorf(t, r0.Expiration.D() == 30*day, "expected 30d expiration, got %v", r0.Expiration)
+	tassert.Errorf(t, r0.AbortMpt.D() == 7*day, "expected 7d abort-mpt, got %v", r0.AbortMpt)
+	tassert.Errorf(t, r0.Evict == 0, "unexpected evict %v", r0.Evict)
+
+	r1 := conf.Rules[1]
+	tassert.Errorf(t, r1.ID == "rule-2", "expected generated ID, got %q", r1.ID)
+	tassert.Errorf(t, r1.Prefix == "cache/" && r1.Disabled, "unexpected rule: %+v", r1)
+	tassert.Errorf(t, r1.Evict.D() == 10*day, "expected the earliest transition (10d), got %v", r1.Evict)
+
+	tassert.Errorf(t, conf.AbortMptAge("logs/a.log") == 7*day, "expected 7d abort age")
+	tassert.Errorf(t, conf.AbortMptAge("data/a.bin") == 0, "expected no abort age")
+}
+
+func TestLifecycleRoundTrip(t *testing.T) {
+	conf := &cmn.LifecycleConf{
+		Enabled: true,
+		Rules: []cmn.LifecycleRule{
+			{ID: "a", Prefix: "tmp/", Expiration: cos.Duration(3 * day)},
+			{ID: "b", Evict: cos.Duration(36 * time.Hour), AbortMpt: cos.Duration(day)},
+		},
+	}
+	b, err := xml.Marshal(s3.NewLifecycleConfiguration(conf))
+	tassert.CheckFatal(t, err)
+
+	lcy, err := s3.DecodeLifecycle(strings.NewReader(string(b)))
+	tassert.CheckFatal(t, err)
+	out, err := lcy.ToNative()
+	tassert.CheckFatal(t, err)
+
+	tassert.Fatalf(t, len(out.Rules) == 2, "expected 2 rules, got %d", len(out.Rules))
+	tassert.Errorf(t, out.Rules[0] == conf.Rules[0], "expected %+v, got %+v", conf.Rules[0], out.Rules[0])
+	// 36h rounds up to 2 days
+	tassert.Errorf(t, out.Rules[1].Evict.D() == 2*day, "expected 2d evict, got %v", out.Rules[1].Evict)
+}
+
+func TestLifecycleInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		rule string
+	}{
+		{"no rules", ``},
+		{"bad status", `<Rule><ID>x</ID><Status>On</Status><Expiration><Days>1</Days></Expiration></Rule>`},
+		{"no action", `<Rule><ID>x</ID><Status>Enabled</Status></Rule>`},
+		{"zero days", `<Rule><ID>x</ID><Status>Enabled</Status><Expiration><Days>0</Days></Expiration></Rule>`},
+		{"date", `<Rule><ID>x</ID><Status>Enabled</Status><Expiration><Date>2030-01-01T00:00:00Z</Date></Expiration></Rule>`},
+		{"tag filter", `<Rule><ID>x</ID><Filter><Tag><Key>k</Key><Value>v</Value></Tag></Filter><Status>Enabled</Status><Expiration><Days>1</Days></Expiration></Rule>`},
+		{"noncurrent", `<Rule><ID>x</ID><Status>Enabled</Status><NoncurrentVersionExpiration><NoncurrentDays>1</NoncurrentDays></NoncurrentVersionExpiration></Rule>`},
+		{"duplicate ID", `<Rule><ID>x</ID><Status>Enabled</Status><Expiration><Days>1</Days></Expiration></Rule>` +
+			`<Rule><ID>x</ID><Status>Enabled</Status><Expiration><Days>2</Days></Expiration></Rule>`},
+		{"filter and prefix", `<Rule><ID>x</ID><Prefix>a</Prefix><Filter><Prefix>b</Prefix></Filter><Status>Enabled</Status><Expiration><Days>1</Days></Expiration></Rule>`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			lcy, err := s3.DecodeLifecycle(strings.NewReader("<LifecycleConfiguration>" + test.rule + "</LifecycleConfiguration>"))
+			tassert.CheckFatal(t, err)
+			_, err = lcy.ToNative()
+			tassert.Fatalf(t, err != nil, "expected error")
+		})
+	}
+}
@@ -0,0 +1,222 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// ListObjectsV2 and ListObjects (V1) on top of native list-objects:
+// - the caller (proxy) reads native pages one at a time and feeds them to `LsoCtx.Add`
+//   until the latter returns true (page full or nothing left to list);
+// - with a delimiter, keys are rolled up into common prefixes (any delimiter, any prefix);
+//   with the "/" delimiter and a "directory" prefix (empty or ending with "/") the rollup
+//   is mostly done natively, by the non-recursive listing (`apc.LsNoRecursion`);
+// - S3 `max-keys` counts both keys and common prefixes, and so the response may end
+//   in the middle of a native page. Therefore, the (opaque) S3 continuation token
+//   carries both the native token of the page to resume from and the last returned
+//   name (key or common prefix) - the one to resume after.
+// see also:
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_ListObjectsV2.html
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_ListObjects.html
+
+const encodingURL = "url"
+
+type (
+	LsoCtx struct {
+		res        *ListObjectResult
+		prefix     string
+		delimiter  string
+		after      string // skip names (keys and common prefixes) <= after
+		last       string // last added name
+		maxKeys    int
+		v2         bool
+		encodeURL  bool
+		fetchOwner bool
+	}
+	lsoToken struct {
+		native string // continuation token of the native page to resume from
+		after  string // last returned name
+	}
+)
+
+func NewLsoCtx(bucket string, query url.Values) (*LsoCtx, error) {
+	ctx := &LsoCtx{
+		res:       NewListObjectResult(bucket),
+		prefix:    query.Get(QparamPrefix),
+		delimiter: query.Get(QparamDelimiter),
+		maxKeys:   apc.MaxPageSizeAWS,
+		v2:        query.Get(QparamListType) == "2",
+	}
+	if s := query.Get(QparamMaxKeys); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 0 {
+			return nil, NewErrInvalidArgument(fmt.Errorf("invalid %s %q", QparamMaxKeys, s))
+		}
+		if n > 0 {
+			ctx.maxKeys = min(n, apc.MaxPageSizeAWS)
+		}
+	}
+	switch enc := query.Get(QparamEncodingType); enc {
+	case "":
+	case encodingURL:
+		ctx.encodeURL = true
+	default:
+		return nil, NewErrInvalidArgument(fmt.Errorf("invalid %s %q (expecting %q)", QparamEncodingType, enc, encodingURL))
+	}
+
+	res := ctx.res
+	res.MaxKeys = ctx.maxKeys
+	res.Prefix = ctx.encode(ctx.prefix)
+	res.Delimiter = ctx.encode(ctx.delimiter)
+	if ctx.encodeURL {
+		res.EncodingType = encodingURL
+	}
+	if !ctx.v2 {
+		// V1: always includes owner; "marker" is a key (or a common prefix) to list after
+		ctx.fetchOwner = true
+		ctx.after = query.Get(QparamMarker)
+		res.Marker = ctx.encode(ctx.after)
+		return ctx, nil
+	}
+
+	ctx.fetchOwner = cos.IsParseBool(query.Get(QparamFetchOwner))
+	// `start-after` is used only when starting to list pages, subsequent next-page calls
+	// utilize `continuation-token`
+	if token := query.Get(QparamContinuationToken); token != "" {
+		res.ContinuationToken = token
+	} else if after := query.Get(QparamStartAfter); after != "" {
+		ctx.after = after
+		res.StartAfter = ctx.encode(after)
+	}
+	return ctx, nil
+}
+
+// initialize native list-objects message; `remote` is true when listing
+// a remote bucket (where `start-after` is not supported - see lsPage)
+func (ctx *LsoCtx) InitMsg(msg *apc.LsoMsg, remote bool) error {
+	msg.Prefix = ctx.prefix
+	msg.PageSize = int64(ctx.maxKeys)
+	if ctx.res.ContinuationToken != "" {
+		token, err := decodeLsoToken(ctx.res.ContinuationToken)
+		if err != nil {
+			return err
+		}
+		msg.ContinuationToken = token.native
+		ctx.after = token.after
+	} else if !remote {
+		msg.StartAfter = ctx.after
+	}
+	if ctx.delimiter == "/" && (ctx.prefix == "" || cos.IsLastB(ctx.prefix, '/')) {
+		msg.SetFlag(apc.LsNoRecursion)
+	}
+	return nil
+}
+
+// add native page that was read using `token`;
+// return true when done: either the page is full or the listing is complete
+func (ctx *LsoCtx) Add(lst *cmn.LsoRes, token string) bool {
+	// lexicographic order is required to roll up and to resume
+	cmn.SortLsoLex(lst.Entries)
+
+	res := ctx.res
+	for _, en := range lst.Entries {
+		name, isPrefix := ctx.rollup(en)
+		if name <= ctx.after || name == ctx.last {
+			continue
+		}
+		if res.KeyCount == ctx.maxKeys {
+			res.IsTruncated = true
+			if ctx.v2 {
+				res.NextContinuationToken = (&lsoToken{native: token, after: ctx.last}).encode()
+			} else if ctx.delimiter != "" {
+				// (without delimiter, V1 clients use the last key as the next marker)
+				res.NextMarker = ctx.encode(ctx.last)
+			}
+			return true
+		}
+		if isPrefix {
+			res.CommonPrefixes = append(res.CommonPrefixes, &CommonPrefix{Prefix: ctx.encode(name)})
+		} else {
+			oi := entryToS3(en)
+			oi.Key = ctx.encode(oi.Key)
+			if ctx.fetchOwner {
+				owner := aisOwner()
+				oi.Owner = &owner
+			}
+			res.Contents = append(res.Contents, oi)
+		}
+		ctx.last = name
+		res.KeyCount++
+	}
+	return lst.ContinuationToken == ""
+}
+
+func (ctx *LsoCtx) Result() *ListObjectResult { return ctx.res }
+
+// returns the name to list: either the object name itself or the common prefix it rolls up into
+func (ctx *LsoCtx) rollup(en *cmn.LsoEnt) (string, bool) {
+	if en.IsAnyFlagSet(apc.EntryIsDir) {
+		// virtual directory (non-recursive listing)
+		if cos.IsLastB(en.Name, '/') {
+			return en.Name, true
+		}
+		return en.Name + "/", true
+	}
+	if ctx.delimiter == "" {
+		return en.Name, false
+	}
+	rest, ok := strings.CutPrefix(en.Name, ctx.prefix)
+	if !ok {
+		return en.Name, false
+	}
+	if i := strings.Index(rest, ctx.delimiter); i >= 0 {
+		return en.Name[:len(ctx.prefix)+i+len(ctx.delimiter)], true
+	}
+	return en.Name, false
+}
+
+// encoding-type=url: percent-encode, with spaces as "%20" (rather than "+")
+func (ctx *LsoCtx) encode(s string) string {
+	if !ctx.encodeURL || s == "" {
+		return s
+	}
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
+
+//
+// continuation token: base64(len(native) ':' native after)
+//
+
+func (t *lsoToken) encode() string {
+	s := strconv.Itoa(len(t.native)) + ":" + t.native + t.after
+	return base64.RawURLEncoding.EncodeToString(cos.UnsafeB(s))
+}
+
+func decodeLsoToken(s string) (*lsoToken, error) {
+	errInvalid := NewErrInvalidArgument(errors.New("the continuation token provided is incorrect"))
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errInvalid
+	}
+	ls, rest, ok := strings.Cut(string(b), ":")
+	if !ok {
+		return nil, errInvalid
+	}
+	l, err := strconv.Atoi(ls)
+	if err != nil || l < 0 || l > len(rest) {
+		return nil, errInvalid
+	}
+	return &lsoToken{native: rest[:l], after: rest[l:]}, nil
+}
@@ -0,0 +1,160 @@
+// Package s3_test provides tests for the Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func lsoPage(names ...string) *cmn.LsoRes {
+	lst := &cmn.LsoRes{}
+	for _, name := range names {
+		lst.Entries = append(lst.Entries, &cmn.LsoEnt{Name: name})
+	}
+	return lst
+}
+
+// list all (S3) pages of the given native listing (single native page)
+func lsoAll(t *testing.T, names []string, query url.Values) (keys, prefixes []string, npages int) {
+	for {
+		ctx, err := s3.NewLsoCtx("bck", query)
+		tassert.CheckFatal(t, err)
+		msg := &apc.LsoMsg{}
+		tassert.CheckFatal(t, ctx.InitMsg(msg, false))
+		tassert.Fatalf(t, msg.ContinuationToken == "", "unexpected native token %q", msg.ContinuationToken)
+
+		tassert.Fatalf(t, ctx.Add(lsoPage(names...), ""), "expecting done")
+		res := ctx.Result()
+		for _, oi := range res.Contents {
+			keys = append(keys, oi.Key)
+		}
+		for _, cp := range res.CommonPrefixes {
+			prefixes = append(prefixes, cp.Prefix)
+		}
+		npages++
+		tassert.Fatalf(t, res.KeyCount == len(res.Contents)+len(res.CommonPrefixes), "invalid key count %d", res.KeyCount)
+		tassert.Fatalf(t, res.KeyCount <= res.MaxKeys, "key count %d exceeds max-keys %d", res.KeyCount, res.MaxKeys)
+		if !res.IsTruncated {
+			return keys, prefixes, npages
+		}
+		tassert.Fatalf(t, res.NextContinuationToken != "", "truncated w/o continuation token")
+		query.Del(s3.QparamStartAfter)
+		query.Set(s3.QparamContinuationToken, res.NextContinuationToken)
+	}
+}
+
+func TestListObjectsDelimiter(t *testing.T) {
+	names := []string{"a/1", "a/2", "a/b/3", "b", "c-1/x", "c-2", "c/d/e", "c/f", "d"}
+	tests := []struct {
+		prefix, delimiter string
+		keys, prefixes    []string
+	}{
+		{"", "", names, nil},
+		{"", "/", []string{"b", "c-2", "d"}, []string{"a/", "c-1/", "c/"}},
+		{"c", "/", []string{"c-2"}, []string{"c-1/", "c/"}},
+		{"c/", "/", []string{"c/f"}, []string{"c/d/"}},
+		{"", "-", []string{"a/1", "a/2", "a/b/3", "b", "c/d/e", "c/f", "d"}, []string{"c-"}},
+		{"a", "/b/", []string{"a/1", "a/2"}, []string{"a/b/"}},
+	}
+	for _, test := range tests {
+		var filtered []string
+		for _, name := range names {
+			if strings.HasPrefix(name, test.prefix) {
+				filtered = append(filtered, name)
+			}
+		}
+		for _, maxKeys := range []string{"", "1", "2"} {
+			query := url.Values{}
+			query.Set(s3.QparamListType, "2")
+			query.Set(s3.QparamPrefix, test.prefix)
+			query.Set(s3.QparamDelimiter, test.delimiter)
+			query.Set(s3.QparamMaxKeys, maxKeys)
+			keys, prefixes, _ := lsoAll(t, filtered, query)
+			tassert.Errorf(t, strings.Join(keys, ",") == strings.Join(test.keys, ","),
+				"(%q, %q, max-keys %q): expected keys %v, got %v", test.prefix, test.delimiter, maxKeys, test.keys, keys)
+			tassert.Errorf(t, strings.Join(prefixes, ",") == strings.Join(test.prefixes, ","),
+				"(%q, %q, max-keys %q): expected prefixes %v, got %v", test.prefix, test.delimiter, maxKeys, test.prefixes, prefixes)
+		}
+	}
+}
+
+func TestListObjectsPages(t *testing.T) {
+	names := []string{"a/1", "a/2", "b", "c/1", "d", "e"}
+	query := url.Values{}
+	query.Set(s3.QparamListType, "2")
+	query.Set(s3.QparamDelimiter, "/")
+	query.Set(s3.QparamMaxKeys, "2")
+	query.Set(s3.QparamStartAfter, "a/2")
+	keys, prefixes, npages := lsoAll(t, names, query)
+	tassert.Errorf(t, strings.Join(keys, ",") == "b,d,e", "unexpected keys %v", keys)
+	tassert.Errorf(t, strings.Join(prefixes, ",") == "c/", "unexpected prefixes %v", prefixes)
+	tassert.Errorf(t, npages == 2, "expected 2 pages, got %d", npages)
+
+	// virtual directories (non-recursive native listing)
+	query = url.Values{}
+	query.Set(s3.QparamListType, "2")
+	query.Set(s3.QparamDelimiter, "/")
+	ctx, err := s3.NewLsoCtx("bck", query)
+	tassert.CheckFatal(t, err)
+	msg := &apc.LsoMsg{}
+	tassert.CheckFatal(t, ctx.InitMsg(msg, false))
+	tassert.Errorf(t, msg.IsFlagSet(apc.LsNoRecursion), "expecting non-recursive native listing")
+	lst := lsoPage("x", "a")
+	lst.Entries = append(lst.Entries, &cmn.LsoEnt{Name: "b", Flags: apc.EntryIsDir})
+	ctx.Add(lst, "")
+	res := ctx.Result()
+	tassert.Fatalf(t, len(res.CommonPrefixes) == 1 && res.CommonPrefixes[0].Prefix == "b/", "unexpected prefixes %+v", res.CommonPrefixes)
+	tassert.Errorf(t, len(res.Contents) == 2 && res.Contents[0].Key == "a", "unexpected contents")
+
+	// invalid token
+	query.Set(s3.QparamContinuationToken, "not-a-token!")
+	ctx, err = s3.NewLsoCtx("bck", query)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, ctx.InitMsg(&apc.LsoMsg{}, false) != nil, "expecting invalid continuation token error")
+}
+
+func TestListObjectsV1(t *testing.T) {
+	names := []string{"a b/1", "a b/2", "a+c", "d/e"}
+	query := url.Values{}
+	query.Set(s3.QparamDelimiter, "/")
+	query.Set(s3.QparamMaxKeys, "2")
+	query.Set(s3.QparamEncodingType, "url")
+	ctx, err := s3.NewLsoCtx("bck", query)
+	tassert.CheckFatal(t, err)
+	ctx.Add(lsoPage(names...), "")
+	res := ctx.Result()
+	tassert.Fatalf(t, res.IsTruncated, "expecting truncated")
+	tassert.Errorf(t, res.NextContinuationToken == "", "V1: unexpected continuation token")
+	tassert.Errorf(t, res.EncodingType == "url", "expecting encoding type")
+	tassert.Fatalf(t, len(res.CommonPrefixes) == 1 && res.CommonPrefixes[0].Prefix == "a%20b%2F",
+		"unexpected prefixes %+v", res.CommonPrefixes)
+	tassert.Fatalf(t, len(res.Contents) == 1 && res.Contents[0].Key == "a%2Bc", "unexpected contents %+v", res.Contents)
+	tassert.Errorf(t, res.Contents[0].Owner != nil, "V1: expecting owner")
+	tassert.Errorf(t, res.NextMarker == "a%2Bc", "unexpected next marker %q", res.NextMarker)
+
+	// next page
+	query.Set(s3.QparamMarker, "a+c")
+	ctx, err = s3.NewLsoCtx("bck", query)
+	tassert.CheckFatal(t, err)
+	msg := &apc.LsoMsg{}
+	tassert.CheckFatal(t, ctx.InitMsg(msg, false))
+	tassert.Errorf(t, msg.StartAfter == "a+c", "expecting native start-after, got %q", msg.StartAfter)
+	ctx.Add(lsoPage(names...), "")
+	res = ctx.Result()
+	tassert.Errorf(t, !res.IsTruncated && len(res.CommonPrefixes) == 1 && res.CommonPrefixes[0].Prefix == "d%2F",
+		"unexpected result %+v", res)
+
+	// invalid encoding type
+	query.Set(s3.QparamEncodingType, "base64")
+	_, err = s3.NewLsoCtx("bck", query)
+	tassert.Errorf(t, err != nil, "expecting invalid encoding type error")
+}
@@ -1,6 +1,6 @@
 // Package s3 provides Amazon S3 compatibility layer
 /*
- * Copyright (c) 2018-2025, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2018-2026, NVIDIA CORPORATION. All rights reserved.
  */
 package s3
 
@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"net/http"
 	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -148,3 +150,31 @@ slow:
 	}
 	return 0, nil
 }
+
+// UploadPartCopy: parse `x-amz-copy-source-range` ("bytes=first-last", both inclusive and required)
+// against the source object size; empty header selects the entire object
+// see https://docs.aws.amazon.com/AmazonS3/latest/API/API_UploadPartCopy.html
+func ParseCopySrcRange(hdr string, size int64) (start, length int64, err error) {
+	if hdr == "" {
+		return 0, size, nil
+	}
+	var first, last string
+	spec, ok := strings.CutPrefix(hdr, cos.HdrRangeValPrefix)
+	if ok {
+		first, last, ok = strings.Cut(spec, "-")
+	}
+	if !ok {
+		return 0, 0, NewErrInvalidArgument(fmt.Errorf("invalid %s %q: expecting \"bytes=first-last\"", cos.S3HdrObjSrcRange, hdr))
+	}
+	end, err := strconv.ParseInt(last, 10, 64)
+	if err == nil {
+		start, err = strconv.ParseInt(first, 10, 64)
+	}
+	if err != nil || start < 0 || end < start {
+		return 0, 0, NewErrInvalidArgument(fmt.Errorf("invalid %s %q", cos.S3HdrObjSrcRange, hdr))
+	}
+	if end >= size {
+		return 0, 0, NewErrInvalidRange(fmt.Errorf("%s %q is out of bounds (source size %d)", cos.S3HdrObjSrcRange, hdr, size))
+	}
+	return start, end - start + 1, nil
+}
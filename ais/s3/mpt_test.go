@@ -0,0 +1,48 @@
+// Package s3_test provides tests for the Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestParseCopySrcRange(t *testing.T) {
+	const size = 1000
+	tests := []struct {
+		hdr    string
+		start  int64
+		length int64
+		status int // expected error status, if any
+	}{
+		{hdr: "", start: 0, length: size},
+		{hdr: "bytes=0-0", start: 0, length: 1},
+		{hdr: "bytes=10-99", start: 10, length: 90},
+		{hdr: "bytes=0-999", start: 0, length: size},
+		{hdr: "bytes=0-1000", status: http.StatusRequestedRangeNotSatisfiable},
+		{hdr: "bytes=10-", status: http.StatusBadRequest},
+		{hdr: "bytes=-10", status: http.StatusBadRequest},
+		{hdr: "bytes=20-10", status: http.StatusBadRequest},
+		{hdr: "10-20", status: http.StatusBadRequest},
+		{hdr: "bytes=a-b", status: http.StatusBadRequest},
+	}
+	for _, test := range tests {
+		start, length, err := s3.ParseCopySrcRange(test.hdr, size)
+		if test.status != 0 {
+			tassert.Fatalf(t, err != nil, "%q: expecting error", test.hdr)
+			w := httptest.NewRecorder()
+			s3.WriteErr(w, httptest.NewRequest(http.MethodPut, "/s3/abc/obj", http.NoBody), err, 0)
+			tassert.Errorf(t, w.Code == test.status, "%q: expecting status %d, got %d", test.hdr, test.status, w.Code)
+			continue
+		}
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, start == test.start && length == test.length, "%q: expecting (%d, %d), got (%d, %d)",
+			test.hdr, test.start, test.length, start, length)
+	}
+}
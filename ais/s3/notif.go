@@ -0,0 +1,116 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Bucket notifications: S3 Get/PutBucketNotificationConfiguration that map onto native
+// cmn.NotifConf. AIS delivers events to HTTP(S) webhooks: each TopicConfiguration's
+// <Topic> is the webhook URL (rather than SNS topic ARN); SQS queue and Lambda function
+// configurations are not supported. An empty configuration removes all rules.
+// See https://docs.aws.amazon.com/AmazonS3/latest/userguide/EventNotifications.html
+
+const (
+	notifFilterPrefix = "prefix"
+	notifFilterSuffix = "suffix"
+)
+
+type (
+	NotificationConfiguration struct {
+		XMLName xml.Name    `xml:"NotificationConfiguration"`
+		Ns      string      `xml:"xmlns,attr,omitempty"`
+		Topics  []TopicConf `xml:"TopicConfiguration"`
+		Queues  []struct{}  `xml:"QueueConfiguration,omitempty"`         // (not supported)
+		Lambdas []struct{}  `xml:"CloudFunctionConfiguration,omitempty"` // (ditto)
+		Bridge  *struct{}   `xml:"EventBridgeConfiguration,omitempty"`   // (ditto)
+	}
+	TopicConf struct {
+		ID     string       `xml:"Id,omitempty"`
+		Topic  string       `xml:"Topic"`
+		Events []string     `xml:"Event"`
+		Filter *NotifFilter `xml:"Filter,omitempty"`
+	}
+	NotifFilter struct {
+		Key struct {
+			Rules []FilterRule `xml:"FilterRule"`
+		} `xml:"S3Key"`
+	}
+	FilterRule struct {
+		Name  string `xml:"Name"`
+		Value string `xml:"Value"`
+	}
+)
+
+func DecodeNotification(r io.Reader) (*NotificationConfiguration, error) {
+	nc := &NotificationConfiguration{}
+	if err := xml.NewDecoder(r).Decode(nc); err != nil {
+		return nil, NewErrMalformedXML(fmt.Errorf("malformed notification configuration: %w", err))
+	}
+	return nc, nil
+}
+
+func NewNotificationConfiguration(conf *cmn.NotifConf) *NotificationConfiguration {
+	nc := &NotificationConfiguration{Ns: s3Namespace, Topics: make([]TopicConf, 0, len(conf.Rules))}
+	for i := range conf.Rules {
+		src := &conf.Rules[i]
+		tc := TopicConf{ID: src.ID, Topic: src.URL, Events: src.Events}
+		if src.Prefix != "" || src.Suffix != "" {
+			tc.Filter = &NotifFilter{}
+			if src.Prefix != "" {
+				tc.Filter.Key.Rules = append(tc.Filter.Key.Rules, FilterRule{Name: notifFilterPrefix, Value: src.Prefix})
+			}
+			if src.Suffix != "" {
+				tc.Filter.Key.Rules = append(tc.Filter.Key.Rules, FilterRule{Name: notifFilterSuffix, Value: src.Suffix})
+			}
+		}
+		nc.Topics = append(nc.Topics, tc)
+	}
+	return nc
+}
+
+// (empty configuration: no rules)
+func (nc *NotificationConfiguration) ToNative() (*cmn.NotifConf, error) {
+	if len(nc.Queues) > 0 || len(nc.Lambdas) > 0 || nc.Bridge != nil {
+		return nil, NewErrNotImplemented(errors.New("only TopicConfiguration (with webhook URL as Topic) is supported"))
+	}
+	conf := &cmn.NotifConf{Rules: make([]cmn.NotifRule, 0, len(nc.Topics))}
+	for i := range nc.Topics {
+		tc := &nc.Topics[i]
+		rule := cmn.NotifRule{ID: tc.ID, URL: tc.Topic, Events: tc.Events}
+		if tc.Filter != nil {
+			for _, fr := range tc.Filter.Key.Rules {
+				switch fr.Name {
+				case notifFilterPrefix, "Prefix":
+					rule.Prefix = fr.Value
+				case notifFilterSuffix, "Suffix":
+					rule.Suffix = fr.Value
+				default:
+					return nil, NewErrMalformedXML(fmt.Errorf("invalid filter rule name %q (expecting %q or %q)",
+						fr.Name, notifFilterPrefix, notifFilterSuffix))
+				}
+			}
+		}
+		conf.Rules = append(conf.Rules, rule)
+	}
+	if err := conf.ValidateAsProps(); err != nil {
+		return nil, NewErrBadRequest(err)
+	}
+	return conf, nil
+}
+
+func (nc *NotificationConfiguration) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(nc)
+	debug.AssertNoErr(err)
+}
@@ -0,0 +1,72 @@
+// Package s3_test provides tests for the Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+const notifXML = `<NotificationConfiguration>
+  <TopicConfiguration>
+    <Id>new-images</Id>
+    <Topic>https://hooks.example.com/ais</Topic>
+    <Event>s3:ObjectCreated:*</Event>
+    <Event>s3:ObjectRemoved:Delete</Event>
+    <Filter><S3Key>
+      <FilterRule><Name>prefix</Name><Value>img/</Value></FilterRule>
+      <FilterRule><Name>suffix</Name><Value>.jpg</Value></FilterRule>
+    </S3Key></Filter>
+  </TopicConfiguration>
+</NotificationConfiguration>`
+
+func TestNotification(t *testing.T) {
+	nc, err := s3.DecodeNotification(strings.NewReader(notifXML))
+	tassert.CheckFatal(t, err)
+	conf, err := nc.ToNative()
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(conf.Rules) == 1, "expecting 1 rule, got %+v", conf.Rules)
+	rule := conf.Rules[0]
+	tassert.Errorf(t, rule.ID == "new-images" && rule.URL == "https://hooks.example.com/ais", "rule: %+v", rule)
+	tassert.Errorf(t, rule.Prefix == "img/" && rule.Suffix == ".jpg" && len(rule.Events) == 2, "rule: %+v", rule)
+	tassert.Errorf(t, len(conf.Match(cmn.NotifPut, "img/a.jpg")) == 1, "expecting match")
+
+	// round trip
+	var buf bytes.Buffer
+	tassert.CheckFatal(t, xml.NewEncoder(&buf).Encode(s3.NewNotificationConfiguration(conf)))
+	again, err := s3.DecodeNotification(&buf)
+	tassert.CheckFatal(t, err)
+	conf2, err := again.ToNative()
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(conf2.Rules) == 1 && conf2.Rules[0].Prefix == rule.Prefix && conf2.Rules[0].Suffix == rule.Suffix,
+		"round trip: %+v vs %+v", conf2.Rules, conf.Rules)
+
+	// empty configuration: no rules
+	nc, err = s3.DecodeNotification(strings.NewReader("<NotificationConfiguration/>"))
+	tassert.CheckFatal(t, err)
+	conf, err = nc.ToNative()
+	tassert.Errorf(t, err == nil && !conf.IsActive() && conf.Rules != nil, "expecting empty (non-nil) rules, got %+v, %v", conf, err)
+}
+
+func TestNotificationInvalid(t *testing.T) {
+	tests := []string{
+		`<NotificationConfiguration><QueueConfiguration><Queue>arn:aws:sqs:us-east-1:1:q</Queue><Event>s3:ObjectCreated:*</Event></QueueConfiguration></NotificationConfiguration>`,
+		`<NotificationConfiguration><TopicConfiguration><Topic>arn:aws:sns:us-east-1:1:t</Topic><Event>s3:ObjectCreated:*</Event></TopicConfiguration></NotificationConfiguration>`,
+		`<NotificationConfiguration><TopicConfiguration><Topic>http://localhost:8000</Topic><Event>s3:ObjectRestore:*</Event></TopicConfiguration></NotificationConfiguration>`,
+		`<NotificationConfiguration><TopicConfiguration><Topic>http://localhost:8000</Topic><Event>s3:ObjectCreated:*</Event><Filter><S3Key><FilterRule><Name>regex</Name><Value>.*</Value></FilterRule></S3Key></Filter></TopicConfiguration></NotificationConfiguration>`,
+	}
+	for _, x := range tests {
+		nc, err := s3.DecodeNotification(strings.NewReader(x))
+		tassert.CheckFatal(t, err)
+		_, err = nc.ToNative()
+		tassert.Errorf(t, err != nil, "expecting error: %s", x)
+	}
+}
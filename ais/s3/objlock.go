@@ -0,0 +1,238 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Object lock: S3 Get/PutObjectLockConfiguration (bucket), Get/PutObjectRetention and
+// Get/PutObjectLegalHold (object), and the respective PUT, HEAD, GET, and DELETE headers.
+// Bucket configuration maps onto native cmn.ObjectLockConf; per-object retention and legal hold
+// persist in the object's custom metadata. For semantics, see cmn/objlock.go.
+// See https://docs.aws.amazon.com/AmazonS3/latest/userguide/object-lock.html
+
+const (
+	HdrObjLockMode        = "x-amz-object-lock-mode"
+	HdrObjLockRetainUntil = "x-amz-object-lock-retain-until-date"
+	HdrObjLockLegalHold   = "x-amz-object-lock-legal-hold"
+	HdrBypassGovernance   = "x-amz-bypass-governance-retention"
+	HdrBckObjLockEnabled  = "x-amz-bucket-object-lock-enabled" // CreateBucket
+
+	objLockEnabled = "Enabled"
+)
+
+type (
+	ObjectLockConfiguration struct {
+		XMLName           xml.Name        `xml:"ObjectLockConfiguration"`
+		Ns                string          `xml:"xmlns,attr,omitempty"`
+		ObjectLockEnabled string          `xml:"ObjectLockEnabled,omitempty"`
+		Rule              *ObjectLockRule `xml:"Rule,omitempty"`
+	}
+	ObjectLockRule struct {
+		DefaultRetention *DefaultRetention `xml:"DefaultRetention"`
+	}
+	DefaultRetention struct {
+		Mode  string `xml:"Mode"`
+		Days  int    `xml:"Days,omitempty"`
+		Years int    `xml:"Years,omitempty"`
+	}
+
+	Retention struct {
+		XMLName         xml.Name `xml:"Retention"`
+		Ns              string   `xml:"xmlns,attr,omitempty"`
+		Mode            string   `xml:"Mode,omitempty"`
+		RetainUntilDate string   `xml:"RetainUntilDate,omitempty"`
+	}
+
+	LegalHold struct {
+		XMLName xml.Name `xml:"LegalHold"`
+		Ns      string   `xml:"xmlns,attr,omitempty"`
+		Status  string   `xml:"Status"`
+	}
+)
+
+/////////////////////////////
+// ObjectLockConfiguration //
+/////////////////////////////
+
+func DecodeObjectLockConfiguration(r io.Reader) (*ObjectLockConfiguration, error) {
+	conf := &ObjectLockConfiguration{}
+	if err := xml.NewDecoder(r).Decode(conf); err != nil {
+		return nil, NewErrMalformedXML(fmt.Errorf("malformed object lock configuration: %w", err))
+	}
+	return conf, nil
+}
+
+func NewObjectLockConfiguration(c *cmn.ObjectLockConf) *ObjectLockConfiguration {
+	conf := &ObjectLockConfiguration{Ns: s3Namespace, ObjectLockEnabled: objLockEnabled}
+	if c.Mode != "" {
+		conf.Rule = &ObjectLockRule{DefaultRetention: &DefaultRetention{Mode: c.Mode, Days: c.Days, Years: c.Years}}
+	}
+	return conf
+}
+
+// (validated by the caller as part of the bucket props)
+func (conf *ObjectLockConfiguration) ToNative() (*cmn.ObjectLockConf, error) {
+	if conf.ObjectLockEnabled != objLockEnabled {
+		return nil, NewErrMalformedXML(fmt.Errorf("invalid ObjectLockEnabled %q (expecting %q)",
+			conf.ObjectLockEnabled, objLockEnabled))
+	}
+	c := &cmn.ObjectLockConf{Enabled: true}
+	if conf.Rule != nil {
+		dr := conf.Rule.DefaultRetention
+		if dr == nil {
+			return nil, NewErrMalformedXML(errors.New("object lock rule: missing DefaultRetention"))
+		}
+		c.Mode, c.Days, c.Years = dr.Mode, dr.Days, dr.Years
+	}
+	return c, nil
+}
+
+func (conf *ObjectLockConfiguration) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(conf)
+	debug.AssertNoErr(err)
+}
+
+///////////////
+// Retention //
+///////////////
+
+func DecodeRetention(r io.Reader) (*Retention, error) {
+	ret := &Retention{}
+	if err := xml.NewDecoder(r).Decode(ret); err != nil {
+		return nil, NewErrMalformedXML(fmt.Errorf("malformed retention: %w", err))
+	}
+	return ret, nil
+}
+
+func NewRetention(r *cmn.ObjRetention) *Retention {
+	return &Retention{Ns: s3Namespace, Mode: r.Mode, RetainUntilDate: r.Until.Format(time.RFC3339)}
+}
+
+// empty retention (no mode and no date) removes existing retention, if permitted
+func (ret *Retention) ToNative() (*cmn.ObjRetention, error) {
+	if ret.Mode == "" && ret.RetainUntilDate == "" {
+		return nil, nil
+	}
+	r, err := cmn.NewObjRetention(ret.Mode, ret.RetainUntilDate)
+	if err != nil {
+		return nil, NewErrMalformedXML(err)
+	}
+	return r, nil
+}
+
+func (ret *Retention) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(ret)
+	debug.AssertNoErr(err)
+}
+
+///////////////
+// LegalHold //
+///////////////
+
+func DecodeLegalHold(r io.Reader) (*LegalHold, error) {
+	hold := &LegalHold{}
+	if err := xml.NewDecoder(r).Decode(hold); err != nil {
+		return nil, NewErrMalformedXML(fmt.Errorf("malformed legal hold: %w", err))
+	}
+	return hold, nil
+}
+
+func NewLegalHold(on bool) *LegalHold {
+	return &LegalHold{Ns: s3Namespace, Status: cos.Ternary(on, cmn.LegalHoldOn, cmn.LegalHoldOff)}
+}
+
+func (hold *LegalHold) ToNative() (bool, error) {
+	return parseLegalHold(hold.Status)
+}
+
+func (hold *LegalHold) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(hold)
+	debug.AssertNoErr(err)
+}
+
+func parseLegalHold(s string) (bool, error) {
+	switch s {
+	case cmn.LegalHoldOn:
+		return true, nil
+	case cmn.LegalHoldOff:
+		return false, nil
+	default:
+		return false, NewErrMalformedXML(fmt.Errorf("invalid legal hold status %q (expecting %s or %s)",
+			s, cmn.LegalHoldOn, cmn.LegalHoldOff))
+	}
+}
+
+/////////////
+// headers //
+/////////////
+
+// PUT object with `x-amz-object-lock-*` headers
+func ObjLockFromHeader(hdr http.Header, oa *cmn.ObjAttrs, enabled bool) error {
+	var (
+		mode  = hdr.Get(HdrObjLockMode)
+		until = hdr.Get(HdrObjLockRetainUntil)
+		hold  = hdr.Get(HdrObjLockLegalHold)
+	)
+	if mode == "" && until == "" && hold == "" {
+		return nil
+	}
+	if !enabled {
+		return NewErrObjLockNotEnabled()
+	}
+	if mode != "" || until != "" {
+		r, err := cmn.NewObjRetention(mode, until)
+		if err != nil {
+			return NewErrBadRequest(err)
+		}
+		oa.SetRetention(r)
+	}
+	if hold != "" {
+		on, err := parseLegalHold(hold)
+		if err != nil {
+			return NewErrBadRequest(err)
+		}
+		oa.SetLegalHold(on)
+	}
+	return nil
+}
+
+func IsBypassGovernance(hdr http.Header) bool {
+	return cos.IsParseBool(hdr.Get(HdrBypassGovernance))
+}
+
+func IsBckObjLockEnabled(hdr http.Header) bool {
+	return strings.EqualFold(hdr.Get(HdrBckObjLockEnabled), "true")
+}
+
+func setObjLockHeaders(hdr http.Header, lom *core.LOM) {
+	if !lom.Bprops().ObjectLock.Enabled {
+		return
+	}
+	oa := lom.ObjAttrs()
+	if r, ok := oa.Retention(); ok {
+		hdr.Set(HdrObjLockMode, r.Mode)
+		hdr.Set(HdrObjLockRetainUntil, r.Until.Format(time.RFC3339))
+	}
+	if oa.LegalHold() {
+		hdr.Set(HdrObjLockLegalHold, cmn.LegalHoldOn)
+	}
+}
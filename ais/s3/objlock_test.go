@@ -0,0 +1,103 @@
+// Package s3_test provides tests for the Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+const objLockXML = `<ObjectLockConfiguration>
+  <ObjectLockEnabled>Enabled</ObjectLockEnabled>
+  <Rule><DefaultRetention><Mode>GOVERNANCE</Mode><Days>30</Days></DefaultRetention></Rule>
+</ObjectLockConfiguration>`
+
+func TestObjectLockConfiguration(t *testing.T) {
+	olc, err := s3.DecodeObjectLockConfiguration(strings.NewReader(objLockXML))
+	tassert.CheckFatal(t, err)
+	conf, err := olc.ToNative()
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, conf.Enabled && conf.Mode == cmn.ObjLockGovernance && conf.Days == 30, "conf: %+v", conf)
+	tassert.CheckError(t, conf.ValidateAsProps())
+
+	// round trip
+	var buf bytes.Buffer
+	tassert.CheckFatal(t, xml.NewEncoder(&buf).Encode(s3.NewObjectLockConfiguration(conf)))
+	again, err := s3.DecodeObjectLockConfiguration(&buf)
+	tassert.CheckFatal(t, err)
+	conf2, err := again.ToNative()
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, *conf2 == *conf, "round trip: %+v vs %+v", conf2, conf)
+
+	// enabled without default retention
+	olc, err = s3.DecodeObjectLockConfiguration(strings.NewReader("<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled></ObjectLockConfiguration>"))
+	tassert.CheckFatal(t, err)
+	conf, err = olc.ToNative()
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, conf.Enabled && conf.Mode == "", "conf: %+v", conf)
+
+	olc, err = s3.DecodeObjectLockConfiguration(strings.NewReader("<ObjectLockConfiguration></ObjectLockConfiguration>"))
+	tassert.CheckFatal(t, err)
+	_, err = olc.ToNative()
+	tassert.Errorf(t, err != nil, "expecting error (ObjectLockEnabled missing)")
+}
+
+func TestObjectRetentionLegalHold(t *testing.T) {
+	ret, err := s3.DecodeRetention(strings.NewReader("<Retention><Mode>COMPLIANCE</Mode><RetainUntilDate>2030-01-01T00:00:00Z</RetainUntilDate></Retention>"))
+	tassert.CheckFatal(t, err)
+	nr, err := ret.ToNative()
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, nr.Mode == cmn.ObjLockCompliance && nr.Until.Year() == 2030, "retention: %+v", nr)
+	tassert.Errorf(t, s3.NewRetention(nr).RetainUntilDate == "2030-01-01T00:00:00Z", "round trip")
+
+	ret, err = s3.DecodeRetention(strings.NewReader("<Retention></Retention>"))
+	tassert.CheckFatal(t, err)
+	nr, err = ret.ToNative()
+	tassert.Errorf(t, err == nil && nr == nil, "empty retention: expecting removal, got %+v, %v", nr, err)
+
+	ret, err = s3.DecodeRetention(strings.NewReader("<Retention><Mode>FOREVER</Mode><RetainUntilDate>2030-01-01T00:00:00Z</RetainUntilDate></Retention>"))
+	tassert.CheckFatal(t, err)
+	_, err = ret.ToNative()
+	tassert.Errorf(t, err != nil, "expecting invalid mode error")
+
+	hold, err := s3.DecodeLegalHold(strings.NewReader("<LegalHold><Status>ON</Status></LegalHold>"))
+	tassert.CheckFatal(t, err)
+	on, err := hold.ToNative()
+	tassert.Errorf(t, err == nil && on, "legal hold: %t, %v", on, err)
+	_, err = s3.NewLegalHold(false).ToNative()
+	tassert.CheckError(t, err)
+	hold.Status = "maybe"
+	_, err = hold.ToNative()
+	tassert.Errorf(t, err != nil, "expecting invalid status error")
+}
+
+func TestObjLockFromHeader(t *testing.T) {
+	hdr := http.Header{}
+	oa := &cmn.ObjAttrs{}
+	tassert.CheckError(t, s3.ObjLockFromHeader(hdr, oa, false))
+
+	hdr.Set(s3.HdrObjLockMode, cmn.ObjLockGovernance)
+	hdr.Set(s3.HdrObjLockRetainUntil, "2030-01-01T00:00:00Z")
+	hdr.Set(s3.HdrObjLockLegalHold, cmn.LegalHoldOn)
+	tassert.Errorf(t, s3.ObjLockFromHeader(hdr, oa, false) != nil, "expecting error (object lock not enabled)")
+	tassert.CheckFatal(t, s3.ObjLockFromHeader(hdr, oa, true))
+	r, ok := oa.Retention()
+	tassert.Errorf(t, ok && r.Mode == cmn.ObjLockGovernance, "retention: %+v", r)
+	tassert.Errorf(t, oa.LegalHold(), "expecting legal hold")
+
+	hdr.Set(s3.HdrObjLockRetainUntil, "tomorrow")
+	tassert.Errorf(t, s3.ObjLockFromHeader(hdr, &cmn.ObjAttrs{}, true) != nil, "expecting invalid date error")
+
+	hdr.Set(s3.HdrBypassGovernance, "true")
+	hdr.Set(s3.HdrBckObjLockEnabled, "True")
+	tassert.Errorf(t, s3.IsBypassGovernance(hdr) && s3.IsBckObjLockEnabled(hdr), "expecting true")
+}
@@ -0,0 +1,254 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Bucket policy emulation: a subset of IAM-style JSON bucket policies that translates
+// into native bucket access attributes (`Bprops.Access`) - a bucket-wide mask of permitted
+// operations that applies to all clients. Therefore:
+// - the only supported principal is everyone ("*" or {"AWS": "*"});
+// - resources must refer to the entire bucket ("arn:aws:s3:::BUCKET" and/or "arn:aws:s3:::BUCKET/*");
+// - conditions are not supported;
+// - resulting access = (union of all allowed actions, or everything when there are no "Allow"
+//   statements) minus (union of all denied actions).
+//
+// GetBucketPolicy returns a policy synthesized from the current access attributes
+// (rather than the original document).
+// See https://docs.aws.amazon.com/AmazonS3/latest/userguide/bucket-policies.html
+
+const (
+	policyVersion = "2012-10-17"
+	policyAllow   = "Allow"
+	policyDeny    = "Deny"
+	policyArnPref = "arn:aws:s3:::"
+	policyAnyone  = "*"
+)
+
+type (
+	BucketPolicy struct {
+		Version   string   `json:"Version"`
+		ID        string   `json:"Id,omitempty"`
+		Statement stmtList `json:"Statement"`
+	}
+	PolicyStatement struct {
+		Sid       string         `json:"Sid,omitempty"`
+		Effect    string         `json:"Effect"`
+		Principal any            `json:"Principal,omitempty"` // "*" or {"AWS": "*" | [...]}
+		Action    strList        `json:"Action"`
+		Resource  strList        `json:"Resource"`
+		Condition map[string]any `json:"Condition,omitempty"`
+
+		NotPrincipal any     `json:"NotPrincipal,omitempty"`
+		NotAction    strList `json:"NotAction,omitempty"`
+		NotResource  strList `json:"NotResource,omitempty"`
+	}
+
+	// (IAM allows either a single element or a list)
+	strList  []string
+	stmtList []PolicyStatement
+)
+
+// S3 action => AIS access
+var policyActions = []struct {
+	action string
+	access apc.AccessAttrs
+}{
+	{"s3:GetObject", apc.AceGET | apc.AceObjHEAD},
+	{"s3:PutObject", apc.AcePUT | apc.AceAPPEND},
+	{"s3:DeleteObject", apc.AceObjDELETE},
+	{"s3:ListBucket", apc.AceObjLIST | apc.AceBckHEAD},
+	{"s3:GetBucketLocation", apc.AceBckHEAD},
+	{"s3:GetBucketPolicy", apc.AceBckHEAD},
+	{"s3:GetBucketAcl", apc.AceBckHEAD},
+	{"s3:PutBucketPolicy", apc.AceBckSetACL},
+	{"s3:PutBucketAcl", apc.AceBckSetACL},
+	{"s3:DeleteBucketPolicy", apc.AceBckSetACL},
+	{"s3:PutBucketVersioning", apc.AcePATCH},
+	{"s3:PutLifecycleConfiguration", apc.AcePATCH},
+	{"s3:AbortMultipartUpload", apc.AcePUT},
+	{"s3:ListMultipartUploadParts", apc.AcePUT},
+	{"s3:ListBucketMultipartUploads", apc.AceObjLIST},
+	{"s3:DeleteBucket", apc.AceDestroyBucket},
+}
+
+func (l *strList) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := cos.JSON.Unmarshal(b, &s); err == nil {
+		*l = strList{s}
+		return nil
+	}
+	var ls []string
+	if err := cos.JSON.Unmarshal(b, &ls); err != nil {
+		return errors.New("expecting string or list of strings")
+	}
+	*l = ls
+	return nil
+}
+
+func (l *stmtList) UnmarshalJSON(b []byte) error {
+	var stmt PolicyStatement
+	if err := cos.JSON.Unmarshal(b, &stmt); err == nil {
+		*l = stmtList{stmt}
+		return nil
+	}
+	var ls []PolicyStatement
+	if err := cos.JSON.Unmarshal(b, &ls); err != nil {
+		return err
+	}
+	*l = ls
+	return nil
+}
+
+// PutBucketPolicy => access
+func ParsePolicy(body []byte, bucket string) (apc.AccessAttrs, error) {
+	policy := &BucketPolicy{}
+	if err := cos.JSON.Unmarshal(body, policy); err != nil {
+		return 0, NewErrMalformedPolicy(fmt.Errorf("invalid policy document: %v", err))
+	}
+	if len(policy.Statement) == 0 {
+		return 0, NewErrMalformedPolicy(errors.New("policy has no statements"))
+	}
+	var (
+		allow, deny apc.AccessAttrs
+		allowing    bool
+	)
+	for i := range policy.Statement {
+		stmt := &policy.Statement[i]
+		access, err := stmt.toAccess(bucket)
+		if err != nil {
+			sid := stmt.Sid
+			if sid == "" {
+				sid = "#" + strconv.Itoa(i)
+			}
+			return 0, fmt.Errorf("statement %s: %w", sid, err)
+		}
+		if stmt.Effect == policyAllow {
+			allow |= access
+			allowing = true
+		} else {
+			deny |= access
+		}
+	}
+	if !allowing {
+		allow = apc.AccessAll
+	}
+	return allow &^ deny, nil
+}
+
+func (stmt *PolicyStatement) toAccess(bucket string) (access apc.AccessAttrs, _ error) {
+	switch stmt.Effect {
+	case policyAllow, policyDeny:
+	default:
+		return 0, NewErrMalformedPolicy(fmt.Errorf("invalid effect %q", stmt.Effect))
+	}
+	if stmt.NotPrincipal != nil || len(stmt.NotAction) > 0 || len(stmt.NotResource) > 0 {
+		return 0, NewErrNotImplemented(errors.New("NotPrincipal, NotAction, and NotResource are not supported"))
+	}
+	if len(stmt.Condition) > 0 {
+		return 0, NewErrNotImplemented(errors.New("conditions are not supported"))
+	}
+	if !isAnyone(stmt.Principal) {
+		return 0, NewErrNotImplemented(errors.New("principals other than \"*\" (everyone) are not supported - bucket access applies to all users"))
+	}
+
+	// resources
+	if len(stmt.Resource) == 0 {
+		return 0, NewErrMalformedPolicy(errors.New("missing resource"))
+	}
+	for _, res := range stmt.Resource {
+		switch res {
+		case policyArnPref + bucket, policyArnPref + bucket + "/*":
+		default:
+			if !strings.HasPrefix(res, policyArnPref+bucket+"/") {
+				return 0, NewErrMalformedPolicy(fmt.Errorf("resource %q does not belong to bucket %q", res, bucket))
+			}
+			return 0, NewErrNotImplemented(fmt.Errorf("resource %q: object- and prefix-level policies are not supported", res))
+		}
+	}
+
+	// actions
+	if len(stmt.Action) == 0 {
+		return 0, NewErrMalformedPolicy(errors.New("missing action"))
+	}
+	for _, action := range stmt.Action {
+		a, err := actionToAccess(action)
+		if err != nil {
+			return 0, err
+		}
+		access |= a
+	}
+	return access, nil
+}
+
+func isAnyone(principal any) bool {
+	switch p := principal.(type) {
+	case string:
+		return p == policyAnyone
+	case map[string]any:
+		if len(p) != 1 {
+			return false
+		}
+		switch v := p["AWS"].(type) {
+		case string:
+			return v == policyAnyone
+		case []any:
+			return len(v) == 1 && v[0] == policyAnyone
+		}
+	}
+	return false
+}
+
+func actionToAccess(action string) (apc.AccessAttrs, error) {
+	switch action {
+	case "*", "s3:*":
+		return apc.AccessAll, nil
+	case "s3:Get*":
+		return apc.AccessRO, nil
+	case "s3:List*":
+		return apc.AceObjLIST | apc.AceBckHEAD, nil
+	case "s3:Put*":
+		return apc.AcePUT | apc.AceAPPEND | apc.AcePATCH | apc.AceBckSetACL, nil
+	case "s3:Delete*":
+		return apc.AceObjDELETE | apc.AceBckSetACL | apc.AceDestroyBucket, nil
+	}
+	for _, pa := range policyActions {
+		if strings.EqualFold(pa.action, action) {
+			return pa.access, nil
+		}
+	}
+	if !strings.HasPrefix(action, "s3:") {
+		return 0, NewErrMalformedPolicy(fmt.Errorf("invalid action %q", action))
+	}
+	return 0, NewErrNotImplemented(fmt.Errorf("action %q is not supported", action))
+}
+
+// GetBucketPolicy: synthesize from access (compare with ParsePolicy)
+func NewBucketPolicy(bucket string, access apc.AccessAttrs) *BucketPolicy {
+	actions := make([]string, 0, len(policyActions))
+	for _, pa := range policyActions {
+		if access.Has(pa.access) {
+			actions = append(actions, pa.action)
+		}
+	}
+	sort.Strings(actions)
+	stmt := PolicyStatement{
+		Sid:       "ais-bucket-access",
+		Effect:    policyAllow,
+		Principal: policyAnyone,
+		Action:    actions,
+		Resource:  strList{policyArnPref + bucket, policyArnPref + bucket + "/*"},
+	}
+	return &BucketPolicy{Version: policyVersion, Statement: stmtList{stmt}}
+}
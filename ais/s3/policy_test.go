@@ -0,0 +1,123 @@
+// Package s3_test provides tests for the Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestBucketPolicy(t *testing.T) {
+	const bucket = "abc"
+	tests := []struct {
+		name   string
+		policy string
+		access apc.AccessAttrs
+	}{
+		{
+			name: "public read",
+			policy: `{"Version":"2012-10-17","Statement":[{"Sid":"r","Effect":"Allow","Principal":"*",
+				"Action":["s3:GetObject","s3:ListBucket"],"Resource":["arn:aws:s3:::abc","arn:aws:s3:::abc/*"]}]}`,
+			access: apc.AccessRO,
+		},
+		{
+			name: "single strings",
+			policy: `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Principal":{"AWS":"*"},
+				"Action":"s3:PutObject","Resource":"arn:aws:s3:::abc/*"}}`,
+			access: apc.AcePUT | apc.AceAPPEND,
+		},
+		{
+			name: "deny delete",
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*",
+				"Action":"s3:DeleteObject","Resource":"arn:aws:s3:::abc/*"}]}`,
+			access: apc.AccessAll &^ apc.AceObjDELETE,
+		},
+		{
+			name: "allow all minus put",
+			policy: `{"Version":"2012-10-17","Statement":[
+				{"Effect":"Allow","Principal":"*","Action":"s3:*","Resource":"arn:aws:s3:::abc"},
+				{"Effect":"Deny","Principal":"*","Action":"s3:PutObject","Resource":"arn:aws:s3:::abc/*"}]}`,
+			access: apc.AccessAll &^ (apc.AcePUT | apc.AceAPPEND),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			access, err := s3.ParsePolicy([]byte(test.policy), bucket)
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, access == test.access, "expected %s, got %s", test.access.Describe(true), access.Describe(true))
+		})
+	}
+
+	// synthesized policy must translate back into the same access
+	synth := cos.MustMarshal(s3.NewBucketPolicy(bucket, apc.AccessRO))
+	access, err := s3.ParsePolicy(synth, bucket)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, access == apc.AccessRO, "round trip: expected %s, got %s", apc.AccessRO.Describe(true), access.Describe(true))
+}
+
+func TestBucketPolicyUnsupported(t *testing.T) {
+	for _, policy := range []string{
+		`{"Version":"2012-10-17","Statement":[]}`,
+		`{"Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::111:user/bob"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::abc/*"}]}`,
+		`{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::abc/private/*"}]}`,
+		`{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::other/*"}]}`,
+		`{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::abc/*","Condition":{"IpAddress":{"aws:SourceIp":"10.0.0.0/8"}}}]}`,
+		`{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObjectTagging","Resource":"arn:aws:s3:::abc/*"}]}`,
+		`{"Statement":[{"Effect":"Maybe","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::abc/*"}]}`,
+		`not json`,
+	} {
+		_, err := s3.ParsePolicy([]byte(policy), "abc")
+		tassert.Errorf(t, err != nil, "expecting policy to fail: %s", policy)
+	}
+}
+
+func TestBucketACL(t *testing.T) {
+	// canned
+	for canned, expected := range map[string]apc.AccessAttrs{
+		s3.ACLPrivate:         apc.AccessAll,
+		s3.ACLPublicRead:      apc.AccessRO,
+		s3.ACLPublicReadWrite: apc.AccessRW,
+	} {
+		r := httptest.NewRequest(http.MethodPut, "/s3/abc?acl", http.NoBody)
+		r.Header.Set(s3.HdrAmzACL, canned)
+		access, err := s3.ACLFromRequest(r)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, access == expected, "%s: expected %s, got %s", canned, expected.Describe(true), access.Describe(true))
+
+		// GET => PUT round trip
+		b, err := xml.Marshal(s3.NewAccessControlPolicy(access))
+		tassert.CheckFatal(t, err)
+		r = httptest.NewRequest(http.MethodPut, "/s3/abc?acl", strings.NewReader(string(b)))
+		access2, err := s3.ACLFromRequest(r)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, access2 == access, "%s: round trip: expected %s, got %s", canned, access.Describe(true), access2.Describe(true))
+	}
+
+	// unsupported
+	r := httptest.NewRequest(http.MethodPut, "/s3/abc?acl", http.NoBody)
+	r.Header.Set(s3.HdrAmzACL, "authenticated-read")
+	_, err := s3.ACLFromRequest(r)
+	tassert.Errorf(t, err != nil, "expecting authenticated-read to fail")
+
+	r = httptest.NewRequest(http.MethodPut, "/s3/abc?acl", http.NoBody)
+	r.Header.Set("x-amz-grant-read", "id=111")
+	_, err = s3.ACLFromRequest(r)
+	tassert.Errorf(t, err != nil, "expecting explicit grant to fail")
+
+	body := `<AccessControlPolicy><Owner><ID>1</ID></Owner><AccessControlList><Grant>
+		<Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="CanonicalUser"><ID>222</ID></Grantee>
+		<Permission>READ</Permission></Grant></AccessControlList></AccessControlPolicy>`
+	r = httptest.NewRequest(http.MethodPut, "/s3/abc?acl", strings.NewReader(body))
+	_, err = s3.ACLFromRequest(r)
+	tassert.Errorf(t, err != nil, "expecting per-user grant to fail")
+}
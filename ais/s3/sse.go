@@ -0,0 +1,99 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/encrypt"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// S3 server-side encryption:
+// - SSE-S3 (`x-amz-server-side-encryption: AES256`) maps to the bucket's `encryption`
+//   property that must be configured - AIS does not encrypt on a per-request basis;
+// - SSE-C: customer-provided key (and its MD5) in the request; the key is never stored;
+// - SSE-KMS is not supported.
+// See:
+// - https://docs.aws.amazon.com/AmazonS3/latest/userguide/UsingServerSideEncryption.html
+// - https://docs.aws.amazon.com/AmazonS3/latest/userguide/ServerSideEncryptionCustomerKeys.html
+
+const (
+	HdrSSE          = "x-amz-server-side-encryption"
+	HdrSSECAlgo     = "x-amz-server-side-encryption-customer-algorithm"
+	HdrSSECKey      = "x-amz-server-side-encryption-customer-key"
+	HdrSSECKeyMD5   = "x-amz-server-side-encryption-customer-key-MD5"
+	SSEAlgoAES256   = "AES256"
+	SSEAlgoKMS      = "aws:kms"
+	errCodeSSEInval = "InvalidArgument"
+)
+
+var errSSEKMS = errors.New("SSE-KMS (" + HdrSSE + ": " + SSEAlgoKMS + ") is not supported")
+
+func newErrSSE(format string, a ...any) error {
+	return &errCoded{code: errCodeSSEInval, err: fmt.Errorf(format, a...), status: http.StatusBadRequest}
+}
+
+// PUT: validates `x-amz-server-side-encryption` against the bucket and returns SSE-C key, if any
+func SSEFromHeader(hdr http.Header, bck *meta.Bck) ([]byte, error) {
+	switch v := hdr.Get(HdrSSE); v {
+	case "":
+	case SSEAlgoAES256:
+		if !bck.Props.Encryption.IsActive() {
+			err := fmt.Errorf("%s: %s requires bucket encryption (see bucket property %q)", bck.Cname(""), HdrSSE, "encryption")
+			return nil, NewErrNotImplemented(err)
+		}
+	case SSEAlgoKMS, "aws:kms:dsse":
+		return nil, NewErrNotImplemented(errSSEKMS)
+	default:
+		return nil, newErrSSE("invalid %s value %q", HdrSSE, v)
+	}
+	ssec, err := SSECFromHeader(hdr)
+	if err == nil && ssec != nil && hdr.Get(HdrSSE) != "" {
+		err = newErrSSE("%s and %s are mutually exclusive", HdrSSE, HdrSSECAlgo)
+	}
+	return ssec, err
+}
+
+// SSE-C request headers => customer-provided key (nil when not present)
+func SSECFromHeader(hdr http.Header) ([]byte, error) {
+	var (
+		algo = hdr.Get(HdrSSECAlgo)
+		b64  = hdr.Get(HdrSSECKey)
+		md5  = hdr.Get(HdrSSECKeyMD5)
+	)
+	if algo == "" && b64 == "" && md5 == "" {
+		return nil, nil
+	}
+	if algo != SSEAlgoAES256 {
+		return nil, newErrSSE("invalid %s value %q (expecting %q)", HdrSSECAlgo, algo, SSEAlgoAES256)
+	}
+	key, err := base64.StdEncoding.DecodeString(b64)
+	if err != nil || len(key) != encrypt.KeySize {
+		return nil, newErrSSE("invalid %s (expecting base64-encoded %d-byte key)", HdrSSECKey, encrypt.KeySize)
+	}
+	if md5 != encrypt.SSECKeyMD5(key) {
+		return nil, newErrSSE("%s does not match the provided key", HdrSSECKeyMD5)
+	}
+	return key, nil
+}
+
+// response headers: encrypted objects only
+func SetSSEHeaders(hdr http.Header, lom *core.LOM) {
+	if !lom.IsEncrypted() {
+		return
+	}
+	if md5, ok := lom.GetCustomKey(cmn.SSECKeyMD5ObjMD); ok {
+		hdr.Set(HdrSSECAlgo, SSEAlgoAES256)
+		hdr.Set(HdrSSECKeyMD5, md5)
+		return
+	}
+	hdr.Set(HdrSSE, SSEAlgoAES256)
+}
@@ -0,0 +1,96 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Object and bucket tagging: S3 Get/Put/DeleteObjectTagging and Get/Put/DeleteBucketTagging.
+// Object tags persist in the object's custom metadata (cmn.TagsObjMD); bucket tags - in bucket
+// properties (cmn.Bprops.Tags). For limits and validation, see cmn/tags.go.
+// See https://docs.aws.amazon.com/AmazonS3/latest/userguide/object-tagging.html
+
+const (
+	HdrTagging      = "x-amz-tagging"       // PUT object: URL-encoded tag set
+	HdrTaggingCount = "x-amz-tagging-count" // GET and HEAD object: number of tags
+)
+
+type (
+	Tagging struct {
+		XMLName xml.Name `xml:"Tagging"`
+		Ns      string   `xml:"xmlns,attr,omitempty"`
+		TagSet  TagSet   `xml:"TagSet"`
+	}
+	TagSet struct {
+		Tags []Tag `xml:"Tag"`
+	}
+	Tag struct {
+		Key   string `xml:"Key"`
+		Value string `xml:"Value"`
+	}
+)
+
+func DecodeTagging(r io.Reader) (*Tagging, error) {
+	tagging := &Tagging{}
+	if err := xml.NewDecoder(r).Decode(tagging); err != nil {
+		return nil, NewErrMalformedXML(fmt.Errorf("malformed tagging: %w", err))
+	}
+	return tagging, nil
+}
+
+func NewTagging(tags cmn.Tags) *Tagging {
+	tagging := &Tagging{Ns: s3Namespace, TagSet: TagSet{Tags: make([]Tag, 0, len(tags))}}
+	for _, tag := range tags {
+		tagging.TagSet.Tags = append(tagging.TagSet.Tags, Tag{Key: tag.Key, Value: tag.Value})
+	}
+	return tagging
+}
+
+// (the caller validates: object vs bucket limits differ)
+func (tagging *Tagging) ToNative() cmn.Tags {
+	tags := make(cmn.Tags, 0, len(tagging.TagSet.Tags))
+	for _, tag := range tagging.TagSet.Tags {
+		tags = append(tags, cmn.Tag{Key: tag.Key, Value: tag.Value})
+	}
+	return tags
+}
+
+func (tagging *Tagging) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(tagging)
+	debug.AssertNoErr(err)
+}
+
+// PUT object with `x-amz-tagging`: returns validated tag set, if any
+func TagsFromHeader(hdr http.Header) (cmn.Tags, error) {
+	s := hdr.Get(HdrTagging)
+	if s == "" {
+		return nil, nil
+	}
+	tags, err := cmn.ParseTags(s)
+	if err == nil {
+		err = tags.ValidateObj()
+	}
+	if err != nil {
+		return nil, NewErrInvalidTag(err)
+	}
+	return tags, nil
+}
+
+func setTaggingCount(hdr http.Header, lom *core.LOM) {
+	if v, ok := lom.GetCustomKey(cmn.TagsObjMD); ok && v != "" {
+		hdr.Set(HdrTaggingCount, strconv.Itoa(cmn.NumTags(v)))
+	}
+}
@@ -0,0 +1,57 @@
+// Package s3_test provides tests for the Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+const taggingXML = `<Tagging>
+  <TagSet>
+    <Tag><Key>split</Key><Value>train</Value></Tag>
+    <Tag><Key>owner</Key><Value>data team</Value></Tag>
+  </TagSet>
+</Tagging>`
+
+func TestTagging(t *testing.T) {
+	tagging, err := s3.DecodeTagging(strings.NewReader(taggingXML))
+	tassert.CheckFatal(t, err)
+	tags := tagging.ToNative()
+	tassert.Fatalf(t, len(tags) == 2, "expecting 2 tags, got %v", tags)
+	tassert.CheckError(t, tags.ValidateObj())
+
+	// round trip
+	var buf bytes.Buffer
+	tassert.CheckFatal(t, xml.NewEncoder(&buf).Encode(s3.NewTagging(tags)))
+	again, err := s3.DecodeTagging(&buf)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, again.ToNative().Encode() == tags.Encode(), "round trip: %v vs %v", again.ToNative(), tags)
+
+	_, err = s3.DecodeTagging(strings.NewReader("<Tagging><TagSet>"))
+	tassert.Errorf(t, err != nil, "expecting malformed XML error")
+}
+
+func TestTagsFromHeader(t *testing.T) {
+	hdr := http.Header{}
+	tags, err := s3.TagsFromHeader(hdr)
+	tassert.Errorf(t, err == nil && tags == nil, "no header: %v, %v", tags, err)
+
+	hdr.Set(s3.HdrTagging, "split=train&owner=data+team")
+	tags, err = s3.TagsFromHeader(hdr)
+	tassert.CheckFatal(t, err)
+	v, _ := tags.Get("owner")
+	tassert.Errorf(t, len(tags) == 2 && v == "data team", "unexpected: %v", tags)
+
+	hdr.Set(s3.HdrTagging, "aws:reserved=1")
+	_, err = s3.TagsFromHeader(hdr)
+	tassert.Errorf(t, err != nil, "expecting invalid tag error")
+}
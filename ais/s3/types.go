@@ -7,9 +7,7 @@ package s3
 import (
 	"encoding/xml"
 	"net/http"
-	"net/url"
 	"path"
-	"strconv"
 	"strings"
 	"time"
 
@@ -28,24 +26,31 @@ import (
 // clients require.
 type (
 	// List objects response
+	// (both ListObjectsV2 and ListObjects aka V1 - see lso.go)
 	ListObjectResult struct {
 		Name                  string          `xml:"Name"`
 		Ns                    string          `xml:"xmlns,attr"`
 		Prefix                string          `xml:"Prefix"`
-		ContinuationToken     string          `xml:"ContinuationToken"`               // original
-		NextContinuationToken string          `xml:"NextContinuationToken,omitempty"` // to read the next page
+		Delimiter             string          `xml:"Delimiter,omitempty"`
+		EncodingType          string          `xml:"EncodingType,omitempty"`          // "url" when requested
+		Marker                string          `xml:"Marker,omitempty"`                // V1: original
+		NextMarker            string          `xml:"NextMarker,omitempty"`            // V1: to read the next page (with delimiter)
+		StartAfter            string          `xml:"StartAfter,omitempty"`            // V2
+		ContinuationToken     string          `xml:"ContinuationToken,omitempty"`     // V2: original
+		NextContinuationToken string          `xml:"NextContinuationToken,omitempty"` // V2: to read the next page
 		Contents              []*ObjInfo      `xml:"Contents"`                        // list of object
-		CommonPrefixes        []*CommonPrefix `xml:"CommonPrefixes,omitempty"`        // list of dirs (used with `apc.LsNoRecursion`)
-		KeyCount              int             `xml:"KeyCount"`                        // number of object names in the response
+		CommonPrefixes        []*CommonPrefix `xml:"CommonPrefixes,omitempty"`        // keys rolled up by delimiter
+		KeyCount              int             `xml:"KeyCount"`                        // number of keys and common prefixes in the response
 		MaxKeys               int             `xml:"MaxKeys"`                         // "The maximum number of keys returned ..."
 		IsTruncated           bool            `xml:"IsTruncated"`                     // true if there are more pages to read
 	}
 	ObjInfo struct {
-		Key          string `xml:"Key"`
-		LastModified string `xml:"LastModified"`
-		ETag         string `xml:"ETag"`
-		Class        string `xml:"StorageClass"`
-		Size         int64  `xml:"Size"`
+		Key          string    `xml:"Key"`
+		LastModified string    `xml:"LastModified"`
+		ETag         string    `xml:"ETag"`
+		Owner        *BckOwner `xml:"Owner,omitempty"` // V1 always, V2 with "fetch-owner"
+		Class        string    `xml:"StorageClass"`
+		Size         int64     `xml:"Size"`
 	}
 	CommonPrefix struct {
 		Prefix string `xml:"Prefix"`
@@ -57,6 +62,12 @@ type (
 		ETag         string `xml:"ETag"`
 	}
 
+	// UploadPartCopy response
+	CopyPartResult struct {
+		LastModified string `xml:"LastModified"`
+		ETag         string `xml:"ETag"`
+	}
+
 	// Multipart upload start response
 	InitiateMptUploadResult struct {
 		Bucket   string `xml:"Bucket"`
@@ -71,9 +82,13 @@ type (
 
 	// Multipart upload completion response
 	CompleteMptUploadResult struct {
-		Bucket string `xml:"Bucket"`
-		Key    string `xml:"Key"`
-		ETag   string `xml:"ETag"`
+		Bucket            string `xml:"Bucket"`
+		Key               string `xml:"Key"`
+		ETag              string `xml:"ETag"`
+		ChecksumCRC32C    string `xml:"ChecksumCRC32C,omitempty"`
+		ChecksumCRC64NVME string `xml:"ChecksumCRC64NVME,omitempty"`
+		ChecksumSHA256    string `xml:"ChecksumSHA256,omitempty"`
+		ChecksumType      string `xml:"ChecksumType,omitempty"`
 	}
 
 	// Multipart uploaded parts response
@@ -111,30 +126,6 @@ type (
 
 func ObjName(items []string) string { return path.Join(items[1:]...) }
 
-func FillLsoMsg(query url.Values, msg *apc.LsoMsg) {
-	mxStr := query.Get(QparamMaxKeys)
-	if pageSize, err := strconv.Atoi(mxStr); err == nil && pageSize > 0 {
-		msg.PageSize = int64(pageSize)
-	}
-	if prefix := query.Get(QparamPrefix); prefix != "" {
-		msg.Prefix = prefix
-	}
-	var token string
-	if token = query.Get(QparamContinuationToken); token != "" {
-		// base64 encoded, as in: base64.StdEncoding.DecodeString(token)
-		msg.ContinuationToken = token
-	}
-	// `start-after` is used only when starting to list pages, subsequent next-page calls
-	// utilize `continuation-token`
-	if after := query.Get(QparamStartAfter); after != "" && token == "" {
-		msg.StartAfter = after
-	}
-	// TODO: check that the delimiter is '/' and raise an error otherwise
-	if delimiter := query.Get(QparamDelimiter); delimiter != "" {
-		msg.SetFlag(apc.LsNoRecursion)
-	}
-}
-
 func NewListObjectResult(bucket string) *ListObjectResult {
 	return &ListObjectResult{
 		Name:     bucket,
@@ -150,18 +141,6 @@ func (r *ListObjectResult) MustMarshal(sgl *memsys.SGL) {
 	debug.AssertNoErr(err)
 }
 
-func (r *ListObjectResult) add(entry *cmn.LsoEnt) {
-	if entry.Flags&apc.EntryIsDir == 0 {
-		r.Contents = append(r.Contents, entryToS3(entry))
-	} else {
-		prefix := entry.Name
-		if !cos.IsLastB(entry.Name, '/') {
-			prefix += "/"
-		}
-		r.CommonPrefixes = append(r.CommonPrefixes, &CommonPrefix{Prefix: prefix})
-	}
-}
-
 // Note: in S3 listings, xs/wanted_lso populates entry.Custom with ETag/LastModified
 // but only if the latter is (or are) missing
 // here, if Custom is empty, we fall back to Atime for LastModified and omit ETag
@@ -180,15 +159,6 @@ func entryToS3(entry *cmn.LsoEnt) (oi *ObjInfo) {
 	return oi
 }
 
-func (r *ListObjectResult) FromLsoResult(lst *cmn.LsoRes) {
-	r.KeyCount = len(lst.Entries)
-	r.IsTruncated = lst.ContinuationToken != ""
-	r.NextContinuationToken = lst.ContinuationToken
-	for _, e := range lst.Entries {
-		r.add(e)
-	}
-}
-
 func SetS3Headers(hdr http.Header, lom *core.LOM) {
 	// 1. Last-Modified
 	var (
@@ -216,7 +186,13 @@ func SetS3Headers(hdr http.Header, lom *core.LOM) {
 		}
 	}
 
-	// 4. finally, user metadata (X-Amz-Meta-...)
+	// 4. x-amz-tagging-count
+	setTaggingCount(hdr, lom)
+
+	// 5. x-amz-object-lock-*
+	setObjLockHeaders(hdr, lom)
+
+	// 6. finally, user metadata (X-Amz-Meta-...)
 	for k, v := range lom.GetCustomMD() {
 		if strings.HasPrefix(k, HeaderMetaPrefix) {
 			hdr.Set(k, v)
@@ -230,6 +206,12 @@ func (r *CopyObjectResult) MustMarshal(sgl *memsys.SGL) {
 	debug.AssertNoErr(err)
 }
 
+func (r *CopyPartResult) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write(cos.UnsafeB(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
 func (r *InitiateMptUploadResult) MustMarshal(sgl *memsys.SGL) {
 	sgl.Write(cos.UnsafeB(xml.Header))
 	err := xml.NewEncoder(sgl).Encode(r)
@@ -19,6 +19,7 @@ import (
 	"github.com/NVIDIA/aistore/ais/backend"
 	"github.com/NVIDIA/aistore/ais/s3"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/bnotif"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/atomic"
@@ -495,6 +496,8 @@ func (t *target) Run() error {
 	mirror.Init()
 
 	xreg.RegWithHK()
+	t.lcyInit()
+	t.wbInit()
 
 	marked := xreg.GetResilverMarked()
 	if marked.Interrupted || daemon.resilver.required {
@@ -504,10 +507,12 @@ func (t *target) Run() error {
 	etl.Tinit()
 	t.initDsort(db, config) // note: conditional linkage
 	dload.Init(db, &config.Client)
+	bnotif.Init(config)
 
 	err = t.htrun.run(config)
 
 	etl.StopAll() // stop all running ETLs if any
+	bnotif.Stop() // spool pending bucket notifications
 	cos.Close(db) // close kv db
 
 	// gracefully
@@ -1083,6 +1088,7 @@ func (t *target) putObject(w http.ResponseWriter, r *http.Request, dpq *dpq, lom
 		poi.lom = lom
 		poi.config = config
 		poi.skipVC = skipVC // feat.SkipVC || apc.QparamSkipVC
+		poi.loaded = !skipVC
 		poi.restful = true
 		poi.t2t = t2t
 	}
@@ -1118,7 +1124,10 @@ func (t *target) httpobjdelete(w http.ResponseWriter, r *http.Request, apireq *a
 		return
 	}
 
-	evict := msg.Action == apc.ActEvictObjects
+	var (
+		evict  = msg.Action == apc.ActEvictObjects
+		bypass = cos.IsParseBool(apireq.query.Get(apc.QparamBypassGovernance))
+	)
 	lom := core.AllocLOM(objName)
 	if err := lom.InitBck(apireq.bck); err != nil {
 		t.writeErr(w, r, err)
@@ -1126,7 +1135,7 @@ func (t *target) httpobjdelete(w http.ResponseWriter, r *http.Request, apireq *a
 		return
 	}
 
-	ecode, err := t.DeleteObject(lom, evict)
+	ecode, err := t.deleteObject(lom, evict, bypass)
 	if err == nil && ecode == 0 {
 		// EC cleanup if EC is enabled
 		ec.ECM.CleanupObject(lom)
@@ -1170,6 +1179,9 @@ func (t *target) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *api
 		} else {
 			vlabs := map[string]string{stats.VlabBucket: lom.Bck().Cname("")}
 			t.statsT.IncWith(stats.ErrRenameCount, vlabs)
+			if cmn.IsErrObjLocked(err) {
+				ecode = http.StatusForbidden
+			}
 		}
 	case apc.ActBlobDl:
 		var (
@@ -1233,6 +1245,7 @@ func (t *target) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *api
 			body:     nil,
 			parts:    mptCompletedParts,
 			locked:   false,
+			event:    cmn.NotifMptComplete,
 		})
 	case apc.ActCheckLock:
 		t._checkLocked(w, r, apireq.bck, apireq.items[1])
@@ -1490,6 +1503,12 @@ func (t *target) httpobjpatch(w http.ResponseWriter, r *http.Request, apireq *ap
 		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, "set-custom", msg.Value, err)
 		return
 	}
+	for key := range custom {
+		if cmn.IsObjLockMD(key) {
+			t.writeErrf(w, r, "%s: custom key %q is reserved (object lock)", apireq.bck.Cname(apireq.items[1]), key)
+			return
+		}
+	}
 
 	lom := core.AllocLOM(apireq.items[1] /*objName*/)
 	defer core.FreeLOM(lom)
@@ -1507,6 +1526,11 @@ func (t *target) httpobjpatch(w http.ResponseWriter, r *http.Request, apireq *ap
 	}
 	delOldSetNew := cos.IsParseBool(apireq.query.Get(apc.QparamNewCustom))
 	if delOldSetNew {
+		for _, key := range cmn.ObjLockMDKeys {
+			if v, ok := lom.GetCustomKey(key); ok {
+				custom[key] = v // (retain)
+			}
+		}
 		lom.SetCustomMD(custom)
 	} else {
 		for key, val := range custom {
@@ -1553,6 +1577,9 @@ func (t *target) putApndArch(r *http.Request, lom *core.LOM, started int64, dpq
 		}
 		a.put = true
 	} else {
+		if err := lomObjLock(lom, false /*bypass*/); err != nil {
+			return http.StatusForbidden, err
+		}
 		a.put = (flags == 0)
 	}
 	if s := r.Header.Get(cos.HdrContentLength); s != "" {
@@ -1567,10 +1594,14 @@ func (t *target) putApndArch(r *http.Request, lom *core.LOM, started int64, dpq
 	return a.do()
 }
 
-func (t *target) DeleteObject(lom *core.LOM, evict bool) (code int, err error) {
+func (t *target) DeleteObject(lom *core.LOM, evict bool) (int, error) {
+	return t.deleteObject(lom, evict, false /*bypass governance*/)
+}
+
+func (t *target) deleteObject(lom *core.LOM, evict, bypassGovernance bool) (code int, err error) {
 	var isback bool
 	lom.Lock(true)
-	code, err, isback = t.delobj(lom, evict)
+	code, err, isback = t.delobj(lom, evict, bypassGovernance)
 	lom.Unlock(true)
 
 	// special corner-case retry (quote):
@@ -1590,10 +1621,16 @@ func (t *target) DeleteObject(lom *core.LOM, evict bool) (code int, err error) {
 	switch {
 	case err == nil:
 		t.statsT.IncWith(stats.DeleteCount, vlabs)
+		if !evict {
+			xs.NBIDel(lom)
+			bnotif.Notify(lom, cmn.NotifDelete)
+		}
 	case cos.IsNotExist(err, code) || cmn.IsErrObjNought(err):
 		if !evict {
 			t.statsT.IncWith(stats.ErrDeleteCount, vlabs)
 		}
+	case cmn.IsErrObjLocked(err):
+		t.statsT.IncWith(stats.ErrDeleteCount, vlabs)
 	default:
 		// not to confuse with `stats.RemoteDeletedDelCount` that counts against
 		// QparamLatestVer, 'versioning.validate_warm_get' and friends
@@ -1664,7 +1701,7 @@ func (t *target) copyObject(lom *core.LOM, bck *meta.Bck, objName string, dpq *d
 }
 
 // NOTE: s3 will return err=nil with OK status to indicate (not deleting) non-existing object (see also aws.go)
-func (t *target) delobj(lom *core.LOM, evict bool) (int, error, bool) {
+func (t *target) delobj(lom *core.LOM, evict, bypassGovernance bool) (int, error, bool) {
 	var (
 		aisErr, backendErr         error
 		aisErrCode, backendErrCode int
@@ -1687,12 +1724,23 @@ func (t *target) delobj(lom *core.LOM, evict bool) (int, error, bool) {
 			return http.StatusNotFound, cos.NewErrNotFound(t, lom.Cname()), false
 		}
 	} else {
+		// object lock (WORM)
+		if err := lomObjLock(lom, bypassGovernance); err != nil {
+			return http.StatusForbidden, err, false
+		}
+		// not yet written back (see xs/wback.go): the only copy
+		if evict && lom.IsDirtyWB() {
+			return http.StatusConflict, cmn.NewErrBusy("object", lom.Cname(), "not yet written back"), false
+		}
 		delFromAIS = true
 	}
 
 	// do
 	if delFromBackend {
 		backendErrCode, backendErr = t.Backend(lom.Bck()).DeleteObj(context.Background(), lom)
+		if backendErr != nil && delFromAIS && lom.IsDirtyWB() && cos.IsNotExist(backendErr, backendErrCode) {
+			backendErrCode, backendErr = 0, nil // never written back - nothing to delete
+		}
 	}
 	if delFromAIS {
 		size := lom.Lsize()
@@ -1731,6 +1779,9 @@ func (t *target) objMv(lom *core.LOM, msg *apc.ActMsg) error {
 	if msg.Name == lom.ObjName {
 		return fmt.Errorf("%s: cannot rename/move object %s onto itself", t.si, lom)
 	}
+	if err := existingObjLock(lom, false /*locked*/); err != nil {
+		return err
+	}
 
 	buf, slab := t.gmm.Alloc()
 	coiParams := xs.AllocCOI()
@@ -1753,6 +1804,9 @@ func (t *target) objMv(lom *core.LOM, msg *apc.ActMsg) error {
 	lom.Lock(true)
 	if err := lom.RemoveObj(); err != nil {
 		nlog.Warningf("%s: failed to delete renamed object %s (new name %s): %v", t, lom, msg.Name, err)
+	} else {
+		xs.NBIDel(lom)
+		bnotif.Notify(lom, cmn.NotifDelete)
 	}
 	lom.Unlock(true)
 	return nil
@@ -89,7 +89,7 @@ func (c *mossConfig) name() (s string) {
 // Allow 1% tolerance for compressed TAR formats while requiring exact equality for the rest.
 func equalSize(outputFormat string, size1, size2 int) bool {
 	switch outputFormat {
-	case archive.ExtTgz, archive.ExtTarGz, archive.ExtTarLz4:
+	case archive.ExtTgz, archive.ExtTarGz, archive.ExtTarLz4, archive.ExtTarZst:
 		if size1 == size2 {
 			return true
 		}
@@ -172,6 +172,7 @@ func TestMoss(t *testing.T) {
 		{inputFormat: archive.ExtTar, outputFormat: archive.ExtTgz, streaming: true},
 		{inputFormat: archive.ExtTar, outputFormat: archive.ExtTarLz4, continueOnErr: true, withMissing: true, streaming: true},
 		{inputFormat: archive.ExtTarLz4, outputFormat: archive.ExtZip, continueOnErr: true, onlyObjName: true, withMissing: true, streaming: true},
+		{inputFormat: archive.ExtTarZst, outputFormat: archive.ExtTarZst, continueOnErr: true, withMissing: true, streaming: true},
 
 		// NEW: Test case - empty default bucket with explicit bucket specification in each entry
 		{inputFormat: "", continueOnErr: false, onlyObjName: false, emptyDefaultBucket: true},
@@ -284,23 +284,27 @@ func (t *target) listObjects(w http.ResponseWriter, r *http.Request, bck *meta.B
 	if lsmsg.IsFlagSet(apc.LsNBI) {
 		debug.AssertNoErr(lsmsg.ValidateNBI()) // checked by proxy
 
-		if invName := r.Header.Get(apc.HdrInvName); invName == "" {
-			// must exist and be single
+		if invName := r.Header.Get(apc.HdrInvName); invName == "" || invName == apc.NBILatest {
+			// must exist and be either single, or the latest one (explicitly or of the same series)
 			nbis, err := fs.CollectNBI(bck.Bucket())
 			if err != nil {
 				t.writeErrf(w, r, "failed to collect bucket inventories: %w", err)
 				return false
 			}
-			if l := len(nbis); l != 1 {
-				if l == 0 {
-					t.writeErrf(w, r, "bucket %q has no inventories", bck.Cname(""))
-				} else {
-					t.writeErrf(w, r, "missing %q header: bucket %q has %d inventories; please specify which one",
-						apc.HdrInvName, bck.Cname(""), l)
-				}
+			l := len(nbis)
+			switch {
+			case l == 0:
+				t.writeErrf(w, r, "bucket %q has no inventories", bck.Cname(""))
+				return false
+			case l == 1:
+				invName = nbis.SingleName()
+			case invName == apc.NBILatest || nbis.SameSeries():
+				invName = nbis.Latest().Name
+			default:
+				t.writeErrf(w, r, "missing %q header: bucket %q has %d inventories; please specify which one (or %q)",
+					apc.HdrInvName, bck.Cname(""), l, apc.NBILatest)
 				return false
 			}
-			invName = nbis.SingleName()
 			if cmn.Rom.V(4, cos.ModAIS) {
 				nlog.Infoln("located inventory", invName, "for bucket", bck.Cname(""))
 			}
@@ -435,6 +439,17 @@ func (t *target) httpbckdelete(w http.ResponseWriter, r *http.Request, apireq *a
 		defer nlp.Unlock()
 		defer wg.Wait()
 
+		// object lock: refuse to evict locked objects
+		if err := bckObjLock(bck); err != nil {
+			t.writeErr(w, r, err, http.StatusForbidden)
+			return
+		}
+		// write-back: refuse to evict objects that are yet to be written back
+		if err := bckDirtyWB(bck); err != nil {
+			t.writeErr(w, r, err, http.StatusConflict)
+			return
+		}
+
 		// start and immdiately finish xaction with a singular purpose:
 		// to have a record in xreg (via `ais show job`): name and timestamp only
 		debug.Assert(strings.HasPrefix(xid, xact.PrefixEvictKeepID), xid)
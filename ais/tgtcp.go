@@ -167,6 +167,8 @@ func (t *target) daeputMsg(w http.ResponseWriter, r *http.Request) {
 		t.statsT.ResetStats(errorsOnly)
 	case apc.ActClearLcache:
 		core.LcacheClear()
+	case apc.ActRestoreQuarantined, apc.ActPurgeQuarantined:
+		t.quarantineAct(w, r, msg)
 
 	case apc.ActReloadBackendCreds:
 		provider := msg.Name
@@ -431,6 +433,13 @@ func (t *target) httpdaeget(w http.ResponseWriter, r *http.Request) {
 		fs.DiskStats(dstats, nil, config, true /*refresh cap*/)
 		mpl := fs.ToMPL()
 		t.writeJSON(w, r, mpl, httpdaeWhat)
+	case apc.WhatQuarantine:
+		entries, err := fs.ListQuarantined()
+		if err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		t.writeJSON(w, r, entries, httpdaeWhat)
 	case apc.WhatDiskRWUtilCap:
 		var (
 			tcdfExt fs.TcdfExt
@@ -25,10 +25,6 @@ import (
 
 // [METHOD] /v1/etl
 func (t *target) etlHandler(w http.ResponseWriter, r *http.Request) {
-	if !k8s.IsK8s() {
-		t.writeErr(w, r, k8s.ErrK8sRequired, 0, Silent)
-		return
-	}
 	switch r.Method {
 	case http.MethodPut:
 		t.handleETLPut(w, r) // TODO: move to proxy (control plane operation)
@@ -105,7 +101,9 @@ func (t *target) handleETLGet(w http.ResponseWriter, r *http.Request, dpq *dpq)
 	case apc.ETLDetails:
 		t.detailsETL(w, r, dpq, apiItems[0])
 	case apc.ETLMetrics:
-		k8s.InitMetricsClient()
+		if k8s.IsK8s() {
+			k8s.InitMetricsClient()
+		}
 		t.metricsETL(w, r, apiItems[0])
 	default:
 		t.writeErrURL(w, r)
@@ -49,6 +49,7 @@ func (t *target) PutObject(lom *core.LOM, params *core.PutParams) (err error) {
 		poi.config = cmn.GCO.Get()
 		poi.r = params.Reader
 		poi.workFQN = workFQN
+		poi.rawMD = params.RawMD
 		poi.atime = params.Atime.UnixNano()
 		poi.xctn = params.Xact
 		poi.size = params.Size
@@ -56,13 +57,14 @@ func (t *target) PutObject(lom *core.LOM, params *core.PutParams) (err error) {
 		poi.skipEC = params.SkipEC
 		poi.skipBackend = params.SkipBackend
 		poi.locked = params.Locked
+		poi.dirtyWB = params.DirtyWB
 	}
 	if poi.owt != cmn.OwtPut {
 		poi.cksumToUse = params.Cksum
 	}
 
 	switch {
-	case params.ChunkSize > 0:
+	case params.ChunkSize > 0 && params.RawMD == "":
 		_, err = poi.chunk(params.ChunkSize)
 	default:
 		_, err = poi.putObject()
@@ -73,7 +75,7 @@ func (t *target) PutObject(lom *core.LOM, params *core.PutParams) (err error) {
 		if err == nil {
 			size := lom.Lsize(true)
 			// (NOTE: check callers that give us a zero)
-			debug.Assertf(params.OWT == cmn.OwtTransform || params.Size <= 0 || params.Size == size, "%s: %d vs %d", lom, params.Size, size)
+			debug.Assertf(params.OWT == cmn.OwtTransform || params.RawMD != "" || params.Size <= 0 || params.Size == size, "%s: %d vs %d", lom, params.Size, size)
 		}
 	})
 	return err
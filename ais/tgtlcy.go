@@ -0,0 +1,94 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/nl"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// bucket lifecycle (see cmn.LifecycleConf):
+// - periodically (every lcyIval) start lifecycle xaction for each bucket with active rules;
+// - abort stale multipart uploads on behalf of the xaction (see xreg.LcyArgs)
+
+const (
+	lcyIval   = time.Hour
+	lcyHkName = "lifecycle" + hk.NameSuffix
+)
+
+func (t *target) lcyInit() {
+	hk.Reg(lcyHkName, t.lcyHousekeep, lcyIval)
+}
+
+func (t *target) lcyHousekeep(int64) time.Duration {
+	if !t.ClusterStarted() || t.regstate.disabled.Load() {
+		return lcyIval
+	}
+	bmd := t.owner.bmd.get()
+	bmd.Range(nil, nil, func(bck *meta.Bck) bool {
+		if !bck.Props.Lifecycle.IsActive() {
+			return false
+		}
+		if _, err := t.runLifecycle("", bck); err != nil && !cmn.IsErrXactUsePrev(err) {
+			nlog.Warningln(t.String(), "failed to start lifecycle for", bck.Cname(""), "err:", err)
+		}
+		return false
+	})
+	return lcyIval
+}
+
+// handle apc.ActLifecycle <-- via hk (above) and api.StartX*
+func (t *target) runLifecycle(xactID string, bck *meta.Bck) (string, error) {
+	rns := xreg.RenewLifecycle(bck, xactID, &xreg.LcyArgs{AbortMpt: t.lcyAbortMpt})
+	if rns.Err != nil {
+		return "", rns.Err
+	}
+	xctn := rns.Entry.Get()
+	if rns.IsRunning() {
+		return xctn.ID(), nil
+	}
+	notif := &xact.NotifXact{
+		Base: nl.Base{When: core.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
+		Xact: xctn,
+	}
+	xctn.AddNotif(notif)
+
+	if cmn.Rom.V(4, cos.ModAIS) {
+		nlog.Infoln("start", apc.ActLifecycle, bck.String(), "xid", xctn.ID())
+	}
+	xact.GoRunW(xctn)
+	return xctn.ID(), nil
+}
+
+// abort multipart uploads initiated earlier than the (matching) rule's `abort_mpt`
+func (t *target) lcyAbortMpt(bck *meta.Bck, now time.Time) (n int) {
+	lcy := &bck.Props.Lifecycle
+	for _, manifest := range t.ups.toSlice() {
+		lom := manifest.Lom()
+		if lom == nil || !lom.Bck().Equal(bck, true /*same ID*/, true /*same backend*/) {
+			continue
+		}
+		age := lcy.AbortMptAge(lom.ObjName)
+		if age == 0 || now.Sub(manifest.Created()) < age {
+			continue
+		}
+		if _, err := t.ups.abort(nil /*req*/, lom, manifest.ID()); err != nil {
+			nlog.Warningln(t.String(), apc.ActLifecycle, "failed to abort upload [", manifest.ID(), lom.Cname(), err, "]")
+			continue
+		}
+		n++
+	}
+	return n
+}
@@ -10,12 +10,15 @@ import (
 	"hash"
 	"io"
 	"net/http"
+	"net/url"
 	"sort"
 	"strconv"
 	"sync"
 	"time"
 
+	"github.com/NVIDIA/aistore/ais/s3"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/bnotif"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
@@ -25,6 +28,7 @@ import (
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/xact/xs"
 )
 
 const (
@@ -62,13 +66,16 @@ type (
 		parts       apc.MptCompletedParts
 		isS3        bool
 		skipBackend bool
-		locked      bool // true if the LOM is already locked by the caller
+		locked      bool   // true if the LOM is already locked by the caller
+		event       string // bucket notification event (empty: none)
 	}
 	// partCksums holds checksum state for a single part upload
 	partCksums struct {
-		crc32c  *cos.CksumHash // always computed for local/remote-AIS (for combination)
+		crc     *cos.CksumHash // always computed for local/remote-AIS (for combination): crc64nvme iff bucket's checksum, otherwise crc32c
 		md5     *cos.CksumHash // S3 ETag compatibility
 		sha256  *cos.CksumHash // S3 content-SHA256 header validation
+		s3cksum *cos.CksumHash // S3 `x-amz-checksum-*` header validation (nil when same as `crc`)
+		s3expct *cos.Cksum     // expected S3 additional checksum from request header
 		partSHA string         // expected SHA256 from request header
 		inOrder bool           // true if this part uses streaming checksum
 	}
@@ -187,6 +194,19 @@ func (*ups) encodeRemoteMetadata(lom *core.LOM, metadata map[string]string) (md
 // misc
 //
 
+// parse and validate (S3) upload ID and part number
+func (ups *ups) parsePartS3(q url.Values) (uploadID string, partNum int32, err error) {
+	if uploadID = q.Get(s3.QparamMptUploadID); uploadID == "" {
+		return "", 0, errors.New("empty uploadId")
+	}
+	part := q.Get(s3.QparamMptPartNo)
+	if part == "" {
+		return "", 0, fmt.Errorf("upload %q: missing part number", uploadID)
+	}
+	partNum, err = ups.parsePartNum(part)
+	return uploadID, partNum, err
+}
+
 func (*ups) parsePartNum(s string) (int32, error) {
 	partNum, err := strconv.ParseInt(s, 10, 32)
 	switch {
@@ -304,7 +324,12 @@ func (ups *ups) putPart(args *partArgs) (etag string, ecode int, err error) {
 	}
 
 	path := args.chunk.Path()
-	if args.fh, err = lom.CreatePart(path); err != nil {
+	fh, err := lom.CreatePart(path)
+	if err != nil {
+		return "", http.StatusInternalServerError, err
+	}
+	if args.fh, err = lom.NewChunkZWriter(args.chunk, fh, args.size); err != nil { // (compression and/or encryption iff configured)
+		cos.Close(fh)
 		return "", http.StatusInternalServerError, err
 	}
 
@@ -329,12 +354,14 @@ func (ups *ups) _put(args *partArgs) (etag string, ecode int, err error) {
 	)
 
 	// Initialize checksums and get writers
-	pc, writers := initPartChecksums(args)
-
-	if rsize <= 0 {
-		return "", http.StatusBadRequest, fmt.Errorf("%s: put-part invalid size (%d)", lom.Cname(), rsize)
+	pc, writers, err := initPartChecksums(args)
+	if err != nil {
+		return "", http.StatusBadRequest, err
 	}
 
+	// zero size: empty part; negative: unknown (e.g., UploadPartCopy source) - streamed as is
+	bufSize := cos.Ternary(rsize < 0, memsys.DefaultBufSize, rsize)
+
 	// write
 	// for remote buckets, use SGL buffering when memory is available
 	var (
@@ -349,13 +376,13 @@ func (ups *ups) _put(args *partArgs) (etag string, ecode int, err error) {
 	switch {
 	case !remote || args.skipBackend:
 		// no need to write to backend
-		buf, slab := t.gmm.AllocSize(rsize)
+		buf, slab := t.gmm.AllocSize(bufSize)
 		expectedSize, err = io.CopyBuffer(mw, reader, buf)
 		slab.Free(buf)
 	case t.gmm.Pressure() < memsys.PressureHigh:
 		// write 1) locally + sgl + checksums; 2) write sgl => backend
 		backend = t.Backend(lom.Bck())
-		sgl := t.gmm.NewSGL(rsize)
+		sgl := t.gmm.NewSGL(bufSize)
 		mw.Append(sgl)
 		expectedSize, err = io.Copy(mw, reader)
 		if err == nil {
@@ -380,7 +407,7 @@ func (ups *ups) _put(args *partArgs) (etag string, ecode int, err error) {
 		}
 
 		backend = t.Backend(lom.Bck())
-		sgl := t.gmm.NewSGL(rsize)
+		sgl := t.gmm.NewSGL(bufSize)
 		mw.Append(sgl)
 		expectedSize, err = io.Copy(mw, reader)
 		if err == nil {
@@ -413,13 +440,18 @@ func (ups *ups) _put(args *partArgs) (etag string, ecode int, err error) {
 		return "", ecode, err
 	}
 
-	// Finalize checksums (validate SHA256, store CRC32C and MD5)
+	// Finalize checksums (validate SHA256 and S3 checksums, store CRC and MD5)
 	etag, err = pc.finalize(chunk, args.partNum, etag)
 	if err != nil {
 		return "", http.StatusBadRequest, err
 	}
 	chunk.SetETag(etag)
 
+	if zw, ok := args.fh.(*core.ZWriter); ok {
+		if err := zw.Fini(); err != nil {
+			return "", http.StatusInternalServerError, err
+		}
+	}
 	if err := args.manifest.Add(chunk, size, int64(args.partNum)); err != nil {
 		return "", http.StatusInternalServerError, err
 	}
@@ -453,6 +485,12 @@ func (ups *ups) complete(args *completeArgs) (string, int, error) {
 	if manifest == nil {
 		return "", http.StatusNotFound, cos.NewErrNotFound(lom, uploadID)
 	}
+	// object lock: cannot overwrite (and see below)
+	if !args.locked {
+		if err := existingObjLock(lom, false /*locked*/); err != nil {
+			return "", http.StatusForbidden, err
+		}
+	}
 
 	// validate/enforce parts, compute etag
 	manifest.Lock()
@@ -500,6 +538,14 @@ func (ups *ups) complete(args *completeArgs) (string, int, error) {
 		locked = true
 	}
 
+	if locked && lom.Bprops().ObjectLock.Enabled {
+		ecode, err := ups._objLock(lom)
+		if err != nil {
+			lom.Unlock(true)
+			return "", ecode, err
+		}
+	}
+
 	cksum, err := manifest.WholeChecksum()
 	if err != nil {
 		if locked {
@@ -532,10 +578,25 @@ func (ups *ups) complete(args *completeArgs) (string, int, error) {
 	if remote {
 		t.statsT.IncWith(t.Backend(lom.Bck()).MetricName(stats.PutCount), vlabs)
 	}
+	xs.NBIPut(lom)
+	if args.event != "" {
+		bnotif.Notify(lom, args.event)
+	}
 
 	return cmn.QuoteETag(etag), 0, nil
 }
 
+// (under wlock) re-check existing object and apply default retention
+func (*ups) _objLock(lom *core.LOM) (int, error) {
+	if err := existingObjLock(lom, true /*locked*/); err != nil {
+		return http.StatusForbidden, err
+	}
+	if err := lom.Bprops().ObjectLock.Apply(lom.ObjAttrs(), time.Now()); err != nil {
+		return http.StatusBadRequest, err
+	}
+	return 0, nil
+}
+
 func (ups *ups) _completeRemote(r *http.Request, lom *core.LOM, uploadID string, body []byte, partList apc.MptCompletedParts) (etag string, ecode int, err error) {
 	var (
 		version  string
@@ -610,8 +671,8 @@ func (ups *ups) _abort(id string, lom *core.LOM) error {
 // Checksum strategy:
 // - Always compute CRC32C for chunk combination (cheap, can be combined without re-reading)
 // - If parts arrive in-order, also compute streaming bucket-configured checksum
-func initPartChecksums(args *partArgs) (pc partCksums, w []io.Writer) {
-	w = make([]io.Writer, 0, 4)
+func initPartChecksums(args *partArgs) (pc partCksums, w []io.Writer, err error) {
+	w = make([]io.Writer, 0, 5)
 	w = append(w, args.fh)
 
 	// S3 content-SHA256 header validation
@@ -623,9 +684,24 @@ func initPartChecksums(args *partArgs) (pc partCksums, w []io.Writer) {
 		}
 	}
 
-	// Always compute CRC32C for chunk combination
-	pc.crc32c = cos.NewCksumHash(cos.ChecksumCRC32C)
-	w = append(w, pc.crc32c.H)
+	// Always compute CRC for chunk combination
+	crcType := cos.ChecksumCRC32C
+	if args.lom.CksumType() == cos.ChecksumCRC64NVME {
+		crcType = cos.ChecksumCRC64NVME
+	}
+	pc.crc = cos.NewCksumHash(crcType)
+	w = append(w, pc.crc.H)
+
+	// S3 additional checksum (`x-amz-checksum-*`) header validation
+	if args.isS3 && args.req != nil {
+		if pc.s3expct, err = s3.CksumFromHeader(args.req.Header); err != nil {
+			return pc, nil, err
+		}
+		if pc.s3expct != nil && pc.s3expct.Ty() != crcType {
+			pc.s3cksum = cos.NewCksumHash(pc.s3expct.Ty())
+			w = append(w, pc.s3cksum.H)
+		}
+	}
 
 	// Check if part arrives in-order for streaming bucket-configured checksum
 	var streamWriter hash.Hash
@@ -640,7 +716,7 @@ func initPartChecksums(args *partArgs) (pc partCksums, w []io.Writer) {
 		w = append(w, pc.md5.H)
 	}
 
-	return pc, w
+	return pc, w, nil
 }
 
 // finalize validates and stores checksums in the chunk
@@ -654,10 +730,22 @@ func (pc *partCksums) finalize(chunk *core.Uchunk, partNum int, etag string) (st
 		}
 	}
 
-	// Store CRC32C in chunk for combination
-	if pc.crc32c != nil {
-		pc.crc32c.Finalize()
-		chunk.SetCksum(&pc.crc32c.Cksum)
+	// Store CRC in chunk for combination
+	if pc.crc != nil {
+		pc.crc.Finalize()
+		chunk.SetCksum(&pc.crc.Cksum)
+	}
+
+	// Validate S3 additional checksum
+	if pc.s3expct != nil {
+		computed := pc.crc
+		if pc.s3cksum != nil {
+			pc.s3cksum.Finalize()
+			computed = pc.s3cksum
+		}
+		if !computed.Equal(pc.s3expct) {
+			return "", cos.NewErrDataCksum(&computed.Cksum, pc.s3expct, fmt.Sprintf("part %d", partNum))
+		}
 	}
 
 	// S3 compatibility API over ais:// buckets: compute part ETag as MD5 of the part (S3 convention).
@@ -22,6 +22,7 @@ import (
 
 	"github.com/NVIDIA/aistore/ais/s3"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/bnotif"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -58,6 +59,7 @@ type (
 		config      *cmn.Config   // (during this request)
 		resphdr     http.Header   // as implied
 		workFQN     string        // temp fqn to be renamed
+		rawMD       string        // at-rest content as is (core.PutParams.RawMD)
 		atime       int64         // access time.Now()
 		ltime       int64         // mono.NanoTime, to measure latency
 		rltime      int64         // mono.NanoTime, to measure remote bucket latency
@@ -69,6 +71,8 @@ type (
 		skipVC      bool          // skip loading existing Version and skip comparing Checksums (skip VC)
 		skipBackend bool          // don't write to backend (e.g., cold-GET caching, rechunk)
 		locked      bool          // true if the LOM is already locked by the caller
+		loaded      bool          // LOM carries existing object's metadata (see dropObjLock)
+		dirtyWB     bool          // rebalance: migrating dirty (not yet written back) object
 		remoteErr   bool          // to exclude `putRemote` errors when counting soft IO errors
 	}
 
@@ -153,9 +157,13 @@ func (poi *putOI) do(resphdr http.Header, r *http.Request, dpq *dpq) (_ int, err
 	poi.owt = cmn.OwtPut // default
 
 	oah := poi.lom.ObjAttrs()
-	if poi.cksumToUse, err = oah.FromHeader(r.Header); err != nil {
+	cksum, err := oah.FromHeader(r.Header)
+	if err != nil {
 		return 0, err
 	}
+	if cksum != nil {
+		poi.cksumToUse = cksum // (otherwise, may have been set by the caller - e.g., S3 `x-amz-checksum-*`)
+	}
 
 	if dpq.sys.owt != "" {
 		poi.owt.FromS(dpq.sys.owt)
@@ -244,6 +252,7 @@ func (poi *putOI) chunk(chunkSize int64) (ecode int, err error) {
 		isS3:        false,
 		skipBackend: poi.skipBackend,
 		locked:      poi.locked,
+		event:       cmn.OwtNotifEvent(poi.owt),
 	})
 	return ecode, err
 }
@@ -252,7 +261,7 @@ func (poi *putOI) putObject() (ecode int, err error) {
 	maxMonoSize := int64(poi.lom.Bprops().Chunks.MaxMonolithicSize)
 	// protect the bucket: if the object size exceeds the max monolithic size, MUST chunk
 	// NOTE: if `poi.size` is not set, don't trigger chunking
-	if maxMonoSize > 0 && poi.size > maxMonoSize {
+	if maxMonoSize > 0 && poi.size > maxMonoSize && poi.rawMD == "" {
 		if cmn.Rom.V(5, cos.ModAIS) {
 			nlog.Infoln("PUT", poi.lom.Cname(), "size", poi.size, "exceeds object size limit, PUT as chunks")
 		}
@@ -260,6 +269,14 @@ func (poi *putOI) putObject() (ecode int, err error) {
 	}
 	poi.ltime = mono.NanoTime()
 
+	// object lock: cannot overwrite (compare with fini)
+	if poi.owt < cmn.OwtChunks {
+		if err := existingObjLock(poi.lom, poi.locked); err != nil {
+			cos.DrainReader(poi.r)
+			return http.StatusForbidden, err
+		}
+	}
+
 	// if checksums match PUT is a no-op
 	if !poi.skipVC && !poi.skipBackend {
 		if poi.lom.EqCksum(poi.cksumToUse) {
@@ -274,7 +291,7 @@ func (poi *putOI) putObject() (ecode int, err error) {
 	buf, slab, lmfh, erw := poi.write()
 	poi._cleanup(buf, slab, lmfh, erw)
 	if erw != nil {
-		err, ecode = erw, http.StatusInternalServerError
+		err, ecode = erw, cos.Ternary(cos.IsErrBadCksum(erw), http.StatusBadRequest, http.StatusInternalServerError)
 		goto rerr
 	}
 
@@ -282,6 +299,15 @@ func (poi *putOI) putObject() (ecode int, err error) {
 		goto rerr
 	}
 
+	// NBI change log: new content (including t2t copies and renames) but not cold GETs and rebalance
+	if poi.owt < cmn.OwtRebalance {
+		xs.NBIPut(poi.lom)
+	}
+	// bucket notifications: ditto, excluding re-chunking
+	if event := cmn.OwtNotifEvent(poi.owt); event != "" {
+		bnotif.Notify(poi.lom, event)
+	}
+
 	// NOTE stats: counting xactions and user PUTs; not counting (cold-GET -> PUT)
 	if poi.xctn != nil {
 		poi.stats()
@@ -436,9 +462,24 @@ func (poi *putOI) fini() (ecode int, err error) {
 	var (
 		lom = poi.lom
 		bck = lom.Bck()
+		xwb *xs.XactWB
 	)
+	// write-back: admit (or else, write through)
+	// migrated dirty object remains dirty regardless (and, if not admitted, gets re-scanned)
+	if bck.IsRemote() && (poi.owt == cmn.OwtPut || poi.dirtyWB) && bck.Props.WritePolicy.IsWriteBack() {
+		if xwb = poi.t.wbAdmit(lom); xwb != nil {
+			defer func() {
+				if err != nil {
+					xwb.Release()
+				}
+			}()
+		}
+	}
+	if poi.dirtyWB && xwb == nil {
+		xs.WBMark(bck)
+	}
 	// put remote
-	if bck.IsRemote() && poi.owt < cmn.OwtRebalance {
+	if bck.IsRemote() && poi.owt < cmn.OwtRebalance && xwb == nil {
 		ecode, err = poi.putRemote()
 		if err != nil {
 			if cmn.Rom.V(5, cos.ModAIS) {
@@ -475,6 +516,19 @@ func (poi *putOI) fini() (ecode int, err error) {
 		lom.SetAtimeUnix(poi.atime)
 	}
 
+	// object lock: existing object (under wlock) and new object's retention
+	if poi.owt < cmn.OwtChunks && bck.Props.ObjectLock.Enabled {
+		if err := existingObjLock(lom, true /*locked*/); err != nil {
+			return http.StatusForbidden, err
+		}
+		if poi.loaded {
+			dropObjLock(lom)
+		}
+		if err := bck.Props.ObjectLock.Apply(lom.ObjAttrs(), time.Unix(0, poi.atime)); err != nil {
+			return http.StatusBadRequest, err
+		}
+	}
+
 	// ais versioning
 	if bck.IsAIS() && lom.VersionConf().Enabled {
 		switch {
@@ -502,7 +556,17 @@ func (poi *putOI) fini() (ecode int, err error) {
 	if lom.AtimeUnix() == 0 { // (is set when migrating within cluster; prefetch special case)
 		lom.SetAtimeUnix(poi.atime)
 	}
-	return 0, lom.PersistMain(false /*isChunked*/)
+
+	// write-back: mark dirty and enqueue (under wlock - see xs.XactWB.clean)
+	// otherwise, the new content is either in sync with remote or not remote at all
+	lom.SetDirtyWB(xwb != nil || poi.dirtyWB)
+	if err = lom.PersistMain(false /*isChunked*/); err != nil {
+		return 0, err
+	}
+	if xwb != nil {
+		xwb.Enqueue(lom)
+	}
+	return 0, nil
 }
 
 // via backend.PutObj()
@@ -511,7 +575,7 @@ func (poi *putOI) putRemote() (int, error) {
 		lom       = poi.lom
 		startTime = mono.NanoTime()
 	)
-	lmfh, err := cos.NewFileHandle(poi.workFQN)
+	lmfh, err := lom.NewWorkHandle(poi.workFQN)
 	if err != nil {
 		return 0, cmn.NewErrFailedTo(poi.t, "open", poi.workFQN, err)
 	}
@@ -550,6 +614,11 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh cos.LomWriter, er
 	if lmfh, err = poi.lom.CreateWork(poi.workFQN); err != nil {
 		return nil, nil, nil, err
 	}
+	if poi.rawMD == "" {
+		if lmfh, err = poi.lom.NewZWriter(lmfh, poi.size); err != nil { // transparent compression and/or encryption iff configured
+			return nil, nil, lmfh, err
+		}
+	}
 	if poi.size <= 0 {
 		buf, slab = poi.t.gmm.Alloc()
 	} else {
@@ -557,6 +626,10 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh cos.LomWriter, er
 	}
 
 	switch {
+	case poi.rawMD != "":
+		// at-rest content as is: keep the checksum that has arrived with the object
+		poi.lom.SetCksum(cos.Ternary(cos.NoneC(poi.cksumToUse), cos.NoneCksum, poi.cksumToUse))
+		written, err = cos.CopyBuffer(lmfh, poi.r, buf)
 	case ckconf.Type == cos.ChecksumNone:
 		poi.lom.SetCksum(cos.NoneCksum)
 		// not using `ReadFrom` of the `*os.File` -
@@ -606,8 +679,14 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh cos.LomWriter, er
 		debug.AssertNoErr(err)
 	}
 
-	cos.Close(lmfh)
+	if err = lmfh.Close(); err != nil { // (compressed: flush and write seek table; encrypted: seal the last segment)
+		return buf, slab, nil, err
+	}
 
+	if poi.rawMD != "" {
+		err = poi.lom.SetRawMD(poi.rawMD, written)
+		return buf, slab, nil, err
+	}
 	poi.lom.SetSize(written) // TODO: compare with non-zero lom.Lsize() that may have been set via oa.FromHeader()
 	if cksums.store != nil {
 		if !cksums.finalized {
@@ -729,8 +808,9 @@ do: // retry uplock or ec-recovery, the latter only once
 		goto fin // ok, done
 	case cold:
 		// have remote backend - use it
-	case goi.latestVer:
+	case goi.latestVer && !goi.lom.IsDirtyWB():
 		// apc.QparamLatestVer or 'versioning.validate_warm_get'
+		// (skipping dirty objects that are yet to be written back - see xs/wback.go)
 		res := goi.lom.CheckRemoteMD(true /* rlocked */, false /*synchronize*/, goi.req)
 		if res.Err != nil {
 			return res.ErrCode, res.Err
@@ -847,6 +927,9 @@ func (goi *getOI) expostfacto(fqn string) error {
 		return cmn.ErrGetTxBenign
 	}
 	lom.SetAtimeUnix(goi.atime)
+	if lom.Bprops().LRU.Policy.CountsHits() {
+		lom.IncHits()
+	}
 	lom.Recache()
 	return nil
 }
@@ -1256,6 +1339,10 @@ func (goi *getOI) setwhdr(whdr http.Header, cksum *cos.Cksum, size int64) {
 	if goi.dpq.isS3 {
 		whdr.Set(cos.HdrContentLength, strconv.FormatInt(size, 10))
 		s3.SetS3Headers(whdr, goi.lom)
+		s3.SetSSEHeaders(whdr, goi.lom)
+		if size == goi.lom.Lsize() { // (not a range)
+			s3.SetCksumHeaders(whdr, goi.lom)
+		}
 	} else {
 		cmn.ToHeader(goi.lom.ObjAttrs(), whdr, size, cksum)
 	}
@@ -1532,7 +1619,11 @@ func (a *apndOI) apnd(buf []byte) (packedHdl string, err error) {
 		workFQN = a.lom.GenFQN(fs.WorkCT, fs.WorkfileAppend)
 		a.lom.Lock(false)
 		if a.lom.Load(false /*cache it*/, false /*locked*/) == nil {
-			_, a.hdl.partialCksum, err = cos.CopyFile(a.lom.FQN, workFQN, buf, a.lom.CksumType())
+			if !a.lom.IsPlain() {
+				a.hdl.partialCksum, err = a.copyz(workFQN, buf)
+			} else {
+				_, a.hdl.partialCksum, err = cos.CopyFile(a.lom.FQN, workFQN, buf, a.lom.CksumType())
+			}
 			a.lom.Unlock(false)
 			if err != nil {
 				return "", err
@@ -1573,6 +1664,31 @@ func (a *apndOI) apnd(buf []byte) (packedHdl string, err error) {
 	return packedHdl, nil
 }
 
+// compressed and/or encrypted object: copy its (plain) content to append to
+func (a *apndOI) copyz(workFQN string, buf []byte) (cksum *cos.CksumHash, err error) {
+	lmfh, err := a.lom.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer cos.Close(lmfh)
+
+	wfh, err := a.lom.CreateWork(workFQN)
+	if err != nil {
+		return nil, err
+	}
+	_, cksum, err = cos.CopyAndChecksum(wfh, lmfh, buf, a.lom.CksumType())
+	if errC := wfh.Close(); err == nil {
+		err = errC
+	}
+	if err != nil {
+		if nerr := cos.RemoveFile(workFQN); nerr != nil && !cos.IsNotExist(nerr) {
+			nlog.Errorf(fmtNested, a.t, err, "remove", workFQN, nerr)
+		}
+		return nil, err
+	}
+	return cksum, nil
+}
+
 func (a *apndOI) flush() (int, error) {
 	if a.hdl.workFQN == "" {
 		return 0, fmt.Errorf("failed to finalize append-file operation: empty source in the %+v handle", a.hdl)
@@ -2035,7 +2151,7 @@ func (a *putA2I) do() (int, error) {
 	}
 	// standard library does not support appending to tgz, zip, and such;
 	// for TAR there is an optimizing workaround not requiring a full copy
-	if a.mime == archive.ExtTar && !a.put /*append*/ && !a.lom.IsChunked() {
+	if a.mime == archive.ExtTar && !a.put /*append*/ && !a.lom.IsChunked() && a.lom.IsPlain() {
 		var (
 			err       error
 			fh        *os.File
@@ -2165,6 +2281,7 @@ func (a *putA2I) finalize(size int64, cksum *cos.Cksum, fqn string) error {
 		return err
 	}
 	a.lom.SetSize(size)
+	a.lom.SetPlain()
 	a.lom.SetCksum(cksum)
 	a.lom.SetAtimeUnix(a.started)
 	if err := a.lom.Persist(); err != nil {
@@ -2211,6 +2328,20 @@ func (t *target) putMirror(lom *core.LOM) {
 	xputlrep.Repl(lom)
 }
 
+// returns nil when the object must be written through (see xs.XactWB.Admit)
+func (t *target) wbAdmit(lom *core.LOM) *xs.XactWB {
+	rns := xreg.RenewWriteBack(lom.Bck())
+	if rns.Err != nil {
+		nlog.Errorln(t.String(), lom.Cname(), rns.Err)
+		return nil
+	}
+	xwb := rns.Entry.Get().(*xs.XactWB)
+	if !xwb.Admit(lom.ObjName) {
+		return nil
+	}
+	return xwb
+}
+
 //
 // uplock
 //
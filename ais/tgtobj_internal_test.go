@@ -175,6 +175,66 @@ func TestPutObjectChunks(tst *testing.T) {
 	}
 }
 
+// PUT over a locked (legal hold) and cached object
+func TestPutObjectLocked(tst *testing.T) {
+	const (
+		bucket  = "bck-objlock"
+		objName = "locked-obj"
+		size    = 1024
+	)
+	bck := meta.NewBck(bucket, apc.AIS, cmn.NsGlobal)
+	bmd := t.owner.bmd.get().clone()
+	bmd.add(bck, &cmn.Bprops{
+		Cksum:      cmn.CksumConf{Type: cos.ChecksumNone},
+		ObjectLock: cmn.ObjectLockConf{Enabled: true},
+	})
+	t.owner.bmd.putPersist(bmd, nil)
+	fs.CreateBucket(bck.Bucket(), false /*nilbmd*/)
+
+	put := func(lom *core.LOM) (int, error) {
+		reader, _ := readers.New(&readers.Arg{Type: readers.Rand, Size: size, CksumType: cos.ChecksumNone})
+		req := &http.Request{Header: make(http.Header), Body: reader, ContentLength: size}
+		dpq := dpqAlloc()
+		defer dpqFree(dpq)
+		return t.putObject(newDiscardRW(), req, dpq, lom, false /*t2t*/, cmn.GCO.Get())
+	}
+
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(tst, lom.InitBck(bck))
+	_, err := put(lom)
+	tassert.CheckFatal(tst, err)
+
+	// legal hold; cache it
+	lom.Lock(true)
+	tassert.CheckFatal(tst, lom.Load(false /*cache it*/, true /*locked*/))
+	lom.ObjAttrs().SetLegalHold(true)
+	err = lom.Persist()
+	lom.Unlock(true)
+	tassert.CheckFatal(tst, err)
+	tassert.CheckFatal(tst, lom.Load(true /*cache it*/, false /*locked*/))
+
+	// overwrite
+	nlom := core.AllocLOM(objName)
+	defer core.FreeLOM(nlom)
+	tassert.CheckFatal(tst, nlom.InitBck(bck))
+	ecode, err := put(nlom)
+	tassert.Fatalf(tst, err != nil && ecode == http.StatusForbidden, "expecting 403, got (%d, %v)", ecode, err)
+
+	// still locked (and cached metadata intact)
+	clom := core.AllocLOM(objName)
+	defer core.FreeLOM(clom)
+	tassert.CheckFatal(tst, clom.InitBck(bck))
+	tassert.CheckFatal(tst, clom.Load(false, false))
+	tassert.Errorf(tst, clom.ObjAttrs().IsObjLocked(time.Now()), "expecting %s to remain locked", clom.Cname())
+
+	// cleanup
+	clom.Lock(true)
+	clom.ObjAttrs().SetLegalHold(false)
+	clom.RemoveObj()
+	clom.Unlock(true)
+}
+
 func BenchmarkObjPut(b *testing.B) {
 	benches := []struct {
 		fileSize int64
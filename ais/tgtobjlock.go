@@ -0,0 +1,99 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"slices"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Object lock (WORM) enforcement on the target: objects under active retention and/or
+// legal hold cannot be deleted, overwritten, renamed, or evicted; a bucket containing
+// such objects cannot be destroyed (or evicted). For semantics, see cmn/objlock.go.
+
+// given loaded LOM
+func lomObjLock(lom *core.LOM, bypassGovernance bool) error {
+	if !lom.Bprops().ObjectLock.Enabled {
+		return nil
+	}
+	return lom.ObjAttrs().CheckObjLock(lom.Cname(), time.Now(), bypassGovernance)
+}
+
+// given LOM that represents new content (PUT and friends): check the existing object, if any
+func existingObjLock(lom *core.LOM, locked bool) error {
+	if !lom.Bprops().ObjectLock.Enabled {
+		return nil
+	}
+	cur := core.AllocLOM(lom.ObjName)
+	defer core.FreeLOM(cur)
+	if err := cur.InitBck(lom.Bck()); err != nil {
+		return err
+	}
+	if err := cur.Load(false /*cache it*/, locked); err != nil {
+		if cos.IsNotExist(err) || cmn.IsErrObjNought(err) {
+			return nil
+		}
+		return err
+	}
+	return cur.ObjAttrs().CheckObjLock(cur.Cname(), time.Now(), false /*bypass*/)
+}
+
+// new content does not inherit existing object's lock state;
+// cloning custom metadata that may be shared with the LOM cache (see lom.Load)
+func dropObjLock(lom *core.LOM) {
+	md := lom.GetCustomMD()
+	if len(md) == 0 {
+		return
+	}
+	nmd := make(cos.StrKVs, len(md))
+	for k, v := range md {
+		if !slices.Contains(cmn.ObjLockMDKeys, k) {
+			nmd[k] = v
+		}
+	}
+	lom.SetCustomMD(nmd)
+}
+
+// destroy (or evict) bucket: fail if any locally stored object is locked
+func bckObjLock(bck *meta.Bck) error {
+	if bck.Props == nil || !bck.Props.ObjectLock.Enabled {
+		return nil
+	}
+	var (
+		now = time.Now()
+		cb  = func(fqn string, de fs.DirEntry) error {
+			if de.IsDir() {
+				return nil
+			}
+			lom := core.AllocLOM("")
+			defer core.FreeLOM(lom)
+			if err := lom.InitFQN(fqn, bck.Bucket()); err != nil {
+				return nil
+			}
+			if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
+				return nil
+			}
+			return lom.ObjAttrs().CheckObjLock(lom.Cname(), now, false /*bypass*/)
+		}
+	)
+	for _, mi := range fs.GetAvail() {
+		opts := &fs.WalkOpts{
+			Mi:       mi,
+			Bck:      *bck.Bucket(),
+			CTs:      []string{fs.ObjCT},
+			Callback: cb,
+		}
+		if err := fs.Walk(opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -5,7 +5,6 @@
 package ais
 
 import (
-	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -17,6 +16,7 @@ import (
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/encrypt"
 	"github.com/NVIDIA/aistore/cmn/feat"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
@@ -36,6 +36,9 @@ func (t *target) s3Handler(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		return
 	}
+	if len(apiItems) > 0 && r.Method != http.MethodOptions && r.Header.Get(s3.HdrOrigin) != "" {
+		t.corsS3(w, r, apiItems[0])
+	}
 
 	switch r.Method {
 	case http.MethodHead:
@@ -47,15 +50,46 @@ func (t *target) s3Handler(w http.ResponseWriter, r *http.Request) {
 		t.putCopyMpt(w, r, config, apiItems)
 	case http.MethodDelete:
 		q := r.URL.Query()
-		if q.Has(s3.QparamMptUploadID) {
+		switch {
+		case q.Has(s3.QparamMptUploadID):
 			t.abortMptS3(w, r, apiItems, q)
-		} else {
+		case q.Has(s3.QparamTagging):
+			t.delObjTaggingS3(w, r, apiItems)
+		default:
 			t.delObjS3(w, r, apiItems)
 		}
 	case http.MethodPost:
 		t.postObjS3(w, r, apiItems)
+	case http.MethodOptions:
+		t.preflightS3(w, r, apiItems)
 	default:
-		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodHead, http.MethodPut, http.MethodPost)
+		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodHead, http.MethodPut, http.MethodPost,
+			http.MethodOptions)
+	}
+}
+
+// OPTIONS /s3/<bucket-name>[/<object-name>]
+// CORS preflight (compare with proxy)
+func (t *target) preflightS3(w http.ResponseWriter, r *http.Request, items []string) {
+	if len(items) == 0 {
+		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodHead, http.MethodPut, http.MethodPost,
+			http.MethodOptions)
+		return
+	}
+	bck, ecode, err := meta.InitByNameOnly(items[0], t.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
+	if err := s3.Preflight(w.Header(), r, &bck.Props.CORS); err != nil {
+		s3.WriteErr(w, r, err, 0)
+	}
+}
+
+// cross-origin (browser) request: add CORS response headers, if configured
+func (t *target) corsS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	if bck, _, err := meta.InitByNameOnly(bucket, t.owner.bmd); err == nil {
+		s3.SetCORSHeaders(w.Header(), r, &bck.Props.CORS)
 	}
 }
 
@@ -79,11 +113,18 @@ func (t *target) putCopyMpt(w http.ResponseWriter, r *http.Request, config *cmn.
 
 	q := r.URL.Query()
 	switch {
+	case q.Has(s3.QparamTagging):
+		t.putObjTaggingS3(w, r, bck, s3.ObjName(items))
+	case q.Has(s3.QparamRetention):
+		t.putObjRetentionS3(w, r, bck, s3.ObjName(items))
+	case q.Has(s3.QparamLegalHold):
+		t.putObjLegalHoldS3(w, r, bck, s3.ObjName(items))
 	case q.Has(s3.QparamMptPartNo) && q.Has(s3.QparamMptUploadID):
 		if r.Header.Get(cos.S3HdrObjSrc) != "" {
-			// TODO: copy another object (or its range) => part of the specified multipart upload.
-			// https://docs.aws.amazon.com/AmazonS3/latest/API/API_UploadPartCopy.html
-			s3.WriteErr(w, r, errors.New("UploadPartCopy not implemented yet"), http.StatusNotImplemented)
+			if cmn.Rom.V(5, cos.ModS3) {
+				nlog.Infoln("putPartCopyMpt", bck.String(), items, q)
+			}
+			t.putPartCopyMptS3(w, r, items, q, bck)
 			return
 		}
 		if cmn.Rom.V(5, cos.ModS3) {
@@ -106,50 +147,12 @@ func (t *target) putCopyMpt(w http.ResponseWriter, r *http.Request, config *cmn.
 // S3 copy object API use the "destination" bucket in the URL path, but AIStore target use "source" bucket
 // we need this extra `copyObjS3` handler at target to address the translation
 func (t *target) copyObjS3(w http.ResponseWriter, r *http.Request, config *cmn.Config, items []string) {
-	src := r.Header.Get(cos.S3HdrObjSrc)
-
-	// [HACK]
-	// it appears, 'x-amz-copy-source' header gets double-escaped upon http redirect
-	// (s3cmd and aws clients, both)
-	srcUnescaped, err := url.QueryUnescape(src)
-	if err != nil {
-		nlog.Errorf("Warning: failed to unescape '%s=%s' header: %v", cos.S3HdrObjSrc, src, err)
-	} else if src != srcUnescaped {
-		if cmn.Rom.V(5, cos.ModS3) {
-			nlog.Infoln("Warning: header", cos.S3HdrObjSrc, "is double-escaped - unescaping from", src, "to", srcUnescaped)
-		}
-		src = srcUnescaped
-	}
-
-	src = strings.Trim(src, "/") // in AWS examples the path starts with "/"
-	parts := strings.SplitN(src, "/", 2)
-	if len(parts) < 2 {
-		s3.WriteErr(w, r, errS3Obj, 0)
-		return
-	}
-	// src
-	bckSrc, ecode, err := meta.InitByNameOnly(parts[0], t.owner.bmd)
+	lom, ecode, err := t.initCopySrcS3(r)
 	if err != nil {
 		s3.WriteErr(w, r, err, ecode)
 		return
 	}
-	objSrc := strings.Trim(parts[1], "/")
-	if err := bckSrc.Init(t.owner.bmd); err != nil {
-		s3.WriteErr(w, r, err, 0)
-		return
-	}
-	lom := core.AllocLOM(objSrc)
 	defer core.FreeLOM(lom)
-	if err := lom.InitBck(bckSrc); err != nil {
-		if cmn.IsErrRemoteBckNotFound(err) {
-			t.BMDVersionFixup(r)
-			err = lom.InitBck(bckSrc)
-		}
-		if err != nil {
-			s3.WriteErr(w, r, err, 0)
-		}
-		return
-	}
 
 	// dst
 	bckTo, ecode, err := meta.InitByNameOnly(items[0], t.owner.bmd)
@@ -187,6 +190,51 @@ func (t *target) copyObjS3(w http.ResponseWriter, r *http.Request, config *cmn.C
 	sgl.Free()
 }
 
+// parse `x-amz-copy-source` header and return initialized source LOM
+// (used by CopyObject and UploadPartCopy); the caller must free the LOM
+func (t *target) initCopySrcS3(r *http.Request) (*core.LOM, int, error) {
+	src := r.Header.Get(cos.S3HdrObjSrc)
+
+	// [HACK]
+	// it appears, 'x-amz-copy-source' header gets double-escaped upon http redirect
+	// (s3cmd and aws clients, both)
+	srcUnescaped, err := url.QueryUnescape(src)
+	if err != nil {
+		nlog.Errorf("Warning: failed to unescape '%s=%s' header: %v", cos.S3HdrObjSrc, src, err)
+	} else if src != srcUnescaped {
+		if cmn.Rom.V(5, cos.ModS3) {
+			nlog.Infoln("Warning: header", cos.S3HdrObjSrc, "is double-escaped - unescaping from", src, "to", srcUnescaped)
+		}
+		src = srcUnescaped
+	}
+
+	src = strings.Trim(src, "/") // in AWS examples the path starts with "/"
+	parts := strings.SplitN(src, "/", 2)
+	if len(parts) < 2 {
+		return nil, 0, errS3Obj
+	}
+	bckSrc, ecode, err := meta.InitByNameOnly(parts[0], t.owner.bmd)
+	if err != nil {
+		return nil, ecode, err
+	}
+	objSrc := strings.Trim(parts[1], "/")
+	if err := bckSrc.Init(t.owner.bmd); err != nil {
+		return nil, 0, err
+	}
+	lom := core.AllocLOM(objSrc)
+	if err := lom.InitBck(bckSrc); err != nil {
+		if cmn.IsErrRemoteBckNotFound(err) {
+			t.BMDVersionFixup(r)
+			err = lom.InitBck(bckSrc)
+		}
+		if err != nil {
+			core.FreeLOM(lom)
+			return nil, 0, err
+		}
+	}
+	return lom, 0, nil
+}
+
 func (t *target) putObjS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, config *cmn.Config, lom *core.LOM) {
 	if err := lom.InitBck(bck); err != nil {
 		if cmn.IsErrRemoteBckNotFound(err) {
@@ -201,7 +249,49 @@ func (t *target) putObjS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck,
 	started := time.Now()
 	lom.SetAtimeUnix(started.UnixNano())
 
-	// TODO: dual checksumming, e.g. lom.SetCustom(apc.AWS, ...)
+	// S3 additional checksum (`x-amz-checksum-*`), to validate and store
+	s3cksum, err := s3.CksumFromHeader(r.Header)
+	if err != nil {
+		s3.WriteErr(w, r, err, http.StatusBadRequest)
+		return
+	}
+	if s3cksum != nil && s3cksum.Ty() == cos.ChecksumCRC64NVME && lom.CksumType() != cos.ChecksumNone {
+		lom.SetCustomKey(cmn.CRC64ObjMD, s3cksum.Val())
+	}
+
+	// S3 object tagging (`x-amz-tagging`)
+	tags, err := s3.TagsFromHeader(r.Header)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	if len(tags) > 0 {
+		lom.SetCustomKey(cmn.TagsObjMD, tags.Encode())
+	}
+
+	// S3 object lock (`x-amz-object-lock-*`)
+	if err := s3.ObjLockFromHeader(r.Header, lom.ObjAttrs(), bck.Props.ObjectLock.Enabled); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+
+	// S3 server-side encryption: SSE-S3 (bucket-configured) or SSE-C (customer-provided key)
+	ssec, err := s3.SSEFromHeader(r.Header, bck)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	if ssec != nil {
+		// SSE-C applies to monolithic objects only
+		if maxMonoSize := int64(bck.Props.Chunks.MaxMonolithicSize); maxMonoSize > 0 && r.ContentLength > maxMonoSize {
+			err := fmt.Errorf("%s: SSE-C is not supported for objects larger than %s (chunks.max_monolithic_size)",
+				lom.Cname(), cos.ToSizeIEC(maxMonoSize, 0))
+			s3.WriteErr(w, r, s3.NewErrNotImplemented(err), 0)
+			return
+		}
+		lom.SetSSEC(ssec)
+		lom.SetCustomKey(cmn.SSECKeyMD5ObjMD, encrypt.SSECKeyMD5(ssec))
+	}
 
 	dpq := dpqAlloc()
 	if err := dpq.parse(r.URL.RawQuery); err != nil {
@@ -215,8 +305,9 @@ func (t *target) putObjS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck,
 		poi.t = t
 		poi.lom = lom
 		poi.config = config
-		poi.skipVC = cmn.Rom.Features().IsSet(feat.SkipVC) || dpq.skipVC // apc.QparamSkipVC
+		poi.skipVC = cmn.Rom.Features().IsSet(feat.SkipVC) || dpq.skipVC || ssec != nil // apc.QparamSkipVC
 		poi.restful = true
+		poi.cksumToUse = s3cksum
 	}
 	ecode, err := poi.do(nil /*response hdr*/, r, dpq)
 	freePOI(poi)
@@ -225,6 +316,8 @@ func (t *target) putObjS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck,
 		s3.WriteErr(w, r, err, ecode)
 	} else {
 		s3.SetS3Headers(w.Header(), lom)
+		s3.SetCksumHeaders(w.Header(), lom)
+		s3.SetSSEHeaders(w.Header(), lom)
 	}
 	dpqFree(dpq)
 }
@@ -254,6 +347,17 @@ func (t *target) getObjS3(w http.ResponseWriter, r *http.Request, items []string
 		return
 	}
 	objName := s3.ObjName(items)
+	switch {
+	case q.Has(s3.QparamTagging):
+		t.getObjTaggingS3(w, r, bck, objName)
+		return
+	case q.Has(s3.QparamRetention):
+		t.getObjRetentionS3(w, r, bck, objName)
+		return
+	case q.Has(s3.QparamLegalHold):
+		t.getObjLegalHoldS3(w, r, bck, objName)
+		return
+	}
 	if q.Has(s3.QparamMptPartNo) {
 		if cmn.Rom.V(5, cos.ModS3) {
 			nlog.Infoln("getMptPart", bck.String(), objName, q)
@@ -278,7 +382,14 @@ func (t *target) getObjS3(w http.ResponseWriter, r *http.Request, items []string
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
+	ssec, err := s3.SSECFromHeader(r.Header)
+	if err != nil {
+		dpqFree(dpq)
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
 	lom := core.AllocLOM(objName)
+	lom.SetSSEC(ssec)
 	dpq.isS3 = true
 	lom, err = t.getObject(w, r, dpq, bck, lom)
 	core.FreeLOM(lom)
@@ -338,8 +449,23 @@ func (t *target) headObjS3(w http.ResponseWriter, r *http.Request, items []strin
 	custom := op.GetCustomMD()
 	lom.SetCustomMD(custom)
 
+	// SSE-C: same key required
+	if md5, ok := custom[cmn.SSECKeyMD5ObjMD]; ok && exists {
+		ssec, err := s3.SSECFromHeader(r.Header)
+		if err != nil {
+			s3.WriteErr(w, r, err, 0)
+			return
+		}
+		if ssec == nil || encrypt.SSECKeyMD5(ssec) != md5 {
+			s3.WriteErr(w, r, fmt.Errorf("%s: %w", lom.Cname(), core.ErrSSECKey), 0)
+			return
+		}
+	}
+
 	// set s3 response headers
 	s3.SetS3Headers(hdr, lom)
+	s3.SetCksumHeaders(hdr, lom)
+	s3.SetSSEHeaders(hdr, lom)
 	hdr.Set(cos.HdrContentLength, strconv.FormatInt(op.Size, 10))
 	if v, ok := custom[cos.HdrContentType]; ok {
 		hdr.Set(cos.HdrContentType, v)
@@ -366,12 +492,15 @@ func (t *target) delObjS3(w http.ResponseWriter, r *http.Request, items []string
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
-	ecode, err = t.DeleteObject(lom, false)
+	ecode, err = t.deleteObject(lom, false /*evict*/, s3.IsBypassGovernance(r.Header))
 	if err != nil {
 		name := lom.Cname()
-		if ecode == http.StatusNotFound {
+		switch {
+		case ecode == http.StatusNotFound:
 			s3.WriteErr(w, r, cos.NewErrNotFound(t, name), http.StatusNotFound)
-		} else {
+		case cmn.IsErrObjLocked(err):
+			s3.WriteErr(w, r, err, ecode)
+		default:
 			s3.WriteErr(w, r, fmt.Errorf("error deleting %s: %v", name, err), ecode)
 		}
 		return
@@ -380,6 +509,100 @@ func (t *target) delObjS3(w http.ResponseWriter, r *http.Request, items []string
 	ec.ECM.CleanupObject(lom)
 }
 
+// GET /s3/<bucket-name>/<object-name>?tagging
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetObjectTagging.html
+func (t *target) getObjTaggingS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	if ecode, err := t._loadMetaS3(lom, false /*locked*/); err != nil {
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
+	v, _ := lom.GetCustomKey(cmn.TagsObjMD)
+	tags, err := cmn.ParseTags(v)
+	if err != nil {
+		s3.WriteErr(w, r, fmt.Errorf("%s: %v", lom.Cname(), err), 0)
+		return
+	}
+	resp := s3.NewTagging(tags)
+	sgl := t.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// PUT /s3/<bucket-name>/<object-name>?tagging (replaces existing tag set, if any)
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectTagging.html
+func (t *target) putObjTaggingS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	tagging, err := s3.DecodeTagging(r.Body)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	tags := tagging.ToNative()
+	if err := tags.ValidateObj(); err != nil {
+		s3.WriteErr(w, r, s3.NewErrInvalidTag(err), 0)
+		return
+	}
+	if ecode, err := t.setObjTags(bck, objName, tags); err != nil {
+		s3.WriteErr(w, r, err, ecode)
+	}
+}
+
+// DELETE /s3/<bucket-name>/<object-name>?tagging
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_DeleteObjectTagging.html
+func (t *target) delObjTaggingS3(w http.ResponseWriter, r *http.Request, items []string) {
+	bck, ecode, err := meta.InitByNameOnly(items[0], t.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
+	if ecode, err := t.setObjTags(bck, s3.ObjName(items), nil); err != nil {
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// set (or, when empty, remove) object tags under write lock
+func (t *target) setObjTags(bck *meta.Bck, objName string, tags cmn.Tags) (int, error) {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck); err != nil {
+		return 0, err
+	}
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if ecode, err := t._loadMetaS3(lom, true /*locked*/); err != nil {
+		return ecode, err
+	}
+	if len(tags) == 0 {
+		if _, ok := lom.GetCustomKey(cmn.TagsObjMD); !ok {
+			return 0, nil
+		}
+		lom.DelCustomKey(cmn.TagsObjMD)
+	} else {
+		lom.SetCustomKey(cmn.TagsObjMD, tags.Encode())
+	}
+	return 0, lom.Persist()
+}
+
+// tags and object lock state are in-cluster metadata: the object must be present
+func (t *target) _loadMetaS3(lom *core.LOM, locked bool) (int, error) {
+	if err := lom.Load(true /*cache it*/, locked); err != nil {
+		if cos.IsNotExist(err) {
+			return http.StatusNotFound, cos.NewErrNotFound(t, lom.Cname())
+		}
+		return 0, err
+	}
+	return 0, nil
+}
+
 // POST /s3/<bucket-name>/<object-name>
 func (t *target) postObjS3(w http.ResponseWriter, r *http.Request, items []string) {
 	bck, ecode, err := meta.InitByNameOnly(items[0], t.owner.bmd)
@@ -0,0 +1,143 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// S3 object lock: per-object retention and legal hold (see also tgtobjlock.go)
+
+// GET /s3/<bucket-name>/<object-name>?retention
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetObjectRetention.html
+func (t *target) getObjRetentionS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	lom, ecode, err := t._loadObjLockS3(bck, objName)
+	if err != nil {
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
+	defer core.FreeLOM(lom)
+	ret, ok := lom.ObjAttrs().Retention()
+	if !ok {
+		s3.WriteErr(w, r, s3.NewErrNoSuchObjRetention(lom.Cname()), 0)
+		return
+	}
+	resp := s3.NewRetention(ret)
+	sgl := t.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// PUT /s3/<bucket-name>/<object-name>?retention
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectRetention.html
+func (t *target) putObjRetentionS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	ret, err := s3.DecodeRetention(r.Body)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	nr, err := ret.ToNative()
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	bypass := s3.IsBypassGovernance(r.Header)
+	ecode, err := t.setObjLockS3(bck, objName, func(oa *cmn.ObjAttrs, cname string) error {
+		if err := oa.CheckRetentionUpdate(cname, nr, time.Now(), bypass); err != nil {
+			return err
+		}
+		oa.SetRetention(nr)
+		return nil
+	})
+	if err != nil {
+		s3.WriteErr(w, r, err, ecode)
+	}
+}
+
+// GET /s3/<bucket-name>/<object-name>?legal-hold
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetObjectLegalHold.html
+func (t *target) getObjLegalHoldS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	lom, ecode, err := t._loadObjLockS3(bck, objName)
+	if err != nil {
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
+	resp := s3.NewLegalHold(lom.ObjAttrs().LegalHold())
+	core.FreeLOM(lom)
+	sgl := t.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// PUT /s3/<bucket-name>/<object-name>?legal-hold
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectLegalHold.html
+func (t *target) putObjLegalHoldS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	hold, err := s3.DecodeLegalHold(r.Body)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	on, err := hold.ToNative()
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	ecode, err := t.setObjLockS3(bck, objName, func(oa *cmn.ObjAttrs, _ string) error {
+		oa.SetLegalHold(on)
+		return nil
+	})
+	if err != nil {
+		s3.WriteErr(w, r, err, ecode)
+	}
+}
+
+// returns loaded LOM (the caller must free)
+func (t *target) _loadObjLockS3(bck *meta.Bck, objName string) (*core.LOM, int, error) {
+	if !bck.Props.ObjectLock.Enabled {
+		return nil, 0, s3.NewErrObjLockNotEnabled()
+	}
+	lom := core.AllocLOM(objName)
+	if err := lom.InitBck(bck); err != nil {
+		core.FreeLOM(lom)
+		return nil, 0, err
+	}
+	if ecode, err := t._loadMetaS3(lom, false /*locked*/); err != nil {
+		core.FreeLOM(lom)
+		return nil, ecode, err
+	}
+	return lom, 0, nil
+}
+
+// modify object lock state under write lock
+func (t *target) setObjLockS3(bck *meta.Bck, objName string, modify func(*cmn.ObjAttrs, string) error) (int, error) {
+	if !bck.Props.ObjectLock.Enabled {
+		return 0, s3.NewErrObjLockNotEnabled()
+	}
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck); err != nil {
+		return 0, err
+	}
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if ecode, err := t._loadMetaS3(lom, true /*locked*/); err != nil {
+		return ecode, err
+	}
+	if err := modify(lom.ObjAttrs(), lom.Cname()); err != nil {
+		return http.StatusForbidden, err
+	}
+	return 0, lom.Persist()
+}
@@ -8,13 +8,15 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"net/url"
-	"os"
 	"strconv"
+	"time"
 
 	"github.com/NVIDIA/aistore/ais/s3"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
@@ -41,6 +43,15 @@ func (t *target) startMptS3(w http.ResponseWriter, r *http.Request, items []stri
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
+	// chunks are encrypted with the bucket-configured key (if any)
+	ssec, err := s3.SSEFromHeader(r.Header, bck)
+	if err == nil && ssec != nil {
+		err = s3.NewErrNotImplemented(errors.New("multipart upload with SSE-C is not supported"))
+	}
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
 
 	uploadID, err := t.ups.start(r, lom, false /*skipBackend*/)
 	if err != nil {
@@ -67,17 +78,7 @@ func (t *target) startMptS3(w http.ResponseWriter, r *http.Request, items []stri
 // https://docs.aws.amazon.com/AmazonS3/latest/API/API_UploadPart.html
 func (t *target) putPartMptS3(w http.ResponseWriter, r *http.Request, items []string, q url.Values, bck *meta.Bck) {
 	// 1. parse/validate
-	uploadID := q.Get(s3.QparamMptUploadID)
-	if uploadID == "" {
-		s3.WriteErr(w, r, errors.New("empty uploadId"), 0)
-		return
-	}
-	part := q.Get(s3.QparamMptPartNo)
-	if part == "" {
-		s3.WriteErr(w, r, fmt.Errorf("upload %q: missing part number", uploadID), 0)
-		return
-	}
-	partNum, err := t.ups.parsePartNum(part)
+	uploadID, partNum, err := t.ups.parsePartS3(q)
 	if err != nil {
 		s3.WriteErr(w, r, err, 0)
 		return
@@ -115,6 +116,208 @@ func (t *target) putPartMptS3(w http.ResponseWriter, r *http.Request, items []st
 	if etag != "" {
 		w.Header().Set(cos.S3CksumHeader, etag)
 	}
+	for _, h := range [...]string{s3.HdrCksumCRC64NVME, s3.HdrCksumCRC32C, s3.HdrCksumSHA256} {
+		if v := r.Header.Get(h); v != "" {
+			w.Header().Set(h, v) // (validated)
+			break
+		}
+	}
+}
+
+// Upload a part by copying (a range of) an existing object.
+// The source may reside in any bucket of any provider. When stored locally, it is read
+// directly (chunked objects included); otherwise, it is read from its (HRW) target that,
+// in turn, may cold-GET it from the remote backend. Either way, the data is written as
+// the specified part (chunk) of the destination upload and never goes through the client.
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_UploadPartCopy.html
+func (t *target) putPartCopyMptS3(w http.ResponseWriter, r *http.Request, items []string, q url.Values, bck *meta.Bck) {
+	// 1. parse/validate
+	uploadID, partNum, err := t.ups.parsePartS3(q)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	lomSrc, ecode, err := t.initCopySrcS3(r)
+	if err != nil {
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
+	defer core.FreeLOM(lomSrc)
+
+	lom := &core.LOM{ObjName: s3.ObjName(items)}
+	if err := lom.InitBck(bck); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+
+	// resolve the upload prior to (r)locking the source - the two may refer to the same object
+	if manifest, _ := t.ups.get(uploadID, lom); manifest == nil {
+		s3.WriteMptErr(w, r, s3.NewErrNoSuchUpload(uploadID, nil), http.StatusNotFound, lom, uploadID)
+		return
+	}
+
+	// 2. open source (range)
+	reader, size, ecode, err := t.openCopySrcS3(lomSrc, r.Header.Get(cos.S3HdrObjSrcRange))
+	if err != nil {
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
+
+	// 3. write part
+	args := partArgs{
+		size:     size,
+		reader:   reader,
+		lom:      lom,
+		uploadID: uploadID,
+		partNum:  int(partNum),
+		isS3:     true,
+	}
+	etag, ecode, err := t.ups.putPart(&args)
+	reader.Close()
+	if cos.IsNotExist(err) {
+		s3.WriteMptErr(w, r, s3.NewErrNoSuchUpload(uploadID, nil), ecode, lom, uploadID)
+		return
+	}
+	if err != nil {
+		s3.WriteMptErr(w, r, err, ecode, lom, uploadID)
+		return
+	}
+
+	result := s3.CopyPartResult{
+		LastModified: cos.FormatTime(time.Now(), cos.ISO8601),
+		ETag:         etag,
+	}
+	sgl := t.gmm.NewSGL(0)
+	result.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// returns reader (and its size, -1 if unknown) of the UploadPartCopy source:
+// - local: rlocked until the reader is closed
+// - otherwise: (range) GET from the source's target
+func (t *target) openCopySrcS3(lom *core.LOM, rng string) (io.ReadCloser, int64, int, error) {
+	smap := t.owner.smap.get()
+	tsi, err := smap.HrwHash2T(lom.Digest())
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	if tsi.ID() == t.SID() {
+		lom.Lock(false)
+		err := lom.Load(true /*cache it*/, true /*locked*/)
+		if err == nil {
+			var start, length int64
+			start, length, err = s3.ParseCopySrcRange(rng, lom.Lsize())
+			if err != nil {
+				lom.Unlock(false)
+				return nil, 0, 0, err
+			}
+			var lh cos.LomReader
+			if lh, err = lom.Open(); err != nil {
+				lom.Unlock(false)
+				return nil, 0, 0, err
+			}
+			return &copySrcReader{io.NewSectionReader(lh, start, length), lh, lom}, length, 0, nil
+		}
+		lom.Unlock(false)
+		if !cos.IsNotExist(err) {
+			return nil, 0, 0, err
+		}
+		if !lom.Bck().IsRemote() {
+			return nil, 0, http.StatusNotFound, cos.NewErrNotFound(t, lom.Cname())
+		}
+		// fall through to (self-)GET that'll cold-GET the object
+	}
+	return t.getCopySrcS3(lom, tsi, rng)
+}
+
+func (t *target) getCopySrcS3(lom *core.LOM, tsi *meta.Snode, rng string) (io.ReadCloser, int64, int, error) {
+	var (
+		length int64 = -1
+		hdr          = http.Header{
+			apc.HdrSenderID:   []string{t.SID()},
+			apc.HdrSenderName: []string{t.String()},
+		}
+	)
+	if rng != "" {
+		start, l, err := s3.ParseCopySrcRange(rng, math.MaxInt64) // (syntax only; bounds are checked by the source)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		length = l
+		hdr.Set(cos.HdrRange, cmn.MakeRangeHdr(start, length))
+	}
+	reqArgs := cmn.HreqArgs{
+		Method: http.MethodGet,
+		Base:   tsi.URL(cmn.NetIntraData),
+		Path:   apc.URLPathObjects.Join(lom.Bck().Name, lom.ObjName),
+		Query:  lom.Bck().NewQuery(),
+		Header: hdr,
+	}
+	req, err := reqArgs.Req()
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	resp, err := g.client.data.Do(req) //nolint:bodyclose // closed by the caller
+	cmn.HreqFree(req)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("%s: failed to GET %s from %s: %w", t, lom.Cname(), tsi.StringEx(), err)
+	}
+
+	ecode := resp.StatusCode
+	switch {
+	case ecode == http.StatusNotFound:
+		err = cos.NewErrNotFound(t, lom.Cname())
+	case ecode == http.StatusRequestedRangeNotSatisfiable:
+		err = s3.NewErrInvalidRange(fmt.Errorf("%s %q: %s", cos.S3HdrObjSrcRange, rng, resp.Status))
+	case ecode >= http.StatusBadRequest:
+		err = fmt.Errorf("%s: failed to GET %s from %s: %s", t, lom.Cname(), tsi.StringEx(), resp.Status)
+	case length >= 0 && resp.ContentLength >= 0 && resp.ContentLength != length:
+		// S3 requires the entire range to exist
+		ecode, err = 0, s3.NewErrInvalidRange(fmt.Errorf("%s %q is out of bounds", cos.S3HdrObjSrcRange, rng))
+	default:
+		// (empty source is a valid empty part; unknown length (-1) gets streamed - see ups._put)
+		if length >= 0 && resp.ContentLength < 0 {
+			return &copySrcRange{resp.Body, length}, length, 0, nil
+		}
+		return resp.Body, resp.ContentLength, 0, nil
+	}
+	cos.DrainReader(resp.Body)
+	resp.Body.Close()
+	return nil, 0, ecode, err
+}
+
+// remote UploadPartCopy source range of unknown length (no Content-Length):
+// must nonetheless deliver the entire range
+type copySrcRange struct {
+	io.ReadCloser
+	n int64 // remaining
+}
+
+func (r *copySrcRange) Read(b []byte) (int, error) {
+	if r.n <= 0 {
+		return 0, io.EOF
+	}
+	n, err := r.ReadCloser.Read(b[:min(int64(len(b)), r.n)])
+	r.n -= int64(n)
+	if err == io.EOF && r.n > 0 {
+		err = s3.NewErrInvalidRange(fmt.Errorf("%s is out of bounds (short by %d bytes)", cos.S3HdrObjSrcRange, r.n))
+	}
+	return n, err
+}
+
+// local UploadPartCopy source: (range) reader that unlocks the LOM upon closing
+type copySrcReader struct {
+	*io.SectionReader
+	lh  cos.LomReader
+	lom *core.LOM
+}
+
+func (r *copySrcReader) Close() error {
+	err := r.lh.Close()
+	r.lom.Unlock(false)
+	return err
 }
 
 // Complete multipart upload.
@@ -176,6 +379,7 @@ func (t *target) completeMptS3(w http.ResponseWriter, r *http.Request, items []s
 		body:     body,
 		parts:    partList,
 		isS3:     true,
+		event:    cmn.NotifMptComplete,
 	})
 	// convert generic error to s3 error
 	if cos.IsNotExist(err) {
@@ -189,6 +393,7 @@ func (t *target) completeMptS3(w http.ResponseWriter, r *http.Request, items []s
 
 	// respond
 	result := &s3.CompleteMptUploadResult{Bucket: bck.Name, Key: objName, ETag: etag}
+	result.SetCksum(lom)
 	sgl := t.gmm.NewSGL(0)
 	result.MustMarshal(sgl)
 	w.Header().Set(cos.HdrContentType, cos.ContentXML)
@@ -327,7 +532,7 @@ func (t *target) getPartMptS3(w http.ResponseWriter, r *http.Request, bck *meta.
 	}
 
 	// read chunk file
-	fh, err := os.Open(chunk.Path())
+	fh, err := chunk.Open()
 	if err != nil {
 		s3.WriteErr(w, r, err, 0)
 		return
@@ -0,0 +1,28 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestCopySrcRange(t *testing.T) {
+	const src = "0123456789"
+
+	// range within the source
+	r := &copySrcRange{io.NopCloser(strings.NewReader(src)), 4}
+	b, err := io.ReadAll(r)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, string(b) == src[:4], "expected %q, got %q", src[:4], b)
+
+	// range that extends past the end of the source
+	r = &copySrcRange{io.NopCloser(strings.NewReader(src)), int64(len(src)) + 1}
+	_, err = io.ReadAll(r)
+	tassert.Errorf(t, err != nil && strings.Contains(err.Error(), "out of bounds"), "expected out-of-bounds error, got %v", err)
+}
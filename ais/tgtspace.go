@@ -1,11 +1,14 @@
 // Package ais provides AIStore's proxy and target nodes.
 /*
- * Copyright (c) 2018-2025, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2018-2026, NVIDIA CORPORATION. All rights reserved.
  */
 package ais
 
 import (
+	"bytes"
 	"fmt"
+	"io"
+	"net/http"
 	"sync"
 	"time"
 
@@ -166,9 +169,99 @@ func (t *target) runSpaceCleanup(xargs *xact.ArgsMsg, wg *sync.WaitGroup) fs.Cap
 		WG:      wg,
 		Args:    xargs,
 	}
+	if xargs.Report != nil {
+		ini.PutReport = func(bck *cmn.Bck, objName string, b []byte) error {
+			return t.putReport(bck, objName, b, xcln)
+		}
+	}
 	xcln.AddNotif(&xact.NotifXact{
 		Base: nl.Base{When: core.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
 		Xact: xcln,
 	})
 	return space.RunCleanup(&ini)
 }
+
+// PUT (generated) report => its HRW target (see xact.ReportArgs)
+func (t *target) putReport(bck *cmn.Bck, objName string, b []byte, xctn core.Xact) error {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitCmnBck(bck); err != nil {
+		return err
+	}
+	tsi, local, err := lom.HrwTarget(&t.owner.smap.get().Smap)
+	if err != nil {
+		return err
+	}
+	if local {
+		params := core.AllocPutParams()
+		{
+			params.WorkTag = fs.WorkfilePut
+			params.Reader = io.NopCloser(bytes.NewReader(b))
+			params.Size = int64(len(b))
+			params.Atime = time.Now()
+			params.OWT = cmn.OwtPut
+			params.Xact = xctn
+		}
+		err = t.PutObject(lom, params)
+		core.FreePutParams(params)
+		return err
+	}
+
+	// t2t
+	var (
+		hdr   = make(http.Header, 2)
+		query = lom.Bck().NewQuery()
+	)
+	hdr.Set(apc.HdrT2TPutterID, t.SID())
+	query.Set(apc.QparamOWT, cmn.OwtPut.ToS())
+	reqArgs := cmn.HreqArgs{
+		Method: http.MethodPut,
+		Base:   tsi.URL(cmn.NetIntraData),
+		Path:   apc.URLPathObjects.Join(bck.Name, objName),
+		Query:  query,
+		Header: hdr,
+		BodyR:  io.NopCloser(bytes.NewReader(b)),
+	}
+	req, _, cancel, err := reqArgs.ReqWith(cmn.GCO.Get().Timeout.SendFile.D())
+	if err != nil {
+		return err
+	}
+	req.ContentLength = int64(len(b))
+	resp, err := g.client.data.Do(req)
+	if err == nil {
+		if resp.StatusCode >= http.StatusBadRequest {
+			err = fmt.Errorf("%s: failed to PUT %s => %s: %s", t, lom.Cname(), tsi.StringEx(), resp.Status)
+		}
+		cos.DrainReader(resp.Body)
+		resp.Body.Close()
+	}
+	cmn.HreqFree(req)
+	cancel()
+	return err
+}
+
+// restore or purge quarantined content (see fs/quarantine.go)
+func (t *target) quarantineAct(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
+	var (
+		qmsg apc.QuarantineMsg
+		n    int
+		err  error
+	)
+	if err := cos.MorphMarshal(msg.Value, &qmsg); err != nil {
+		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+		return
+	}
+	if msg.Action == apc.ActRestoreQuarantined {
+		n, err = fs.RestoreQuarantined(&qmsg)
+	} else {
+		n, err = fs.PurgeQuarantined(&qmsg)
+	}
+	if n > 0 {
+		nlog.Infoln(t.String(), msg.Action, "[", n, "]")
+	}
+	if err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	t.writeJSON(w, r, n, msg.Action)
+}
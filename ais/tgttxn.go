@@ -981,6 +981,16 @@ func (t *target) destroyBucket(c *txnSrv) error {
 		if !nlp.TryLock(c.timeout.netw / 2) {
 			return cmn.NewErrBusy("bucket", c.bck.Cname(""))
 		}
+		// object lock: refuse to destroy (or evict) locked objects
+		if err := bckObjLock(c.bck); err != nil {
+			nlp.Unlock()
+			return err
+		}
+		// write-back: refuse to evict objects that are yet to be written back
+		if err := bckDirtyWB(c.bck); err != nil {
+			nlp.Unlock()
+			return err
+		}
 		txn := newTxnBckBase(c.bck)
 		txn.fillFromCtx(c)
 		if err := t.txns.begin(txn, nlp); err != nil {
@@ -1208,6 +1218,13 @@ func (t *target) createNBI(c *txnSrv) (string, error) {
 		} else {
 			cimsg.Name = c.uuid
 		}
+		if cimsg.Series != "" {
+			if err := cos.CheckAlphaPlus(cimsg.Series, "inventory series"); err != nil {
+				return "", err
+			}
+		} else {
+			cimsg.Series = cimsg.Name
+		}
 		cimsg.LsoMsg.NormalizeNameSizeDflt()
 
 		// rlock the bucket for 2pc duration
@@ -1216,18 +1233,25 @@ func (t *target) createNBI(c *txnSrv) (string, error) {
 			return "", cmn.NewErrBusy("bucket", c.bck.Cname(""))
 		}
 
-		// NOTE 4.3 limitation: single inventory per bucket
+		// single inventory per bucket unless retaining multiple (see CreateNBIMsg.Keep and Schedule)
 		nbis, errN := fs.CollectNBI(c.bck.Bucket())
 		if errN != nil {
 			nlp.Unlock()
 			nlog.Errorln("failed to collect bucket inventories:", errN)
 			return "", errN
 		}
-		if len(nbis) > 0 {
+		if len(nbis) > 0 && cimsg.Retain() && !cimsg.Force {
+			for _, info := range nbis {
+				if info.Name == cimsg.Name {
+					nlp.Unlock()
+					return "", fmt.Errorf("inventory %q for bucket %s already exists", cimsg.Name, c.bck.Cname(""))
+				}
+			}
+		} else if len(nbis) > 0 {
 			invNames := nbis.Names()
 			if !cimsg.Force {
 				const (
-					hint = "(only one inventory per bucket is supported, use CLI '--force' or 'CreateNBIMsg.Force' to override)"
+					hint = "(use CLI '--keep' to retain multiple inventories, or '--force' to replace existing ones)"
 				)
 				nlp.Unlock()
 				return "", fmt.Errorf("inventory for bucket %s already exists: %v\n%s", c.bck.Cname(""), invNames, hint)
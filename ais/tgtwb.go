@@ -0,0 +1,115 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/xact/xreg"
+	"github.com/NVIDIA/aistore/xact/xs"
+)
+
+// write-back re-scan (see xs.XactWB):
+// - dirty objects are the source of truth while in-memory write-back queues are not;
+// - upon startup, flush all write-back buckets (queues do not survive restarts);
+// - thereafter, periodically flush buckets marked by xs.WBMark (write-back stopped
+//   with pending objects, retries exhausted, dirty objects received via rebalance, etc.)
+
+const (
+	wbIval        = 5 * time.Minute
+	wbStartupIval = time.Minute
+	wbHkName      = "write-back" + hk.NameSuffix
+)
+
+type wbhk struct {
+	t       *target
+	started bool
+}
+
+func (t *target) wbInit() {
+	h := &wbhk{t: t}
+	hk.Reg(wbHkName, h.housekeep, wbStartupIval)
+}
+
+func (h *wbhk) housekeep(int64) time.Duration {
+	t := h.t
+	if !t.ClusterStarted() || t.regstate.disabled.Load() {
+		return wbStartupIval
+	}
+	bmd := t.owner.bmd.get()
+	if !h.started {
+		h.started = true
+		bmd.Range(nil, nil, func(bck *meta.Bck) bool {
+			if bck.IsRemote() && bck.Props.WritePolicy.IsWriteBack() {
+				xs.WBMark(bck)
+			}
+			return false
+		})
+	}
+	for _, bck := range xs.WBMarked() {
+		// (regardless of the current write policy - dirty objects must be written back)
+		if err := bck.InitFast(t.Bowner()); err != nil {
+			continue // e.g., destroyed or evicted
+		}
+		rns := xreg.RenewFlushWB(cos.GenUUID(), bck)
+		if rns.Err != nil {
+			nlog.Warningln(t.String(), "failed to flush write-back", bck.Cname(""), "err:", rns.Err)
+			xs.WBMark(bck) // next time
+		}
+	}
+	return wbIval
+}
+
+// evict remote bucket: fail if any locally stored object is yet to be written back
+// (the only copy); mark the bucket for flushing so that a retry can succeed
+func bckDirtyWB(bck *meta.Bck) error {
+	if !bck.IsRemote() {
+		return nil
+	}
+	var (
+		errDirty = errors.New("dirty")
+		cb       = func(fqn string, de fs.DirEntry) error {
+			if de.IsDir() {
+				return nil
+			}
+			lom := core.AllocLOM("")
+			defer core.FreeLOM(lom)
+			if err := lom.InitFQN(fqn, bck.Bucket()); err != nil {
+				return nil
+			}
+			if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
+				return nil
+			}
+			if lom.IsDirtyWB() {
+				return errDirty
+			}
+			return nil
+		}
+	)
+	for _, mi := range fs.GetAvail() {
+		opts := &fs.WalkOpts{
+			Mi:       mi,
+			Bck:      *bck.Bucket(),
+			CTs:      []string{fs.ObjCT},
+			Callback: cb,
+		}
+		if err := fs.Walk(opts); err != nil {
+			if !errors.Is(err, errDirty) {
+				return err
+			}
+			xs.WBMark(bck)
+			return cmn.NewErrBusy("bucket", bck.Cname(""), "has objects not yet written back")
+		}
+	}
+	return nil
+}
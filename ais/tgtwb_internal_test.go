@@ -0,0 +1,60 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// evicting remote bucket must not destroy objects that are yet to be written back
+func TestBckDirtyWB(tst *testing.T) {
+	bck := meta.NewBck("wb", apc.AWS, cmn.NsGlobal)
+	bmd := t.owner.bmd.get().clone()
+	bmd.add(bck, &cmn.Bprops{
+		Cksum:       cmn.CksumConf{Type: cos.ChecksumNone},
+		WritePolicy: cmn.WritePolicyConf{Data: apc.WriteDelayed, MD: apc.WriteImmediate},
+	})
+	t.owner.bmd.putPersist(bmd, nil)
+	if errs := fs.CreateBucket(bck.Bucket(), false /*nilbmd*/); len(errs) > 0 {
+		tst.Fatal(errs[0])
+	}
+	defer fs.DestroyBucket("test", bck.Bucket(), 0)
+
+	tassert.CheckFatal(tst, bck.Init(t.owner.bmd))
+	tassert.CheckFatal(tst, bckDirtyWB(bck))
+
+	lom := core.AllocLOM("dirty")
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(tst, lom.InitBck(bck))
+	tassert.CheckFatal(tst, os.WriteFile(lom.FQN, []byte("write-back"), cos.PermRWR))
+	lom.SetSize(int64(len("write-back")))
+	lom.SetAtimeUnix(time.Now().UnixNano())
+	lom.SetDirtyWB(true)
+	tassert.CheckFatal(tst, lom.Persist())
+
+	err := bckDirtyWB(bck)
+	tassert.Fatalf(tst, cmn.IsErrBusy(err), "expected busy error, got %v", err)
+	rec := httptest.NewRecorder()
+	cmn.WriteErr(rec, httptest.NewRequest(http.MethodDelete, "/", http.NoBody), err)
+	tassert.Errorf(tst, rec.Code == http.StatusConflict, "expected status %d, got %d", http.StatusConflict, rec.Code)
+
+	// written back
+	lom.SetDirtyWB(false)
+	tassert.CheckFatal(tst, lom.Persist())
+	lom.UncacheUnless()
+	tassert.CheckFatal(tst, bckDirtyWB(bck))
+}
@@ -290,9 +290,14 @@ func (t *target) xstart(args *xact.ArgsMsg, bck *meta.Bck, msg *apc.ActMsg) (xid
 			ObjSizeLimit: int64(bck.Props.Chunks.ObjSizeLimit),
 			ChunkSize:    int64(bck.Props.Chunks.ChunkSize),
 		})
+	case apc.ActLifecycle:
+		return t.runLifecycle(args.ID, bck)
 	case apc.ActLoadLomCache:
 		rns := xreg.RenewBckLoadLomCache(args.ID, bck)
 		return xid, rns.Err
+	case apc.ActFlushWB:
+		rns := xreg.RenewFlushWB(args.ID, bck)
+		return xid, rns.Err
 	case apc.ActBlobDl:
 		debug.Assert(msg.Name != "")
 		lom := core.AllocLOM(msg.Name)
@@ -311,6 +316,8 @@ func (t *target) xstart(args *xact.ArgsMsg, bck *meta.Bck, msg *apc.ActMsg) (xid
 	// 3. cannot start
 	case apc.ActPutCopies:
 		return xid, fmt.Errorf("cannot start %q (is driven by PUTs into a mirrored bucket)", args)
+	case apc.ActWriteBack:
+		return xid, fmt.Errorf("cannot start %q (is driven by PUTs into a write-back bucket; use %q to flush)", args, apc.ActFlushWB)
 	case apc.ActDownload, apc.ActEvictObjects, apc.ActDeleteObjects, apc.ActMakeNCopies, apc.ActECEncode:
 		return xid, fmt.Errorf("initiating %q must be done via a separate documented API", args)
 	// 4. unknown
@@ -65,6 +65,7 @@ func (t *target) _promLocal(params *core.PromoteParams, lom *core.LOM) (fileSize
 	if err := lom.Load(true /*cache it*/, false /*locked*/); err == nil && !params.OverwriteDst {
 		return -1, 0, nil
 	}
+	lom.SetPlain() // promoting as is (compare w/ PUT)
 	if params.DeleteSrc {
 		// To use `params.SrcFQN` as `workFQN`, make sure both are
 		// located on the same filesystem. About "filesystem sharing" see also:
@@ -41,6 +41,10 @@ const (
 	ActPutCopies   = "put-copies"
 	ActRechunk     = "rechunk"
 
+	// remote buckets: write-back (write_policy.data = "delayed") and flush
+	ActWriteBack = "write-back"
+	ActFlushWB   = "flush-write-back"
+
 	ActRebalance = "rebalance"
 	ActMoveBck   = "move-bck"
 
@@ -50,6 +54,7 @@ const (
 
 	ActLRU          = "lru"
 	ActStoreCleanup = "cleanup-store"
+	ActLifecycle    = "lifecycle" // evaluate bucket lifecycle rules (see cmn.LifecycleConf)
 
 	ActEvictRemoteBck = "evict-remote-bck" // evict remote bucket's data
 	ActList           = "list"
@@ -74,6 +79,10 @@ const (
 
 	ActClearLcache = "clear-lcache"
 
+	// space-cleanup quarantine (see QuarantineMsg)
+	ActRestoreQuarantined = "restore-quarantined"
+	ActPurgeQuarantined   = "purge-quarantined"
+
 	ActShutdownCluster = "shutdown" // see also: ActShutdownNode
 
 	// multi-object (via `ListRange`)
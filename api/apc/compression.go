@@ -22,3 +22,11 @@ var SupportedCompression = [...]string{CompressNever, CompressAlways}
 func IsValidCompression(c string) bool {
 	return c == "" || c == SupportedCompression[0] || c == SupportedCompression[1]
 }
+
+// at-rest (bucket) compression algorithms - see cmn.CompressionConf
+const (
+	CompressLZ4  = LZ4Compression
+	CompressZstd = "zstd"
+)
+
+var SupportedCompressAlgos = [...]string{CompressLZ4, CompressZstd}
@@ -0,0 +1,15 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+// at-rest (bucket) encryption: key providers - see cmn.EncryptionConf
+const (
+	EncryptKeyfile = "keyfile" // local keyfile (see env.AisEncryptionKeyfile)
+
+	// S3 SSE-C: customer-provided key (request-scoped, not configurable)
+	EncryptSSEC = "sse-c"
+)
+
+var SupportedEncryptProviders = [...]string{EncryptKeyfile}
@@ -0,0 +1,34 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import "fmt"
+
+// eviction policy (enum and accessors)
+// bucket-configurable with global default via cluster config (see cmn.LRUConf)
+type EvictPolicy string
+
+const (
+	EvictLRU = EvictPolicy("lru") // least recently used: oldest access time first (default)
+	EvictLFU = EvictPolicy("lfu") // least frequently used: lowest access count first, then oldest
+	EvictGDS = EvictPolicy("gds") // size-weighted GreedyDual: large and cold first, small and hot last
+	EvictTTL = EvictPolicy("ttl") // time-to-live since cold GET (or PUT): expired only, oldest first
+
+	EvictDefault = EvictPolicy("") // same as `EvictLRU`
+)
+
+var SupportedEvictPolicy = [...]string{string(EvictLRU), string(EvictLFU), string(EvictGDS), string(EvictTTL)}
+
+func (ep EvictPolicy) IsLRU() bool { return ep == EvictDefault || ep == EvictLRU }
+
+// whether eviction requires per-object access counting (see core.LOM.IncHits)
+func (ep EvictPolicy) CountsHits() bool { return ep == EvictLFU || ep == EvictGDS }
+
+func (ep EvictPolicy) Validate() (err error) {
+	if ep.IsLRU() || ep == EvictLFU || ep == EvictGDS || ep == EvictTTL {
+		return
+	}
+	return fmt.Errorf("invalid eviction policy %q (expecting one of %v)", ep, SupportedEvictPolicy)
+}
@@ -0,0 +1,270 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// LsoFilter: optional server-side predicate for list-objects (`LsoMsg.Filter`).
+// Targets evaluate the filter before adding objects to the page, so that only
+// matching entries are returned. All specified conditions must hold (logical AND).
+//
+// Notes:
+// - time bounds are absolute (Unix nanoseconds); see ParseLsoFilter for relative ("ago") syntax;
+// - when listing in-cluster objects, all conditions are evaluated against the object's metadata;
+// - when listing remote buckets (or via bucket inventory) the filter is evaluated against listed
+//   entries, and conditions on properties that were not listed (e.g., custom metadata) do not match;
+//   in this case atime and mtime are both checked against the listed (last-modified) time.
+
+const (
+	LsoFilterSepa = ";" // ParseLsoFilter: terms separator
+
+	lsoFilterCustom = "custom."
+)
+
+type LsoFilter struct {
+	NameGlob    string     `json:"name_glob,omitempty"`           // shell pattern matching entire object name (see path.Match)
+	NameRegex   string     `json:"name_regex,omitempty"`          // regular expression (see regexp.MatchString)
+	Custom      cos.StrKVs `json:"custom,omitempty"`              // custom metadata key=value pairs; empty value: key must exist
+	HasCksum    *bool      `json:"has_cksum,omitempty"`           // true: with checksum; false: without
+	MinSize     int64      `json:"min_size,string,omitempty"`     // inclusive
+	MaxSize     int64      `json:"max_size,string,omitempty"`     // inclusive (zero: unlimited)
+	MtimeAfter  int64      `json:"mtime_after,string,omitempty"`  // last modified after (Unix ns)
+	MtimeBefore int64      `json:"mtime_before,string,omitempty"` // last modified before (Unix ns)
+	AtimeAfter  int64      `json:"atime_after,string,omitempty"`  // last accessed after (Unix ns)
+	AtimeBefore int64      `json:"atime_before,string,omitempty"` // last accessed before (Unix ns)
+}
+
+func (f *LsoFilter) IsEmpty() bool {
+	return f.NameGlob == "" && f.NameRegex == "" && len(f.Custom) == 0 && f.HasCksum == nil &&
+		f.MinSize == 0 && f.MaxSize == 0 &&
+		f.MtimeAfter == 0 && f.MtimeBefore == 0 && f.AtimeAfter == 0 && f.AtimeBefore == 0
+}
+
+// true when evaluating the filter requires more than just the object name
+func (f *LsoFilter) NeedsMD() bool {
+	return len(f.Custom) > 0 || f.HasCksum != nil || f.MinSize != 0 || f.MaxSize != 0 ||
+		f.MtimeAfter != 0 || f.MtimeBefore != 0 || f.AtimeAfter != 0 || f.AtimeBefore != 0
+}
+
+func (f *LsoFilter) Validate() error {
+	const tag = "invalid list-objects filter"
+	if f.NameGlob != "" {
+		if _, err := path.Match(f.NameGlob, ""); err != nil {
+			return fmt.Errorf("%s: name glob %q: %v", tag, f.NameGlob, err)
+		}
+	}
+	if f.NameRegex != "" {
+		if _, err := regexp.Compile(f.NameRegex); err != nil {
+			return fmt.Errorf("%s: name regex %q: %v", tag, f.NameRegex, err)
+		}
+	}
+	for k := range f.Custom {
+		if k == "" {
+			return fmt.Errorf("%s: empty custom metadata key", tag)
+		}
+	}
+	if f.MinSize < 0 || f.MaxSize < 0 {
+		return fmt.Errorf("%s: negative size (%d, %d)", tag, f.MinSize, f.MaxSize)
+	}
+	if f.MaxSize != 0 && f.MinSize > f.MaxSize {
+		return fmt.Errorf("%s: min size %d is greater than max size %d", tag, f.MinSize, f.MaxSize)
+	}
+	if f.MtimeAfter != 0 && f.MtimeBefore != 0 && f.MtimeAfter >= f.MtimeBefore {
+		return fmt.Errorf("%s: empty mtime range", tag)
+	}
+	if f.AtimeAfter != 0 && f.AtimeBefore != 0 && f.AtimeAfter >= f.AtimeBefore {
+		return fmt.Errorf("%s: empty atime range", tag)
+	}
+	return nil
+}
+
+func (f *LsoFilter) String() string {
+	terms := make([]string, 0, 4)
+	if f.NameGlob != "" {
+		terms = append(terms, "name="+f.NameGlob)
+	}
+	if f.NameRegex != "" {
+		terms = append(terms, "name~"+f.NameRegex)
+	}
+	if f.MinSize != 0 {
+		terms = append(terms, "size>="+strconv.FormatInt(f.MinSize, 10))
+	}
+	if f.MaxSize != 0 {
+		terms = append(terms, "size<="+strconv.FormatInt(f.MaxSize, 10))
+	}
+	if f.MtimeAfter != 0 {
+		terms = append(terms, "mtime>"+time.Unix(0, f.MtimeAfter).Format(time.RFC3339Nano))
+	}
+	if f.MtimeBefore != 0 {
+		terms = append(terms, "mtime<"+time.Unix(0, f.MtimeBefore).Format(time.RFC3339Nano))
+	}
+	if f.AtimeAfter != 0 {
+		terms = append(terms, "atime>"+time.Unix(0, f.AtimeAfter).Format(time.RFC3339Nano))
+	}
+	if f.AtimeBefore != 0 {
+		terms = append(terms, "atime<"+time.Unix(0, f.AtimeBefore).Format(time.RFC3339Nano))
+	}
+	if f.HasCksum != nil {
+		if *f.HasCksum {
+			terms = append(terms, "cksum")
+		} else {
+			terms = append(terms, "!cksum")
+		}
+	}
+	for k, v := range f.Custom {
+		if v == "" {
+			terms = append(terms, lsoFilterCustom+k)
+		} else {
+			terms = append(terms, lsoFilterCustom+k+"="+v)
+		}
+	}
+	return strings.Join(terms, LsoFilterSepa)
+}
+
+// ParseLsoFilter parses filter expression - a list of LsoFilterSepa-separated terms, e.g.:
+// "size>=1GiB; mtime>24h; name~\.tar$; custom.owner=alice; cksum"
+//
+// Supported terms:
+// - name=GLOB                 - shell pattern (path.Match)
+// - name~REGEX                - regular expression
+// - size>=N, size<=N          - size bounds, with optional IEC units (also: `>` and `<`)
+// - mtime>T, mtime<T          - last modified after (before) T
+// - atime>T, atime<T          - last accessed after (before) T
+// - cksum, !cksum             - with (without) checksum
+// - custom.KEY=VALUE          - custom metadata; or simply custom.KEY (key must exist)
+//
+// T is either RFC3339 timestamp or a duration that stands for "ago", e.g.:
+// "mtime>24h" selects objects modified during the last 24 hours.
+func ParseLsoFilter(expr string) (*LsoFilter, error) {
+	var (
+		f   = &LsoFilter{}
+		now = time.Now()
+	)
+	for term := range strings.SplitSeq(expr, LsoFilterSepa) {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+		if err := f.parseTerm(term, now); err != nil {
+			return nil, fmt.Errorf("invalid filter term %q: %v", term, err)
+		}
+	}
+	if f.IsEmpty() {
+		return nil, fmt.Errorf("invalid filter %q: no terms", expr)
+	}
+	return f, f.Validate()
+}
+
+func (f *LsoFilter) parseTerm(term string, now time.Time) error {
+	switch {
+	case term == "cksum":
+		f.HasCksum = Ptr(true)
+		return nil
+	case term == "!cksum":
+		f.HasCksum = Ptr(false)
+		return nil
+	case strings.HasPrefix(term, lsoFilterCustom):
+		kv := term[len(lsoFilterCustom):]
+		k, v, _ := strings.Cut(kv, "=")
+		if k = strings.TrimSpace(k); k == "" {
+			return errors.New("empty custom metadata key")
+		}
+		if f.Custom == nil {
+			f.Custom = make(cos.StrKVs, 2)
+		}
+		f.Custom[k] = strings.TrimSpace(v)
+		return nil
+	case strings.HasPrefix(term, "name"):
+		val := strings.TrimSpace(term[len("name"):])
+		switch {
+		case strings.HasPrefix(val, "="):
+			f.NameGlob = strings.TrimSpace(val[1:])
+		case strings.HasPrefix(val, "~"):
+			f.NameRegex = strings.TrimSpace(val[1:])
+		default:
+			return errors.New("expecting name=GLOB or name~REGEX")
+		}
+		return nil
+	}
+
+	// size, mtime, atime
+	i := strings.IndexAny(term, "<>")
+	if i <= 0 {
+		return errors.New("unrecognized term")
+	}
+	var (
+		prop = strings.TrimSpace(term[:i])
+		op   = term[i : i+1]
+		val  = term[i+1:]
+		incl bool
+	)
+	if strings.HasPrefix(val, "=") {
+		incl = true
+		val = val[1:]
+	}
+	val = strings.TrimSpace(val)
+	switch prop {
+	case "size":
+		n, err := cos.ParseSize(val, cos.UnitsIEC)
+		if err != nil {
+			return err
+		}
+		if op == ">" {
+			if !incl {
+				n++
+			}
+			f.MinSize = n
+		} else {
+			if !incl {
+				n--
+			}
+			if n <= 0 {
+				return errors.New("max size must be positive")
+			}
+			f.MaxSize = n
+		}
+	case "mtime", "atime":
+		t, err := parseLsoFilterTime(val, now)
+		if err != nil {
+			return err
+		}
+		switch {
+		case prop == "mtime" && op == ">":
+			f.MtimeAfter = t
+		case prop == "mtime":
+			f.MtimeBefore = t
+		case op == ">":
+			f.AtimeAfter = t
+		default:
+			f.AtimeBefore = t
+		}
+	default:
+		return fmt.Errorf("unknown property %q (expecting size, mtime, or atime)", prop)
+	}
+	return nil
+}
+
+func parseLsoFilterTime(val string, now time.Time) (int64, error) {
+	if t, err := time.Parse(time.RFC3339Nano, val); err == nil {
+		return t.UnixNano(), nil
+	}
+	if d, err := time.ParseDuration(val); err == nil {
+		return now.Add(-d).UnixNano(), nil
+	}
+	if n, err := strconv.ParseInt(val, 10, 64); err == nil && n > 0 {
+		return n, nil // (Unix ns)
+	}
+	return 0, fmt.Errorf("expecting RFC3339 timestamp or duration, got %q", val)
+}
@@ -117,7 +117,7 @@ const (
 	LsoStatusMask = (1 << statusBits) - 1
 )
 
-// NOTE: approaching uint16 limit - bits 9,14,15 remaining
+// NOTE: approaching uint16 limit - bits 9,15 remaining
 const (
 	// location _status_
 	LocOK = iota
@@ -136,6 +136,7 @@ const (
 	EntryHeadFail   = 1 << (statusBits + 7)
 	// added v4.0
 	EntryIsChunked = 1 << (statusBits + 8) // see NOTE above
+	EntryNotSynced = 1 << (statusBits + 9) // write-back: not yet written to remote backend
 )
 
 // LsoMsg and HEAD(object) enum
@@ -196,6 +197,7 @@ type (
 		SID               string      `json:"target"`                // selected target to solely execute backend.list-objects
 		Flags             uint64      `json:"flags,string"`          // enum {LsCached, ...} - "LsoMsg flags" above
 		PageSize          int64       `json:"pagesize"`              // max entries returned by list objects call
+		Filter            *LsoFilter  `json:"filter,omitempty"`      // optional server-side predicate (see lsfilter.go)
 	}
 )
 
@@ -279,6 +281,10 @@ func (lsmsg *LsoMsg) Str(cname string, sb *cos.SB) {
 		sb.WriteString(", flags:")
 		lsmsg.appendFlags(sb)
 	}
+	if lsmsg.Filter != nil {
+		sb.WriteString(", filter:")
+		sb.WriteString(lsmsg.Filter.String())
+	}
 }
 
 func (lsmsg *LsoMsg) appendFlags(sb *cos.SB) {
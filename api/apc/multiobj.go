@@ -14,10 +14,12 @@ import (
 // (common for all multi-object operations)
 type (
 	// List of object names _or_ a template specifying { optional Prefix, zero or more Ranges }
+	// optionally, narrowed down to objects that have all the specified tags
 	// swagger:model
 	ListRange struct {
 		Template string   `json:"template"`
 		ObjNames []string `json:"objnames"`
+		Tags     []string `json:"tags,omitempty"` // tag selectors: "key=value" or "key" (any value); see cmn/tags.go
 	}
 	// swagger:model
 	EvdMsg struct {
@@ -34,8 +36,12 @@ type (
 
 func (lrm *ListRange) IsList() bool      { return len(lrm.ObjNames) > 0 }
 func (lrm *ListRange) HasTemplate() bool { return lrm.Template != "" }
+func (lrm *ListRange) HasTags() bool     { return len(lrm.Tags) > 0 }
 
 func (lrm *ListRange) Str(sb *cos.SB, isPrefix bool) {
+	if lrm.HasTags() {
+		defer lrm._tags(sb)
+	}
 	switch {
 	case isPrefix:
 		if cos.MatchAll(lrm.Template) {
@@ -58,6 +64,13 @@ func (lrm *ListRange) Str(sb *cos.SB, isPrefix bool) {
 	}
 }
 
+func (lrm *ListRange) _tags(sb *cos.SB) {
+	if sb.Len() > 0 {
+		sb.WriteString(", ")
+	}
+	sb.WriteString(fmt.Sprintf("tags:%v", lrm.Tags))
+}
+
 // prefetch
 // swagger:model
 type PrefetchMsg struct {
@@ -118,7 +131,7 @@ type RechunkMsg struct {
 // at the specified (bucket) destination.
 // See also: api.PutApndArchArgs
 // --------------------  terminology   ---------------------
-// here and elsewhere "archive" is any (.tar, .tgz/.tar.gz, .zip, .tar.lz4) formatted object.
+// here and elsewhere "archive" is any (.tar, .tgz/.tar.gz, .zip, .tar.lz4, .tar.zst) formatted object.
 // [NOTE] see cmn/api for cmn.ArchiveMsg (that also contains ToBck)
 // swagger:model
 type ArchiveMsg struct {
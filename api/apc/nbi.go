@@ -7,6 +7,8 @@ package apc
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/NVIDIA/aistore/cmn/cos"
 )
@@ -23,30 +25,74 @@ type (
 		// Advanced usage only - non-zero overrides system default.
 		NamesPerChunk int64 `json:"names_per_chunk,omitempty"`
 
-		// Remove all existing inventories, if any, and proceed to create the new one
-		// (only one inventory per bucket is supported).
+		// Periodically re-create (re-sync) the inventory - see NBISchedule.
+		Schedule NBISchedule `json:"schedule,omitzero"`
+
+		// Number of the most recent inventories (of the same series) to retain;
+		// older ones get removed upon successful creation of a new one.
+		// Non-zero (or non-empty schedule) allows for multiple inventories per bucket.
+		Keep int `json:"keep,omitempty"`
+
+		// Base name shared by periodically re-created inventories
+		// (optional; defaults to Name).
+		Series string `json:"series,omitempty"`
+
+		// Merge recent changes (PUTs, DELETEs, renames) into the latest inventory of the same series
+		// instead of listing the entire bucket; each target falls back to full listing when it cannot
+		// (e.g., no base inventory, incomplete change log, or cluster map changed).
+		Incremental bool `json:"incremental,omitempty"`
+
+		// Remove all existing inventories, if any, and proceed to create the new one.
 		Force bool `json:"force,omitempty"`
 	}
 
+	// re-create inventory every so often and/or after so many bucket changes (PUTs and DELETEs)
+	// - whichever comes first; zero value: no re-sync
+	NBISchedule struct {
+		Interval    cos.Duration `json:"interval,omitempty"`
+		AfterOps    int64        `json:"after_ops,omitempty"`
+		Incremental bool         `json:"incremental,omitempty"` // re-sync incrementally (see CreateNBIMsg.Incremental)
+	}
+
 	NBIMeta struct {
-		Prefix   string `json:"prefix,omitempty"`   // lsmsg.Prefix
-		Started  int64  `json:"started,omitempty"`  // time started creating (ns)
-		Finished int64  `json:"finished,omitempty"` // finished (ns)
-		Ntotal   int64  `json:"ntotal,omitempty"`   // total number of names in the inventory
-		SmapVer  int64  `json:"smap_ver,omitempty"` // cluster map when writing inventory
-		Chunks   int32  `json:"chunks,omitempty"`   // number of chunks (manifest.Count())
-		Nat      int32  `json:"nat,omitempty"`      // number of active (not in maintenance) targets
+		Prefix        string      `json:"prefix,omitempty"`          // lsmsg.Prefix
+		Props         string      `json:"props,omitempty"`           // lsmsg.Props
+		Series        string      `json:"series,omitempty"`          // CreateNBIMsg.Series
+		Base          string      `json:"base,omitempty"`            // incremental: name of the inventory it was merged from
+		Schedule      NBISchedule `json:"schedule,omitzero"`         // CreateNBIMsg.Schedule
+		Started       int64       `json:"started,omitempty"`         // time started creating (ns)
+		Finished      int64       `json:"finished,omitempty"`        // finished (ns)
+		Ntotal        int64       `json:"ntotal,omitempty"`          // total number of names in the inventory
+		SmapVer       int64       `json:"smap_ver,omitempty"`        // cluster map when writing inventory
+		Flags         uint64      `json:"flags,omitempty"`           // lsmsg.Flags
+		NamesPerChunk int64       `json:"names_per_chunk,omitempty"` // CreateNBIMsg.NamesPerChunk
+		Chunks        int32       `json:"chunks,omitempty"`          // number of chunks (manifest.Count())
+		Nat           int32       `json:"nat,omitempty"`             // number of active (not in maintenance) targets
+		Keep          int32       `json:"keep,omitempty"`            // CreateNBIMsg.Keep
+		Gen           int32       `json:"gen,omitempty"`             // incremental generation: zero when fully listed
 	}
 	NBIInfo struct {
 		Bucket  string `json:"bucket"`
 		Name    string `json:"name"`
 		ObjName string `json:"obj_name"`
 		Size    int64  `json:"size"` // inventory size on disk
+		// (best effort) number of bucket changes (PUTs and DELETEs) since the bucket's latest inventory
+		// was created; in-memory, not persisted
+		Changes int64 `json:"changes,omitempty"`
+		Latest  bool  `json:"latest,omitempty"` // the bucket's most recent inventory
 		NBIMeta
 	}
 	NBIInfoMap map[string]*NBIInfo // by NBIInfo.ObjName
 )
 
+// special inventory name to list the bucket's most recent inventory (see HdrInvName)
+const NBILatest = "latest"
+
+const (
+	MinNBIInterval = 10 * time.Minute
+	MaxNBIKeep     = 64
+)
+
 //
 // LsoMsg - NBI extension
 //
@@ -107,7 +153,18 @@ func (m *CreateNBIMsg) SetValidate() error {
 		return fmt.Errorf("%s: %s", epref, sb.String())
 	}
 
-	// 2) advanced tunables
+	// 2) re-sync and retention
+	if m.Name == NBILatest || m.Series == NBILatest {
+		return fmt.Errorf("%s: %q is reserved", epref, NBILatest)
+	}
+	if err := m.Schedule.Validate(); err != nil {
+		return fmt.Errorf("%s: %v", epref, err)
+	}
+	if m.Keep < 0 || m.Keep > MaxNBIKeep {
+		return fmt.Errorf("%s: keep=%d out of range [0, %d]", epref, m.Keep, MaxNBIKeep)
+	}
+
+	// 3) advanced tunables
 	switch {
 	case m.NamesPerChunk == 0:
 		m.NamesPerChunk = DfltInvNamesPerChunk
@@ -119,10 +176,10 @@ func (m *CreateNBIMsg) SetValidate() error {
 		return fmt.Errorf("%s: names_per_chunk=%d too large (max=%d)", epref, m.NamesPerChunk, MaxInvNamesPerChunk)
 	}
 
-	// 3) NOTE: otherwise, backend _may_ append extra (virt-dir) entries (in re: pre-allocation+reuse)
+	// 4) NOTE: otherwise, backend _may_ append extra (virt-dir) entries (in re: pre-allocation+reuse)
 	m.SetFlag(LsNoDirs)
 
-	// 4) absolute minimum
+	// 5) absolute minimum
 	if m.IsFlagSet(LsNameOnly) {
 		m.Props = GetPropsName
 		return nil
@@ -136,7 +193,7 @@ func (m *CreateNBIMsg) SetValidate() error {
 		return nil
 	}
 
-	// 5) default props
+	// 6) default props
 	if m.Props == "" {
 		m.AddProps(GetPropsName, GetPropsSize, GetPropsCached)
 	} else {
@@ -146,6 +203,66 @@ func (m *CreateNBIMsg) SetValidate() error {
 	return nil
 }
 
+// multiple inventories per bucket, with older ones (beyond Keep) removed
+func (m *CreateNBIMsg) Retain() bool { return m.Keep > 0 || m.Schedule.IsSet() || m.Incremental }
+
+// at least one (the latest) inventory is retained
+func (m *CreateNBIMsg) KeepN() int { return max(m.Keep, 1) }
+
+// naming convention for inventories of a given series, e.g. "daily-20260317-021507"
+func NBISeriesName(series string, t time.Time) string {
+	return series + "-" + t.UTC().Format("20060102-150405")
+}
+
+/////////////////
+// NBISchedule //
+/////////////////
+
+func (s *NBISchedule) IsSet() bool { return s.Interval > 0 || s.AfterOps > 0 }
+
+func (s *NBISchedule) Validate() error {
+	if s.Interval < 0 || s.AfterOps < 0 {
+		return fmt.Errorf("invalid schedule (%v, %d)", s.Interval, s.AfterOps)
+	}
+	if s.Interval > 0 && time.Duration(s.Interval) < MinNBIInterval {
+		return fmt.Errorf("schedule interval %v is too short (min %v)", s.Interval, MinNBIInterval)
+	}
+	if s.Incremental && !s.IsSet() {
+		return errors.New("incremental schedule requires interval and/or after-ops")
+	}
+	return nil
+}
+
+// given the latest inventory: whether it is time to re-create it
+func (s *NBISchedule) IsDue(info *NBIInfo, now int64) bool {
+	switch {
+	case info.Finished == 0:
+		return false
+	case s.Interval > 0 && now-info.Finished >= int64(s.Interval):
+		return true
+	default:
+		return s.AfterOps > 0 && info.Changes >= s.AfterOps
+	}
+}
+
+func (s *NBISchedule) String() string {
+	var out string
+	switch {
+	case s.Interval > 0 && s.AfterOps > 0:
+		out = fmt.Sprintf("every %v or %d changes", s.Interval, s.AfterOps)
+	case s.Interval > 0:
+		out = "every " + s.Interval.String()
+	case s.AfterOps > 0:
+		out = fmt.Sprintf("every %d changes", s.AfterOps)
+	default:
+		return ""
+	}
+	if s.Incremental {
+		out += " (incremental)"
+	}
+	return out
+}
+
 ////////////////
 // NBIInfoMap //
 ////////////////
@@ -164,3 +281,45 @@ func (m NBIInfoMap) SingleName() string {
 	}
 	return ""
 }
+
+// most recent first
+func (m NBIInfoMap) Sorted() []*NBIInfo {
+	lst := make([]*NBIInfo, 0, len(m))
+	for _, info := range m {
+		lst = append(lst, info)
+	}
+	sort.Slice(lst, func(i, j int) bool {
+		if lst[i].Finished == lst[j].Finished {
+			return lst[i].Name > lst[j].Name
+		}
+		return lst[i].Finished > lst[j].Finished
+	})
+	return lst
+}
+
+func (m NBIInfoMap) Latest() *NBIInfo {
+	var latest *NBIInfo
+	for _, info := range m {
+		if latest == nil || info.Finished > latest.Finished ||
+			(info.Finished == latest.Finished && info.Name > latest.Name) {
+			latest = info
+		}
+	}
+	return latest
+}
+
+// all inventories belong to the same (non-empty) series
+func (m NBIInfoMap) SameSeries() bool {
+	var series string
+	for _, info := range m {
+		switch {
+		case info.Series == "":
+			return false
+		case series == "":
+			series = info.Series
+		case series != info.Series:
+			return false
+		}
+	}
+	return true
+}
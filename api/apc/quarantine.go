@@ -0,0 +1,48 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import "time"
+
+// space cleanup: instead of removing invalid FQNs, objects with missing or corrupted metadata,
+// misplaced objects, orphaned EC slices, and orphaned chunks move them to per-mountpath
+// quarantine (config "space.quarantine")
+
+type (
+	// quarantined item (sidecar record)
+	QuarantineEntry struct {
+		ID     string `json:"id"`
+		FQN    string `json:"fqn"`    // original location
+		Reason string `json:"reason"` // e.g. "invalid-fqn", "misplaced-ec", "orphan-chunk"
+		XID    string `json:"xid"`    // space-cleanup job that quarantined it
+		Mpath  string `json:"mpath"`
+		Size   int64  `json:"size,string"`
+		Time   int64  `json:"time,string"` // when quarantined (Unix nanoseconds)
+	}
+	// per target ID
+	QuarantineList map[string][]*QuarantineEntry
+
+	// ActRestoreQuarantined and ActPurgeQuarantined
+	// - either IDs or OlderThan (or both) - otherwise, all
+	QuarantineMsg struct {
+		IDs       []string      `json:"ids,omitempty"`
+		OlderThan time.Duration `json:"older_than,omitempty"`
+	}
+)
+
+func (msg *QuarantineMsg) Match(e *QuarantineEntry, now int64) bool {
+	if msg.OlderThan > 0 && now-e.Time < msg.OlderThan.Nanoseconds() {
+		return false
+	}
+	if len(msg.IDs) == 0 {
+		return true
+	}
+	for _, id := range msg.IDs {
+		if id == e.ID {
+			return true
+		}
+	}
+	return false
+}
@@ -33,6 +33,10 @@ const (
 	// NOTE: making an s/_/-/ naming exception because of the namesake CLI usage
 	QparamNewCustom = "set-new-custom"
 
+	// object lock: delete an object under (active) governance-mode retention
+	// (requires AceObjUpdate in addition to AceObjDELETE)
+	QparamBypassGovernance = "bypass_governance"
+
 	// Main bucket query params.
 	QparamProvider  = "provider"  // Backend provider type (ais, s3, gcp, azure, etc.)
 	QparamNamespace = "namespace" // Namespace for remote buckets and cross-cluster operations
@@ -84,7 +88,7 @@ const (
 	QparamAllLogs = "all"
 
 	// The following 4 (four) QparamArch* parameters are all intended for usage with sharded datasets,
-	// whereby the shards are (.tar, .tgz (or .tar.gz), .zip, and/or .tar.lz4) formatted objects.
+	// whereby the shards are (.tar, .tgz (or .tar.gz), .zip, .tar.lz4, and/or .tar.zst) formatted objects.
 	//
 	// For the most recently updated list of supported serialization formats, please see cmn/archive package.
 	//
@@ -269,6 +273,7 @@ const (
 
 	// assorted
 	WhatMountpaths = "mountpaths"
+	WhatQuarantine = "quarantine" // space-cleanup quarantined content (see QuarantineEntry)
 	WhatRemoteAIS  = "remote"
 	WhatSmapVote   = "smapvote"
 	WhatSysInfo    = "sysinfo"
@@ -8,11 +8,13 @@ import "fmt"
 
 // write policy (enum and accessors)
 // applies to both AIS metadata and data; bucket-configurable with global defaults via cluster config
+// - metadata (write_policy.md): all three policies
+// - data (write_policy.data): immediate (write-through) and delayed (write-back) - remote buckets only
 type WritePolicy string
 
 const (
 	WriteImmediate = WritePolicy("immediate") // immediate write (default)
-	WriteDelayed   = WritePolicy("delayed")   // cache and flush when not accessed for a while (lom_cache_hk.go)
+	WriteDelayed   = WritePolicy("delayed")   // md: cache and flush when not accessed for a while (lom_cache_hk.go); data: write-back
 	WriteNever     = WritePolicy("never")     // transient - in-memory only
 
 	WriteDefault = WritePolicy("") // same as `WriteImmediate` - see IsImmediate() below
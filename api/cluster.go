@@ -346,6 +346,52 @@ func ClearLcache(bp BaseParams, tid string) error {
 	return _putCluster(bp, apc.ActMsg{Action: apc.ActClearLcache, Name: tid})
 }
 
+// GetQuarantined returns space-cleanup quarantined content, per target ID
+// (see also: config "space.quarantine")
+func GetQuarantined(bp BaseParams) (out apc.QuarantineList, err error) {
+	q := qalloc()
+	q.Set(apc.QparamWhat, apc.WhatQuarantine)
+
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = q
+	}
+	_, err = reqParams.DoReqAny(&out)
+
+	FreeRp(reqParams)
+	qfree(q)
+	return out, err
+}
+
+// RestoreQuarantined moves quarantined content back to its original location;
+// returns the number of restored items
+func RestoreQuarantined(bp BaseParams, msg *apc.QuarantineMsg) (int, error) {
+	return _quarantineAct(bp, apc.ActRestoreQuarantined, msg)
+}
+
+// PurgeQuarantined permanently removes quarantined content;
+// returns the number of purged items
+func PurgeQuarantined(bp BaseParams, msg *apc.QuarantineMsg) (int, error) {
+	return _quarantineAct(bp, apc.ActPurgeQuarantined, msg)
+}
+
+func _quarantineAct(bp BaseParams, action string, msg *apc.QuarantineMsg) (n int, err error) {
+	bp.Method = http.MethodPut
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: action, Value: msg})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	_, err = reqParams.DoReqAny(&n)
+	FreeRp(reqParams)
+	return n, err
+}
+
 func _putCluster(bp BaseParams, msg apc.ActMsg) error {
 	bp.Method = http.MethodPut
 	reqParams := AllocRp()
@@ -28,6 +28,10 @@ const (
 	// false or not set: IPv4 (default)
 	AisUseIPv6 = "AIS_USE_IPv6"
 
+	// at-rest encryption: local keyfile (JSON map key-ID => base64-encoded 256-bit key)
+	// see also: bucket property `encryption` (cmn.EncryptionConf)
+	AisEncryptionKeyfile = "AIS_ENCRYPTION_KEYFILE"
+
 	//
 	// HTTPS (see https://github.com/NVIDIA/aistore/blob/main/docs/environment-vars.md#https)
 	//
@@ -82,7 +82,7 @@ func GetBatch(bp BaseParams, bck cmn.Bck, req *apc.MossReq, w io.Writer) (resp a
 // GetBatchStream starts a streaming GetBatch and returns the response body _as is_
 // and response headers:
 // - the returned body is forward-only (non-seekable)
-// - supported streaming formats: .tar/.tgz/.tar.lz4; zip is excepted as non-streamable
+// - supported streaming formats: .tar/.tgz/.tar.lz4/.tar.zst; zip is excepted as non-streamable
 // - it is the caller's responsibility to close the body
 // - compare with GetBatch() above
 
@@ -633,7 +633,7 @@ func PrefetchObject(bp BaseParams, bck cmn.Bck, objName string) (string, error)
 }
 
 // Archive the content of a reader (`args.Reader` - e.g., an open file). =======================================
-// Destination, depending on the options, can be an existing (.tar, .tgz or .tar.gz, .zip, .tar.lz4)
+// Destination, depending on the options, can be an existing (.tar, .tgz or .tar.gz, .zip, .tar.lz4, .tar.zst)
 // formatted object (aka "shard") or a new one (or, a new version).
 // ---
 // For the updated list of supported archival formats -- aka MIME types -- see cmn/cos/archive.go.
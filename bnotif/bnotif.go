@@ -0,0 +1,301 @@
+// Package bnotif delivers bucket event notifications (S3 event-message format) to HTTP webhooks.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package bnotif
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// Each target delivers events for the objects it stores (and the primary proxy -
+// bucket-level events, such as soft-quota crossings):
+// - a bucket's notification rules (cmn.NotifConf) select events by type and object name;
+// - matching records are queued per webhook (URL) and delivered in batches (HTTP POST);
+// - before delivery, each batch is written into the target's on-disk queue (spool) -
+//   a batch is removed from the spool only after the webhook responds with 2xx;
+// - failed deliveries are retried with exponential backoff, in order;
+// - both in-memory and on-disk queues are bounded; when full, the oldest batches
+//   (or new records) are dropped (and logged).
+// - webhook hosts are checked against cluster-wide allow and deny lists (cmn.WebhookConf)
+//   when dialing: connections go to the checked addresses only;
+// - webhook queues that remain idle (see maxIdle) are removed, along with their goroutines.
+// Delivery guarantee: records are first queued in memory and spooled within flushIval
+// (or sooner, when a batch fills up). Records that have not been spooled are lost
+// if the target crashes (graceful shutdown spools them). Spooled batches are delivered
+// at-least-once: the webhook may receive duplicates (e.g., after a timeout or restart).
+// See also: cmn/notif.go
+
+const (
+	batchSize   = 100              // max records per POST
+	flushIval   = time.Second      // max time a record waits in memory
+	maxPending  = 64 * batchSize   // max in-memory records per webhook
+	maxSpooled  = 1024             // max on-disk batches per webhook
+	minRetry    = time.Second      // backoff
+	maxRetry    = time.Minute      // ditto
+	sendTimeout = 30 * time.Second // HTTP client timeout
+	maxIdle     = 10 * time.Minute // remove queue (and its goroutine) when idle for so long
+	urlFname    = "url"            // (in each webhook's spool directory)
+	batchExt    = ".json"          // batch file extension
+	s3ARNPrefix = "arn:aws:s3:::"  // S3 bucket ARN
+	evVersion   = "2.2"            // S3 event structure version
+	evSource    = "aws:s3"         // ditto
+	evSchemaVer = "1.0"            // ditto
+	evRegion    = "ais"            // (see also ais/s3/AISRegion)
+	evTimeFmt   = "2006-01-02T15:04:05.000Z"
+)
+
+type (
+	// S3 event message
+	// see https://docs.aws.amazon.com/AmazonS3/latest/userguide/notification-content-structure.html
+	Message struct {
+		Records []Record `json:"Records"`
+	}
+	Record struct {
+		EventVersion string   `json:"eventVersion"`
+		EventSource  string   `json:"eventSource"`
+		AwsRegion    string   `json:"awsRegion"`
+		EventTime    string   `json:"eventTime"`
+		EventName    string   `json:"eventName"`
+		S3           S3Entity `json:"s3"`
+	}
+	S3Entity struct {
+		SchemaVersion   string       `json:"s3SchemaVersion"`
+		ConfigurationID string       `json:"configurationId"`
+		Bucket          BucketEntity `json:"bucket"`
+		Object          ObjectEntity `json:"object"`
+	}
+	BucketEntity struct {
+		Name     string `json:"name"`
+		ARN      string `json:"arn"`
+		Provider string `json:"provider,omitempty"` // (AIS extension)
+	}
+	ObjectEntity struct {
+		Key       string `json:"key"` // URL-encoded (as per S3)
+		Size      int64  `json:"size,omitempty"`
+		ETag      string `json:"eTag,omitempty"`
+		VersionID string `json:"versionId,omitempty"`
+		Sequencer string `json:"sequencer"`
+	}
+)
+
+type notifier struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	client *http.Client
+	dialer *net.Dialer
+	stopCh chan struct{}
+	dir    string   // spool
+	queues sync.Map // webhook URL => *whq
+	wg     sync.WaitGroup
+	mu     sync.Mutex
+	seq    atomic.Int64
+}
+
+var nt *notifier
+
+// targets and proxies (the latter deliver bucket-level events); resumes delivery of previously spooled batches, if any
+func Init(config *cmn.Config) {
+	nt = &notifier{
+		client: cmn.NewClient(cmn.TransportArgs{Timeout: sendTimeout, UseHTTPProxyEnv: true}),
+		dialer: &net.Dialer{Timeout: cmn.DfltDialupTimeout, KeepAlive: cmn.DfltKeepaliveTCP},
+		stopCh: make(chan struct{}),
+		dir:    filepath.Join(config.ConfigDir, fname.NotifSpool),
+	}
+	nt.client.Transport.(*http.Transport).DialContext = nt.dial
+	nt.ctx, nt.cancel = context.WithCancel(context.Background())
+	nt.seq.Store(time.Now().UnixNano())
+
+	dents, err := os.ReadDir(nt.dir)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			nlog.Errorln("bucket notifications: failed to read spool:", err)
+		}
+		return
+	}
+	for _, dent := range dents {
+		if !dent.IsDir() {
+			continue
+		}
+		dir := filepath.Join(nt.dir, dent.Name())
+		b, err := os.ReadFile(filepath.Join(dir, urlFname))
+		if err != nil {
+			nlog.Errorln("bucket notifications: failed to read spool:", err)
+			continue
+		}
+		if q := nt.queue(string(b)); len(q.spool) > 0 {
+			nlog.Infoln("bucket notifications:", q.url, "resuming delivery of", len(q.spool), "spooled batch(es)")
+		}
+	}
+}
+
+// graceful shutdown: spool in-memory records
+func Stop() {
+	if nt == nil {
+		return
+	}
+	nt.cancel()
+	close(nt.stopCh)
+	nt.wg.Wait()
+}
+
+// Notify is called upon successful write (or delete) of a given object;
+// no-op unless the object's bucket has notification rules that match.
+func Notify(lom *core.LOM, event string) {
+	bprops := lom.Bprops()
+	if nt == nil || bprops == nil || !bprops.Notif.IsActive() {
+		return
+	}
+	rules := bprops.Notif.Match(event, lom.ObjName)
+	if len(rules) == 0 {
+		return
+	}
+	rec := newRecord(lom.Bck(), event, lom.ObjName)
+	if event != cmn.NotifDelete {
+		rec.S3.Object.Size = lom.Lsize(true)
+		rec.S3.Object.ETag = lom.ETag(time.Time{}, false /*allow syscall*/)
+		rec.S3.Object.VersionID = lom.Version(true)
+	}
+	dispatch(rules, rec)
+}
+
+// NotifyBck delivers bucket-level event (e.g., cmn.NotifQuotaSoft) with an empty
+// object key and the bucket's current size
+func NotifyBck(bck *meta.Bck, event string, size int64) {
+	if nt == nil || bck.Props == nil || !bck.Props.Notif.IsActive() {
+		return
+	}
+	rules := bck.Props.Notif.Match(event, "")
+	if len(rules) == 0 {
+		return
+	}
+	rec := newRecord(bck, event, "")
+	rec.S3.Object.Size = size
+	dispatch(rules, rec)
+}
+
+func newRecord(bck *meta.Bck, event, objName string) *Record {
+	return &Record{
+		EventVersion: evVersion,
+		EventSource:  evSource,
+		AwsRegion:    evRegion,
+		EventTime:    time.Now().UTC().Format(evTimeFmt),
+		EventName:    event[strings.IndexByte(event, ':')+1:], // (S3 omits "s3:" prefix)
+		S3: S3Entity{
+			SchemaVersion: evSchemaVer,
+			Bucket:        BucketEntity{Name: bck.Name, ARN: s3ARNPrefix + bck.Name, Provider: bck.Provider},
+			Object: ObjectEntity{
+				Key:       url.QueryEscape(objName),
+				Sequencer: strconv.FormatInt(nt.seq.Inc(), 16),
+			},
+		},
+	}
+}
+
+func dispatch(rules []*cmn.NotifRule, rec *Record) {
+	for i, rule := range rules {
+		// (same webhook, multiple rules: deliver once)
+		var dup bool
+		for _, prev := range rules[:i] {
+			if dup = prev.URL == rule.URL; dup {
+				break
+			}
+		}
+		if dup {
+			continue
+		}
+		rec.S3.ConfigurationID = rule.ID
+		nt.queue(rule.URL).add(rec)
+	}
+}
+
+// CheckURL checks webhook URL against cluster config (cmn.WebhookConf) - when setting
+// bucket notification rules; delivery (see dial) checks it again
+func CheckURL(ctx context.Context, conf *cmn.WebhookConf, whurl string) error {
+	if !conf.IsActive() {
+		return nil
+	}
+	u, err := url.Parse(whurl)
+	if err != nil {
+		return err
+	}
+	if _, err := checkHost(ctx, conf, u.Hostname()); err != nil {
+		if _, ok := err.(*net.DNSError); !ok {
+			return err
+		}
+		return conf.Check(u.Hostname(), nil) // (may not resolve here)
+	}
+	return nil
+}
+
+// resolve and check; returns host's addresses
+func checkHost(ctx context.Context, conf *cmn.WebhookConf, host string) ([]net.IP, error) {
+	if ip := net.ParseIP(host); ip != nil {
+		return []net.IP{ip}, conf.Check(host, nil)
+	}
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	ips := make([]net.IP, len(addrs))
+	for i := range addrs {
+		ips[i] = addrs[i].IP
+	}
+	return ips, conf.Check(host, ips)
+}
+
+// dial webhook (or HTTP proxy) at the addresses that have been checked
+// (rather than resolving again)
+func (n *notifier) dial(ctx context.Context, network, addr string) (net.Conn, error) {
+	conf := &cmn.GCO.Get().Webhook
+	if !conf.IsActive() {
+		return n.dialer.DialContext(ctx, network, addr)
+	}
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	ips, err := checkHost(ctx, conf, host)
+	if err != nil {
+		return nil, err
+	}
+	for _, ip := range ips {
+		var conn net.Conn
+		if conn, err = n.dialer.DialContext(ctx, network, net.JoinHostPort(ip.String(), port)); err == nil {
+			return conn, nil
+		}
+	}
+	return nil, err
+}
+
+func (n *notifier) queue(whurl string) *whq {
+	if v, ok := n.queues.Load(whurl); ok {
+		return v.(*whq)
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if v, ok := n.queues.Load(whurl); ok {
+		return v.(*whq)
+	}
+	q := newWHQ(n, whurl)
+	n.queues.Store(whurl, q)
+	n.wg.Add(1)
+	go q.run()
+	return q
+}
@@ -0,0 +1,213 @@
+// Package bnotif delivers bucket event notifications (S3 event-message format) to HTTP webhooks.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package bnotif
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+type webhook struct {
+	mu      sync.Mutex
+	keys    []string
+	posts   int
+	failing atomic.Bool
+}
+
+func (wh *webhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if wh.failing.Load() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	msg := &Message{}
+	if err := cos.JSON.NewDecoder(r.Body).Decode(msg); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	wh.mu.Lock()
+	wh.posts++
+	for i := range msg.Records {
+		wh.keys = append(wh.keys, msg.Records[i].S3.Object.Key)
+	}
+	wh.mu.Unlock()
+}
+
+func (wh *webhook) received() (posts, recs int) {
+	wh.mu.Lock()
+	defer wh.mu.Unlock()
+	return wh.posts, len(wh.keys)
+}
+
+func newRec(key string) *Record {
+	return &Record{EventName: "ObjectCreated:Put", S3: S3Entity{Object: ObjectEntity{Key: key}}}
+}
+
+func waitRecs(t *testing.T, wh *webhook, n int, timeout time.Duration) {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		if _, recs := wh.received(); recs >= n {
+			return
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	_, recs := wh.received()
+	t.Fatalf("timed out waiting for %d records (received %d)", n, recs)
+}
+
+func TestDeliverBatched(t *testing.T) {
+	wh := &webhook{}
+	srv := httptest.NewServer(wh)
+	defer srv.Close()
+
+	config := &cmn.Config{}
+	config.ConfigDir = t.TempDir()
+	Init(config)
+	defer Stop()
+
+	const n = 3*batchSize + 7
+	q := nt.queue(srv.URL)
+	for i := range n {
+		q.add(newRec("obj-" + strconv.Itoa(i)))
+	}
+	waitRecs(t, wh, n, 10*time.Second)
+	posts, recs := wh.received()
+	tassert.Errorf(t, recs == n, "expecting %d records, got %d", n, recs)
+	tassert.Errorf(t, posts >= n/batchSize && posts < n, "expecting batched delivery, got %d POSTs", posts)
+}
+
+func TestDeliverRetrySpool(t *testing.T) {
+	wh := &webhook{}
+	wh.failing.Store(true)
+	srv := httptest.NewServer(wh)
+	defer srv.Close()
+
+	config := &cmn.Config{}
+	config.ConfigDir = t.TempDir()
+	Init(config)
+
+	q := nt.queue(srv.URL)
+	for range 10 {
+		q.add(newRec("obj"))
+	}
+	time.Sleep(2 * flushIval)
+	Stop() // (webhook still failing)
+
+	dents, err := os.ReadDir(q.dir)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(dents) >= 2, "expecting url file and spooled batch(es), got %d entries", len(dents))
+	_, recs := wh.received()
+	tassert.Fatalf(t, recs == 0, "expecting no deliveries, got %d", recs)
+
+	// restart: resume delivery from the spool
+	wh.failing.Store(false)
+	Init(config)
+	defer Stop()
+	waitRecs(t, wh, 10, 10*time.Second)
+
+	time.Sleep(2 * flushIval)
+	dents, err = os.ReadDir(q.dir)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(dents) == 1, "expecting empty spool (url file only), got %d entries", len(dents))
+}
+
+func TestNotifyBck(t *testing.T) {
+	wh := &webhook{}
+	srv := httptest.NewServer(wh)
+	defer srv.Close()
+
+	config := &cmn.Config{}
+	config.ConfigDir = t.TempDir()
+	Init(config)
+	defer Stop()
+
+	props := &cmn.Bprops{Notif: cmn.NotifConf{Rules: []cmn.NotifRule{
+		{ID: "quota", Events: []string{cmn.NotifQuota + "*"}, URL: srv.URL},
+		{ID: "filtered", Events: []string{cmn.NotifQuotaSoft}, Prefix: "a/", URL: srv.URL + "/filtered"},
+	}}}
+	bck := meta.NewBck("abc", apc.AIS, cmn.NsGlobal, props)
+	NotifyBck(bck, cmn.NotifQuotaSoft, cos.GiB)
+	NotifyBck(bck, cmn.NotifPut, cos.GiB) // (no matching rule)
+
+	waitRecs(t, wh, 1, 10*time.Second)
+	time.Sleep(2 * flushIval)
+	wh.mu.Lock()
+	keys := wh.keys
+	wh.mu.Unlock()
+	tassert.Errorf(t, len(keys) == 1 && keys[0] == "", "expecting a single bucket-level record, got %q", keys)
+}
+
+func TestDeliverDenied(t *testing.T) {
+	wh := &webhook{}
+	srv := httptest.NewServer(wh)
+	defer srv.Close()
+
+	config := &cmn.Config{}
+	config.ConfigDir = t.TempDir()
+	config.Webhook.Deny = []string{"127.0.0.0/8", "::1"}
+	prev := cmn.GCO.Get()
+	cmn.GCO.Put(config)
+	defer cmn.GCO.Put(prev)
+	Init(config)
+	defer Stop()
+
+	err := CheckURL(context.Background(), &config.Webhook, srv.URL)
+	tassert.Errorf(t, err != nil, "expecting %s to be denied", srv.URL)
+
+	// (not connecting - the batch remains spooled)
+	q := nt.queue(srv.URL)
+	q.add(newRec("obj"))
+	time.Sleep(3 * flushIval)
+	_, recs := wh.received()
+	tassert.Errorf(t, recs == 0, "expecting no deliveries, got %d", recs)
+	dents, err := os.ReadDir(q.dir)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(dents) == 2, "expecting url file and spooled batch, got %d entries", len(dents))
+}
+
+func TestRetireIdle(t *testing.T) {
+	wh := &webhook{}
+	srv := httptest.NewServer(wh)
+	defer srv.Close()
+
+	config := &cmn.Config{}
+	config.ConfigDir = t.TempDir()
+	Init(config)
+	defer Stop()
+
+	q := nt.queue(srv.URL)
+	q.add(newRec("obj-1"))
+	waitRecs(t, wh, 1, 10*time.Second)
+
+	q.mu.Lock()
+	q.last -= int64(maxIdle)
+	q.mu.Unlock()
+	for i := 0; ; i++ {
+		if _, ok := nt.queues.Load(srv.URL); !ok {
+			break
+		}
+		tassert.Fatalf(t, i < 100, "timed out waiting for idle queue to be removed")
+		time.Sleep(50 * time.Millisecond)
+	}
+	_, err := os.Stat(q.dir)
+	tassert.Errorf(t, os.IsNotExist(err), "expecting spool directory removed, got %v", err)
+
+	// late record (retired queue): delivered via new queue
+	q.add(newRec("obj-2"))
+	waitRecs(t, wh, 2, 10*time.Second)
+	tassert.Errorf(t, nt.queue(srv.URL) != q, "expecting new queue")
+}
@@ -0,0 +1,260 @@
+// Package bnotif delivers bucket event notifications (S3 event-message format) to HTTP webhooks.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package bnotif
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+
+	onexxh "github.com/OneOfOne/xxhash"
+)
+
+// per-webhook queue: in-memory records => spooled batches => delivery
+// (spool is owned by the queue's goroutine: no locking)
+
+type whq struct {
+	n       *notifier
+	url     string
+	dir     string   // spool directory
+	spool   []string // batch filenames, oldest first
+	recs    []Record
+	kick    chan struct{}
+	next    time.Time // next delivery attempt (backoff)
+	retry   time.Duration
+	mu      sync.Mutex
+	last    int64 // mono time of the last added record
+	dropped int64
+	retired bool // removed when idle (see retire)
+}
+
+func newWHQ(n *notifier, whurl string) *whq {
+	digest := onexxh.Checksum64S(cos.UnsafeB(whurl), cos.MLCG32)
+	q := &whq{
+		n:    n,
+		url:  whurl,
+		dir:  filepath.Join(n.dir, strconv.FormatUint(digest, 16)),
+		kick: make(chan struct{}, 1),
+		last: mono.NanoTime(),
+	}
+	if dents, err := os.ReadDir(q.dir); err == nil {
+		for _, dent := range dents {
+			if name := dent.Name(); strings.HasSuffix(name, batchExt) {
+				q.spool = append(q.spool, name)
+			}
+		}
+		sort.Strings(q.spool)
+	}
+	return q
+}
+
+func (q *whq) String() string { return "webhook[" + q.url + "]" }
+
+func (q *whq) add(rec *Record) {
+	q.mu.Lock()
+	if q.retired {
+		q.mu.Unlock()
+		q.n.queue(q.url).add(rec) // (new queue)
+		return
+	}
+	q.last = mono.NanoTime()
+	if len(q.recs) >= maxPending {
+		q.dropped++
+		q.mu.Unlock()
+		return
+	}
+	q.recs = append(q.recs, *rec)
+	l := len(q.recs)
+	q.mu.Unlock()
+	if l >= batchSize {
+		select {
+		case q.kick <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (q *whq) run() {
+	timer := time.NewTimer(flushIval)
+	defer func() {
+		timer.Stop()
+		q.n.wg.Done()
+	}()
+	for {
+		select {
+		case <-q.kick:
+		case <-timer.C:
+		case <-q.n.stopCh:
+			q.seal()
+			return
+		}
+		q.seal()
+		wait := q.deliver()
+		if len(q.spool) == 0 && q.retire() {
+			return
+		}
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		timer.Reset(wait)
+	}
+}
+
+// remove the queue that's been idle for maxIdle (e.g., its URL is no longer
+// referenced by any bucket's notification rules), along with its spool directory
+func (q *whq) retire() bool {
+	q.n.mu.Lock()
+	defer q.n.mu.Unlock()
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.recs) > 0 || mono.Since(q.last) < maxIdle {
+		return false
+	}
+	q.retired = true
+	q.n.queues.Delete(q.url)
+	if err := os.RemoveAll(q.dir); err != nil {
+		nlog.Errorln(q.String(), "failed to remove spool directory:", err)
+	}
+	return true
+}
+
+// write pending records into the spool
+func (q *whq) seal() {
+	q.mu.Lock()
+	recs, dropped := q.recs, q.dropped
+	q.recs, q.dropped = nil, 0
+	q.mu.Unlock()
+
+	if dropped > 0 {
+		nlog.Warningln(q.String(), "in-memory queue is full: dropped", dropped, "record(s)")
+	}
+	if len(recs) == 0 {
+		return
+	}
+	if len(q.spool) == 0 {
+		if err := q.initDir(); err != nil {
+			nlog.Errorln(q.String(), "failed to spool", len(recs), "record(s):", err)
+			return
+		}
+	}
+	for i := 0; i < len(recs); i += batchSize {
+		batch := recs[i:min(i+batchSize, len(recs))]
+		name := fmt.Sprintf("%016x%s", q.n.seq.Inc(), batchExt)
+		if err := q.write(name, cos.MustMarshal(&Message{Records: batch})); err != nil {
+			nlog.Errorln(q.String(), "failed to spool", len(batch), "record(s):", err)
+			continue
+		}
+		q.spool = append(q.spool, name)
+	}
+	if l := len(q.spool); l > maxSpooled {
+		nlog.Warningln(q.String(), "on-disk queue is full: dropping", l-maxSpooled, "oldest batch(es)")
+		for _, name := range q.spool[:l-maxSpooled] {
+			q.remove(name)
+		}
+		q.spool = q.spool[l-maxSpooled:]
+	}
+}
+
+func (q *whq) initDir() error {
+	if err := cos.CreateDir(q.dir); err != nil {
+		return err
+	}
+	return q.write(urlFname, []byte(q.url))
+}
+
+// (write-and-rename)
+func (q *whq) write(name string, b []byte) error {
+	var (
+		fpath = filepath.Join(q.dir, name)
+		tmp   = fpath + ".tmp"
+	)
+	if err := os.WriteFile(tmp, b, cos.PermRWR); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, fpath); err != nil {
+		cos.RemoveFile(tmp)
+		return err
+	}
+	return nil
+}
+
+func (q *whq) remove(name string) {
+	if err := cos.RemoveFile(filepath.Join(q.dir, name)); err != nil {
+		nlog.Errorln(q.String(), "failed to remove spooled batch:", err)
+	}
+}
+
+// deliver spooled batches in order; returns time to wait until the next attempt
+func (q *whq) deliver() time.Duration {
+	if len(q.spool) == 0 {
+		return flushIval
+	}
+	if now := time.Now(); now.Before(q.next) {
+		return q.next.Sub(now)
+	}
+	for len(q.spool) > 0 {
+		name := q.spool[0]
+		b, err := os.ReadFile(filepath.Join(q.dir, name))
+		if err == nil {
+			err = q.post(b)
+		} else if os.IsNotExist(err) {
+			q.spool = q.spool[1:]
+			continue
+		}
+		if err != nil {
+			q.retry = min(max(2*q.retry, minRetry), maxRetry)
+			q.next = time.Now().Add(q.retry)
+			if q.retry == minRetry || q.retry == maxRetry {
+				nlog.Warningln(q.String(), "delivery failed (", len(q.spool), "batch(es) pending, retrying in", q.retry, "):", err)
+			}
+			return q.retry
+		}
+		q.remove(name)
+		q.spool = q.spool[1:]
+	}
+	q.retry = 0
+	return flushIval
+}
+
+func (q *whq) post(b []byte) error {
+	req, err := http.NewRequestWithContext(q.n.ctx, http.MethodPost, q.url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set(cos.HdrContentType, cos.ContentJSON)
+	// via HTTP proxy (environment): check the webhook host (the proxy is checked when dialing)
+	if conf := &cmn.GCO.Get().Webhook; conf.IsActive() {
+		if pu, _ := http.ProxyFromEnvironment(req); pu != nil {
+			if _, err := checkHost(req.Context(), conf, req.URL.Hostname()); err != nil {
+				return err
+			}
+		}
+	}
+	resp, err := q.n.client.Do(req) //nolint:bodyclose // closed below
+	if err != nil {
+		return err
+	}
+	cos.DrainReader(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("POST %s: %s", q.url, resp.Status)
+	}
+	return nil
+}
@@ -52,6 +52,8 @@ Usage examples:
 - ais bucket props BUCKET checksum		# to show
 - ais bucket props set BUCKET backend_bck=s3://abc
 - ais bucket props set BUCKET backend_bck=none	# to reset
+- ais bucket props set BUCKET '{"notification": {"rules": [{"events": ["s3:ObjectCreated:*"], "suffix": ".jpg", "url": "https://hooks.example.com/ais"}]}}'
+- ais bucket props set BUCKET '{"notification": {"rules": []}}'	# to disable notifications
   (see docs/cli for details)
 `
 
@@ -107,7 +109,7 @@ const copyBucketObjUsage = "Copy entire bucket, selected objects, or a single ob
 	indent1 + "\t- 'ais cp s3://abc/dir/ ais://dst --nr'\t- copy only immediate contents of 'dir/' (non-recursive)."
 
 // ais ls (note duplicated `archExts` constant)
-const listAnyUsage = "List buckets, objects in buckets, and files in (.tar, .tgz, .tar.gz, .zip, .tar.lz4)-formatted objects,\n" +
+const listAnyUsage = "List buckets, objects in buckets, and files in (.tar, .tgz, .tar.gz, .zip, .tar.lz4, .tar.zst)-formatted objects,\n" +
 	indent1 + "e.g.:\n" +
 	indent1 + "\t* ais ls \t- list all buckets in a cluster (all providers);\n" +
 	indent1 + "\t* ais ls ais://abc -props name,size,copies,location \t- list objects with only these specific properties;\n" +
@@ -197,6 +199,7 @@ var (
 		noFooterFlag,
 		maxPagesFlag,
 		startAfterFlag,
+		lsoFilterFlag,
 		bckSummaryFlag,
 		nonRecursFlag,
 		noDirsFlag,
@@ -234,6 +237,7 @@ var (
 		commandCopy: {
 			listFlag,
 			templateFlag,
+			objTagsFlag,
 			numWorkersFlag,
 			verbObjPrefixFlag,
 			copyAllObjsFlag,
@@ -258,6 +262,7 @@ var (
 		},
 		commandEvict: append(
 			listRangeProgressWaitFlags,
+			objTagsFlag,
 			keepMDFlag,
 			verbObjPrefixFlag, // to disambiguate bucket/prefix vs bucket/objName
 			dryRunFlag,
@@ -49,8 +49,9 @@ var (
 		clusterFeatures: append(feat.Cluster[:], apc.ResetToken),
 		bucketFeatures:  append(feat.Bucket[:], apc.ResetToken),
 		// rest
-		"write_policy.data":                   apc.SupportedWritePolicy[:],
+		"write_policy.data":                   {string(apc.WriteImmediate), string(apc.WriteDelayed)},
 		"write_policy.md":                     apc.SupportedWritePolicy[:],
+		"lru.policy":                          apc.SupportedEvictPolicy[:],
 		"ec.compression":                      apc.SupportedCompression[:],
 		"compression.checksum":                apc.SupportedCompression[:],
 		"rebalance.compression":               apc.SupportedCompression[:],
@@ -10,6 +10,7 @@ import (
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/ext/dload"
+	"github.com/NVIDIA/aistore/xact"
 
 	"github.com/urfave/cli"
 )
@@ -114,6 +115,7 @@ const (
 	cmdLRU          = apc.ActLRU
 	commandRechunk  = apc.ActRechunk
 	cmdStgCleanup   = "cleanup" // display name for apc.ActStoreCleanup
+	cmdQuarantine   = "quarantine"
 	cmdScrub        = "validate"
 	cmdSummary      = "summary" // ditto apc.ActSummaryBck
 
@@ -151,6 +153,10 @@ const (
 	// mountpath commands (advanced)
 	cmdMpathRescanDisks = "rescan-disks"
 	cmdMpathFshc        = "fshc"
+
+	// quarantine subcommands
+	cmdQntRestore = "restore"
+	cmdQntPurge   = "purge"
 	// backend enable/disable (advanced)
 	cmdBackendEnable  = "enable-backend"
 	cmdBackendDisable = "disable-backend"
@@ -290,7 +296,7 @@ const (
 const separatorLine = "---"
 
 const (
-	archFormats = ".tar, .tgz or .tar.gz, .zip, .tar.lz4" // namely, archive.FileExtensions
+	archFormats = ".tar, .tgz or .tar.gz, .zip, .tar.lz4, .tar.zst" // namely, archive.FileExtensions
 	archExts    = "(" + archFormats + ")"
 )
 
@@ -376,6 +382,9 @@ const (
 	joinNodeArgument          = "IP:PORT"
 	nodeMountpathPairArgument = "NODE_ID=MOUNTPATH [NODE_ID=MOUNTPATH...]"
 
+	// storage quarantine
+	quarantineIDsArgument = "[ID...]"
+
 	// node log
 	showLogArgument = nodeIDArgument
 	getLogArgument  = nodeIDArgument + " [OUT_FILE|OUT_DIR|-]"
@@ -443,6 +452,9 @@ const (
 
 const scopeAll = "all"
 
+// '--filter': job table rows (see columnFilterFlag) and list-objects (lsoFilterFlag)
+const filterFlagName = "filter"
+
 const (
 	cfgScopeAll       = scopeAll
 	cfgScopeLocal     = "local"
@@ -589,7 +601,7 @@ var (
 	}
 
 	columnFilterFlag = cli.StringFlag{
-		Name: "filter",
+		Name: filterFlagName,
 		Usage: "Regular expression to filter job table rows based on column values, format: \"COLUMN=PATTERN\", e.g.:\n" +
 			indent4 + "\t--filter \"STATE=Running\" - show only running jobs\n" +
 			indent4 + "\t--filter \"NODE=(FFIt8090|UTat8088)\" - show jobs for specific nodes\n" +
@@ -655,6 +667,24 @@ var (
 			indent1 + "\tTip: use 'ais config cluster log.modules space' to enable logging for dry-run visibility",
 	}
 
+	clnReportFlag = cli.StringFlag{
+		Name: "report",
+		Usage: "Store the list of removed (or, with '--dry-run', to-be-removed) content in the specified bucket,\n" +
+			indent1 + "\tone object per target: " + xact.ReportPrefix + "/<JOB_ID>/<TARGET_ID>.<FORMAT>",
+	}
+	clnReportFormatFlag = cli.StringFlag{
+		Name:  "report-format",
+		Usage: "Format of the '--report' objects: " + xact.ReportJSON + " or " + xact.ReportCSV,
+		Value: xact.ReportJSON,
+	}
+
+	qntOlderThanFlag = DurationFlag{
+		Name: "older-than",
+		Usage: "Only quarantined items that are older than the specified duration (e.g., 30m, 72h);\n" +
+			indent4 + "\tvalid time units: " + timeUnits,
+	}
+	qntAllFlag = cli.BoolFlag{Name: scopeAll, Usage: "All quarantined items (use with caution)"}
+
 	smallSizeFlag = cli.StringFlag{
 		Name:  "small-size",
 		Usage: "Count and report all objects that are smaller or equal in size (e.g.: 4, 4b, 1k, 128kib; default: 0)",
@@ -688,6 +718,14 @@ var (
 		Name:  "start-after",
 		Usage: "List bucket's content alphabetically starting with the first name _after_ the specified",
 	}
+	lsoFilterFlag = cli.StringFlag{
+		Name: filterFlagName,
+		Usage: "Server-side filter: semicolon-separated conditions that all must hold, e.g.:\n" +
+			indent4 + "\t--filter \"size>=1GiB; mtime>24h\" - objects of 1GiB or larger modified in the last 24 hours\n" +
+			indent4 + "\t--filter \"name~\\.tar$; custom.owner=alice\" - name regex and custom metadata key=value\n" +
+			indent4 + "\t--filter \"name=*.jpg; atime<2026-01-01T00:00:00Z; !cksum\" - name glob, last access time, no checksum\n" +
+			indent4 + "\tsupported: name=GLOB, name~REGEX, size>=|<=, mtime>|<, atime>|< (RFC3339 or duration \"ago\"), [!]cksum, custom.KEY[=VALUE]",
+	}
 
 	//
 	// list-objects sizing and limiting
@@ -940,6 +978,14 @@ var (
 			indent4 + "\t--template '/home/dir/subdir/'\n" +
 			indent4 + "\t--template \"/abc/prefix-{0010..9999..2}-suffix\"",
 	}
+	objTagsFlag = cli.StringFlag{
+		Name: "tags",
+		Usage: "Select (in-cluster) objects that have all the specified tags (see S3 object tagging);\n" +
+			"\tcomma-separated list of 'key=value' (exact match) and/or 'key' (any value), e.g.:\n" +
+			indent4 + "\t--tags 'split=val'\n" +
+			indent4 + "\t--tags 'split=val, owner'\n" +
+			"\tcan be combined with '--list', '--template', or embedded prefix",
+	}
 
 	listRangeProgressWaitFlags = []cli.Flag{
 		listFlag,
@@ -1446,4 +1492,31 @@ var (
 		Name:  forceFlag.Name,
 		Usage: "Proceed with removing existing bucket inventories and creating a new one",
 	}
+
+	// re-sync and retention
+	nbiIntervalFlag = DurationFlag{
+		Name: "interval",
+		Usage: "Periodically re-create (re-sync) the inventory at this interval (min 10m), e.g.: '6h';\n" +
+			indent4 + "\tvalid time units: " + timeUnits,
+	}
+	nbiAfterOpsFlag = cli.IntFlag{
+		Name:  "after-ops",
+		Usage: "Re-create (re-sync) the inventory after so many bucket changes (PUTs and DELETEs)",
+	}
+	nbiSeriesFlag = cli.StringFlag{
+		Name: "inv-series",
+		Usage: "Inventory series: base name shared by re-created inventories (default: inventory name);\n" +
+			indent4 + "\twhen " + qflprn(nbiNameFlag) + " is omitted, the new inventory is named '<series>-<YYYYMMDD-hhmmss>'",
+	}
+	nbiIncrementalFlag = cli.BoolFlag{
+		Name: "incremental",
+		Usage: "Merge recent changes (PUTs, DELETEs, renames) into the latest inventory instead of listing the entire bucket;\n" +
+			indent4 + "\twhen used with " + qflprn(nbiIntervalFlag) + " and/or " + qflprn(nbiAfterOpsFlag) + ", applies to all scheduled re-syncs\n" +
+			indent4 + "\t(note: each target falls back to full listing when it cannot merge, e.g., after restart)",
+	}
+	nbiKeepFlag = cli.IntFlag{
+		Name: "keep",
+		Usage: "Number of the most recent inventories to retain (older ones get removed);\n" +
+			indent4 + "\tallows for multiple inventories per bucket - use " + qflprn(nbiNameFlag) + " 'latest' to list the most recent one",
+	}
 )
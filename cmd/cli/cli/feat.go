@@ -49,6 +49,7 @@ var clusterFeatDesc = [...]string{
 	"resume interrupted multipart uploads from persisted partial manifests",
 	"do not delete unrecognized/invalid FQNs during space cleanup ('ais space-cleanup')",
 	"when bucket is n-way mirrored read object replica from the least-utilized mountpath",
+	"allow ETL process runtime ('runtime.type: process'): user-specified commands executed on target hosts as target's user",
 
 	// apc.ResetToken ("none") ===========
 }
@@ -79,6 +80,7 @@ var featTags = map[string]string{
 	"Resume-Interrupted-MPU":               "mpu,ops",
 	"Keep-Unknown-FQN":                     "integrity?,ops",
 	"Load-Balance-GET":                     "perf",
+	"Allow-ETL-Process-Runtime":            "etl,security-",
 }
 
 // common (cluster, bucket) feature-flags (set, show) helper
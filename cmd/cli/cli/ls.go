@@ -435,6 +435,13 @@ func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch, printEmpt
 	if flagIsSet(c, startAfterFlag) {
 		msg.StartAfter = parseStrFlag(c, startAfterFlag)
 	}
+	if flagIsSet(c, lsoFilterFlag) {
+		flt, err := apc.ParseLsoFilter(parseStrFlag(c, lsoFilterFlag))
+		if err != nil {
+			return fmt.Errorf("invalid %s: %v", qflprn(lsoFilterFlag), err)
+		}
+		msg.Filter = flt
+	}
 	pageSize, maxPages, limit, err := setLsoPage(c, bck)
 	if err != nil {
 		return err
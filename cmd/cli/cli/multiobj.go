@@ -33,6 +33,14 @@ func _warnProgress(c *cli.Context) {
 	actionWarn(c, "cannot show progress bar with an empty list/range type option - "+NIY)
 }
 
+// tag selectors (apc.ListRange.Tags), if specified
+func parseObjTagsFlag(c *cli.Context) []string {
+	if !flagIsSet(c, objTagsFlag) {
+		return nil
+	}
+	return splitCsv(parseStrFlag(c, objTagsFlag))
+}
+
 // x-TCO: multi-object transform or copy
 func runTCO(c *cli.Context, bckFrom, bckTo cmn.Bck, listObjs, tmplObjs, etlName string) error {
 	var (
@@ -68,6 +76,8 @@ func runTCO(c *cli.Context, bckFrom, bckTo cmn.Bck, listObjs, tmplObjs, etlName
 		showProgress = false
 	}
 
+	lrMsg.Tags = parseObjTagsFlag(c)
+
 	// 2. TCO message
 	msg := cmn.TCOMsg{ToBck: bckTo}
 	{
@@ -214,7 +224,7 @@ func _evictOne(c *cli.Context, shift int) error {
 
 	// Choose between bucket and object eviction; if no flags and no object specified, evict whole bucket
 	if oltp.list == "" && oltp.tmpl == "" {
-		if objNameOrTmpl == "" {
+		if objNameOrTmpl == "" && !flagIsSet(c, objTagsFlag) {
 			return evictBucket(c, bck)
 		}
 		// Treat objName as a single-object list
@@ -412,7 +422,10 @@ func _rmOne(c *cli.Context, shift int, warned *bool) error {
 		// TODO: warnEscapeObjName()
 		lrCtx := &lrCtx{oltp.list, oltp.tmpl, bck}
 		return lrCtx.do(c)
-	case oltp.objName == "": // 2. all objects
+	case oltp.objName == "" && flagIsSet(c, objTagsFlag): // 2. all objects that have the specified tags
+		lrCtx := &lrCtx{"", "", bck}
+		return lrCtx.do(c)
+	case oltp.objName == "": // 3. all objects
 		if flagIsSet(c, rmrfFlag) {
 			if !flagIsSet(c, yesFlag) {
 				warn := fmt.Sprintf("will remove all objects from %s. The operation cannot be undone!", bck.String())
@@ -424,7 +437,7 @@ func _rmOne(c *cli.Context, shift int, warned *bool) error {
 		}
 		return incorrectUsageMsg(c, "to select objects to be removed use one of: (%s or %s or %s)",
 			qflprn(listFlag), qflprn(templateFlag), qflprn(rmrfFlag))
-	default: // 3. one obj
+	default: // 4. one obj
 		encObjName := warnEscapeObjName(c, oltp.objName, warned)
 		err := api.DeleteObject(apiBP, bck, encObjName)
 		if err == nil && bck.IsCloud() && oltp.notFound {
@@ -569,6 +582,10 @@ func (lr *lrCtx) do(c *cli.Context) error {
 		}
 	}
 
+	if tags := parseObjTagsFlag(c); len(tags) > 0 {
+		text += fmt.Sprintf(" (tags: %s)", strings.Join(tags, ", "))
+	}
+
 	// 5. progress
 	showProgress := flagIsSet(c, progressFlag)
 	if showProgress && num == 0 {
@@ -653,7 +670,7 @@ func (lr *lrCtx) _do(c *cli.Context, fileList []string) (xid, kind, action strin
 	switch verb {
 	case commandRemove:
 		msg := &apc.EvdMsg{
-			ListRange: apc.ListRange{ObjNames: fileList, Template: lr.tmplObjs},
+			ListRange: apc.ListRange{ObjNames: fileList, Template: lr.tmplObjs, Tags: parseObjTagsFlag(c)},
 			NonRecurs: flagIsSet(c, nonRecursFlag),
 		}
 		xid, err = api.DeleteMultiObj(apiBP, lr.bck, msg)
@@ -687,7 +704,7 @@ func (lr *lrCtx) _do(c *cli.Context, fileList []string) (xid, kind, action strin
 			return "", "", "", err
 		}
 		msg := &apc.EvdMsg{
-			ListRange: apc.ListRange{ObjNames: fileList, Template: lr.tmplObjs},
+			ListRange: apc.ListRange{ObjNames: fileList, Template: lr.tmplObjs, Tags: parseObjTagsFlag(c)},
 			NonRecurs: flagIsSet(c, nonRecursFlag),
 		}
 		xid, err = api.EvictMultiObj(apiBP, lr.bck, msg)
@@ -8,6 +8,7 @@ package cli
 import (
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
@@ -26,7 +27,10 @@ const (
 		indent1 + "\t* ais nbi create s3://abc --prefix images/\t- inventory only objects under 'images/';\n" +
 		indent1 + "\t* ais nbi create s3://abc --all\t- inventory with all object properties;\n" +
 		indent1 + "\t* ais nbi create s3://abc --name-only\t- lightweight: object names only;\n" +
-		indent1 + "\t* ais nbi create ais://@remais/xyz --inv-pages 2\t- remote AIS, with 2 pages per chunk."
+		indent1 + "\t* ais nbi create ais://@remais/xyz --inv-pages 2\t- remote AIS, with 2 pages per chunk;\n" +
+		indent1 + "\t* ais nbi create s3://abc --inv-name daily --interval 24h --keep 3\t- re-sync daily and keep the last 3 inventories;\n" +
+		indent1 + "\t* ais nbi create s3://abc --after-ops 100000 --keep 2\t- re-sync after 100K PUTs and DELETEs, keep 2;\n" +
+		indent1 + "\t* ais nbi create ais://abc --inv-series daily --incremental\t- merge recent changes into the latest 'daily' inventory."
 
 	removeNBIUsage = "Remove bucket inventory,\n" +
 		indent1 + "e.g.:\n" +
@@ -36,7 +40,8 @@ const (
 	showNBIUsage = "Show bucket inventory,\n" +
 		indent1 + "e.g.:\n" +
 		indent1 + "\t* ais nbi show s3://abc\t- show inventory details for the bucket;\n" +
-		indent1 + "\t* ais nbi show s3://abc --inv-name my-first-inventory\t- show specific named inventory."
+		indent1 + "\t* ais nbi show s3://abc --inv-name my-first-inventory\t- show specific named inventory;\n" +
+		indent1 + "\t* ais nbi show s3://abc --verbose\t- include re-sync schedule and retention."
 )
 
 // flags
@@ -49,6 +54,11 @@ var (
 			allPropsFlag,
 			nbiNamesPerChunkFlag,
 			nbiForceFlag,
+			nbiIntervalFlag,
+			nbiAfterOpsFlag,
+			nbiKeepFlag,
+			nbiIncrementalFlag,
+			nbiSeriesFlag,
 		},
 		commandRemove: {
 			nbiNameFlag,
@@ -148,6 +158,33 @@ func createNBIHandler(c *cli.Context) error {
 	}
 	msg.Force = flagIsSet(c, nbiForceFlag)
 
+	// re-sync and retention
+	if flagIsSet(c, nbiIntervalFlag) {
+		msg.Schedule.Interval = cos.Duration(parseDurationFlag(c, nbiIntervalFlag))
+	}
+	if flagIsSet(c, nbiAfterOpsFlag) {
+		msg.Schedule.AfterOps = int64(parseIntFlag(c, nbiAfterOpsFlag))
+	}
+	if flagIsSet(c, nbiKeepFlag) {
+		msg.Keep = parseIntFlag(c, nbiKeepFlag)
+	}
+	if flagIsSet(c, nbiSeriesFlag) {
+		msg.Series = parseStrFlag(c, nbiSeriesFlag)
+		if err := cos.CheckAlphaPlus(msg.Series, "inventory series"); err != nil {
+			return err
+		}
+		if msg.Name == "" {
+			msg.Name = apc.NBISeriesName(msg.Series, time.Now())
+		}
+	}
+	if flagIsSet(c, nbiIncrementalFlag) {
+		msg.Incremental = true
+		msg.Schedule.Incremental = msg.Schedule.IsSet()
+	}
+	if err := msg.Schedule.Validate(); err != nil {
+		return err
+	}
+
 	// do
 	xid, err := api.CreateNBI(apiBP, bck, msg)
 	if err != nil {
@@ -110,6 +110,7 @@ var (
 	objectCmdsFlags = map[string][]cli.Flag{
 		commandRemove: append(
 			listRangeProgressWaitFlags,
+			objTagsFlag,
 			verbObjPrefixFlag, // to disambiguate bucket/prefix vs bucket/objName
 			rmrfFlag,
 			verboseFlag, // rm -rf
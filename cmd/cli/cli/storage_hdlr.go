@@ -1,7 +1,7 @@
 // Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
 // This file handles commands that interact with the cluster.
 /*
- * Copyright (c) 2021-2025, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2021-2026, NVIDIA CORPORATION. All rights reserved.
  */
 package cli
 
@@ -11,7 +11,10 @@ import (
 	"net/http"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
@@ -204,6 +207,9 @@ var (
 		forceClnFlag,
 		rmZeroSizeFlag,
 		keepMisplacedFlag,
+		dryRunFlag,
+		clnReportFlag,
+		clnReportFormatFlag,
 		waitFlag,
 		waitJobXactFinishedFlag,
 	}
@@ -217,12 +223,53 @@ var (
 			indent4 + "\t- optionally, remove zero-size objects as well.\n" +
 			"\n" +
 			indent1 + "\tBy default, any stored content with invalid or unrecognized FQN is treated as obsolete and is removed.\n" +
-			indent1 + "\tTo preserve, use the cluster feature flag 'Keep-Unknown-FQN'.",
+			indent1 + "\tTo preserve, use the cluster feature flag 'Keep-Unknown-FQN'.\n" +
+			indent1 + "\tTo see what would be removed (without removing anything), use " + qflprn(dryRunFlag) + ".",
 		ArgsUsage:    lsAnyCommandArgument,
 		Flags:        sortFlags(cleanupFlags),
 		Action:       cleanupStorageHandler,
 		BashComplete: bucketCompletions(bcmplop{}),
 	}
+
+	qntRmFlags = []cli.Flag{
+		qntOlderThanFlag,
+		qntAllFlag,
+	}
+	quarantineCmd = cli.Command{
+		Name: cmdQuarantine,
+		Usage: "Show, restore, or purge content quarantined by storage cleanup\n" +
+			indent1 + "\t(when cluster config 'space.quarantine' is enabled, invalid FQNs, objects with missing or corrupted metadata,\n" +
+			indent1 + "\tmisplaced objects, orphaned EC slices, and orphaned chunks are moved to per-mountpath quarantine\n" +
+			indent1 + "\trather than removed; see also 'space.quarantine_time')",
+		Flags:  sortFlags([]cli.Flag{jsonFlag}),
+		Action: showQuarantineHandler,
+		Subcommands: []cli.Command{
+			{
+				Name:   commandShow,
+				Usage:  "Show quarantined content",
+				Flags:  sortFlags([]cli.Flag{jsonFlag}),
+				Action: showQuarantineHandler,
+			},
+			{
+				Name: cmdQntRestore,
+				Usage: "Move quarantined content back to its original location, e.g.:\n" +
+					indent1 + "\t- 'ais storage quarantine restore ID1 ID2'\t- restore the specified items;\n" +
+					indent1 + "\t- 'ais storage quarantine restore --all'\t- restore everything",
+				ArgsUsage: quarantineIDsArgument,
+				Flags:     sortFlags(qntRmFlags),
+				Action:    restoreQuarantineHandler,
+			},
+			{
+				Name: cmdQntPurge,
+				Usage: "Permanently remove quarantined content, e.g.:\n" +
+					indent1 + "\t- 'ais storage quarantine purge ID1 ID2'\t- remove the specified items;\n" +
+					indent1 + "\t- 'ais storage quarantine purge --older-than 72h'\t- remove items quarantined more than 3 days ago",
+				ArgsUsage: quarantineIDsArgument,
+				Flags:     sortFlags(append(qntRmFlags, yesFlag)),
+				Action:    purgeQuarantineHandler,
+			},
+		},
+	}
 )
 
 var (
@@ -299,6 +346,7 @@ var (
 			mpathCmd,
 			showCmdDisk,
 			cleanupCmd,
+			quarantineCmd,
 			makeAlias(&jobStartResilver, &mkaliasOpts{
 				replace: cos.StrKVs{
 					"ais job start resilver": "ais storage resilver",
@@ -339,6 +387,20 @@ func cleanupStorageHandler(c *cli.Context) error {
 	if flagIsSet(c, keepMisplacedFlag) {
 		xargs.Flags |= xact.FlagKeepMisplaced
 	}
+	dryRun := flagIsSet(c, dryRunFlag)
+	if dryRun {
+		xargs.Flags |= xact.FlagDryRun
+	}
+	if flagIsSet(c, clnReportFlag) {
+		rbck, err := parseBckURI(c, parseStrFlag(c, clnReportFlag), false)
+		if err != nil {
+			return err
+		}
+		xargs.Report = &xact.ReportArgs{Bck: rbck, Format: parseStrFlag(c, clnReportFormatFlag)}
+		if err := xargs.Report.Validate(); err != nil {
+			return err
+		}
+	}
 
 	// do
 	xid, err := xstart(&xargs, "")
@@ -359,6 +421,9 @@ func cleanupStorageHandler(c *cli.Context) error {
 		} else {
 			fmt.Fprintln(c.App.Writer, "Started storage cleanup")
 		}
+		if dryRun && xid != "" {
+			actionNote(c, fmt.Sprintf("dry-run: to see what would be removed, run 'ais show job %s -v'", xid))
+		}
 		return nil
 	}
 
@@ -370,9 +435,140 @@ func cleanupStorageHandler(c *cli.Context) error {
 		return err
 	}
 	fmt.Fprint(c.App.Writer, fmtXactSucceeded)
+	if !dryRun {
+		return nil
+	}
+	xargs.OnlyRunning = false
+	return showClnTotals(c, &xargs)
+}
+
+// per target, per reason (see space.ExtClnStats)
+func showClnTotals(c *cli.Context, xargs *xact.ArgsMsg) error {
+	xs, _, err := queryXactions(xargs, false)
+	if err != nil {
+		return err
+	}
+	tids := make([]string, 0, len(xs))
+	for tid := range xs {
+		tids = append(tids, tid)
+	}
+	sort.Strings(tids)
+
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "TARGET\tREASON\tCOUNT\tSIZE")
+	for _, tid := range tids {
+		for _, snap := range xs[tid] {
+			ext, ok := snap.Ext.(map[string]any)
+			if !ok {
+				continue
+			}
+			reasons := make([]string, 0, len(ext))
+			for k := range ext {
+				if r, ok := strings.CutSuffix(k, ".n"); ok {
+					reasons = append(reasons, r)
+				}
+			}
+			sort.Strings(reasons)
+			for _, r := range reasons {
+				n, _ := ext[r+".n"].(string)
+				if n == "" || n == "0" {
+					continue
+				}
+				size := "-"
+				if v, ok := ext[r+".size"].(string); ok {
+					if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+						size = cos.IEC(i, 2)
+					}
+				}
+				fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", meta.Tname(tid), r, n, size)
+			}
+		}
+	}
+	return tw.Flush()
+}
+
+//
+// quarantine
+//
+
+func showQuarantineHandler(c *cli.Context) error {
+	all, err := api.GetQuarantined(apiBP)
+	if err != nil {
+		return V(err)
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(all, "", teb.Jopts(true))
+	}
+	tids := make([]string, 0, len(all))
+	for tid, entries := range all {
+		if len(entries) > 0 {
+			tids = append(tids, tid)
+		}
+	}
+	if len(tids) == 0 {
+		actionDone(c, "No quarantined content")
+		return nil
+	}
+	sort.Strings(tids)
+
+	var (
+		now = time.Now().UnixNano()
+		tw  = &tabwriter.Writer{}
+	)
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tTARGET\tREASON\tSIZE\tAGE\tFQN")
+	for _, tid := range tids {
+		for _, e := range all[tid] {
+			age := time.Duration(now - e.Time).Truncate(time.Second)
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%v\t%s\n", e.ID, meta.Tname(tid), e.Reason, cos.IEC(e.Size, 2), age, e.FQN)
+		}
+	}
+	return tw.Flush()
+}
+
+func restoreQuarantineHandler(c *cli.Context) error {
+	msg, err := parseQntMsg(c)
+	if err != nil {
+		return err
+	}
+	n, err := api.RestoreQuarantined(apiBP, msg)
+	if err != nil {
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("Restored %d quarantined item%s", n, cos.Plural(n)))
+	return nil
+}
+
+func purgeQuarantineHandler(c *cli.Context) error {
+	msg, err := parseQntMsg(c)
+	if err != nil {
+		return err
+	}
+	if !flagIsSet(c, yesFlag) {
+		if ok := confirm(c, "Permanently remove quarantined content?"); !ok {
+			return nil
+		}
+	}
+	n, err := api.PurgeQuarantined(apiBP, msg)
+	if err != nil {
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("Purged %d quarantined item%s", n, cos.Plural(n)))
 	return nil
 }
 
+func parseQntMsg(c *cli.Context) (*apc.QuarantineMsg, error) {
+	msg := &apc.QuarantineMsg{IDs: c.Args()}
+	if flagIsSet(c, qntOlderThanFlag) {
+		msg.OlderThan = parseDurationFlag(c, qntOlderThanFlag)
+	}
+	if len(msg.IDs) == 0 && msg.OlderThan == 0 && !flagIsSet(c, qntAllFlag) {
+		return nil, fmt.Errorf("expecting quarantined item ID(s), %s, or %s", qflprn(qntOlderThanFlag), qflprn(qntAllFlag))
+	}
+	return msg, nil
+}
+
 //
 // disk
 //
@@ -141,7 +141,7 @@ func copyTransform(c *cli.Context, etlName, objNameOrTmpl string, bckFrom, bckTo
 	//
 	// (1) copy/transform bucket (x-tcb)
 	//
-	if oltp.objName == "" && oltp.list == "" && oltp.tmpl == "" {
+	if oltp.objName == "" && oltp.list == "" && oltp.tmpl == "" && !flagIsSet(c, objTagsFlag) {
 		// NOTE: e.g. 'ais cp gs://abc gs:/abc' to sync remote bucket => aistore
 		if bckFrom.Equal(&bckTo) && !bckFrom.IsRemote() {
 			return incorrectUsageMsg(c, errFmtSameBucket, commandCopy, bckTo.Cname(""))
@@ -534,7 +534,15 @@ func bckPropList(props *cmn.Bprops, verbose bool) (propList nvpairList) {
 			{"mirror", props.Mirror.String()},
 			{"ec", props.EC.String()},
 			{"chunks", props.Chunks.String()},
+			{"compression", props.Compression.String()},
+			{"encryption", props.Encryption.String()},
 			{"lru", props.LRU.String()},
+			{"lifecycle", props.Lifecycle.String()},
+			{"cors", props.CORS.String()},
+			{"tags", props.Tags.String()},
+			{"object_lock", props.ObjectLock.String()},
+			{"notification", props.Notif.String()},
+			{"quota", props.Quota.String()},
 			{"versioning", props.Versioning.String()},
 		}
 		if props.Provider == apc.HT {
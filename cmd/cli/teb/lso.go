@@ -103,6 +103,8 @@ func fmtLsObjStatus(en *cmn.LsoEnt) string {
 			return fblue("deleted") // as in note: deleted
 		case en.IsAnyFlagSet(apc.EntryHeadFail):
 			return fred("remote-error")
+		case en.IsAnyFlagSet(apc.EntryNotSynced):
+			return fcyan("not-synced") // write-back pending
 		default:
 			return "ok"
 		}
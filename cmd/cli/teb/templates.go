@@ -374,7 +374,7 @@ See '--help' and docs/cli for details.`
 	// `search`
 	SearchTmpl = "{{ JoinListNL . }}\n"
 
-	NBITmpl = "BUCKET\t NAME\t SIZE\t OBJECTS\t STARTED\t FINISHED\t PREFIX\n" +
+	NBITmpl = "BUCKET\t NAME\t SIZE\t OBJECTS\t STARTED\t FINISHED\t AGE\t STALENESS\t PREFIX\n" +
 		"{{range $v := .}}" +
 		"{{$v.Bucket}}\t " +
 		"{{$v.Name}}\t " +
@@ -382,10 +382,12 @@ See '--help' and docs/cli for details.`
 		"{{if $v.Ntotal}}{{$v.Ntotal}}{{else}}-{{end}}\t " +
 		"{{FormatUnixNano $v.Started}}\t " +
 		"{{FormatUnixNano $v.Finished}}\t " +
+		"{{FormatNBIAge $v}}\t " +
+		"{{FormatNBIStale $v}}\t " +
 		"{{if $v.Prefix}}{{$v.Prefix}}{{else}}-{{end}}\n" +
 		"{{end}}"
 
-	NBITmplVerbose = "BUCKET\t NAME\t OBJECT\t SIZE\t OBJECTS\t CHUNKS\t TARGETS\t SMAP\t STARTED\t FINISHED\t PREFIX\n" +
+	NBITmplVerbose = "BUCKET\t NAME\t OBJECT\t SIZE\t OBJECTS\t CHUNKS\t TARGETS\t SMAP\t STARTED\t FINISHED\t AGE\t STALENESS\t SCHEDULE\t KEEP\t BASE\t PREFIX\n" +
 		"{{range $v := .}}" +
 		"{{$v.Bucket}}\t " +
 		"{{$v.Name}}\t " +
@@ -397,6 +399,11 @@ See '--help' and docs/cli for details.`
 		"{{if $v.SmapVer}}v{{$v.SmapVer}}{{else}}-{{end}}\t " +
 		"{{FormatUnixNano $v.Started}}\t " +
 		"{{FormatUnixNano $v.Finished}}\t " +
+		"{{FormatNBIAge $v}}\t " +
+		"{{FormatNBIStale $v}}\t " +
+		"{{if $v.Schedule.IsSet}}{{$v.Schedule.String}}{{else}}-{{end}}\t " +
+		"{{if $v.Keep}}{{$v.Keep}}{{else}}-{{end}}\t " +
+		"{{if $v.Base}}{{$v.Base}} (gen {{$v.Gen}}){{else}}-{{end}}\t " +
 		"{{if $v.Prefix}}{{$v.Prefix}}{{else}}-{{end}}\n" +
 		"{{end}}"
 
@@ -79,6 +79,8 @@ var (
 		"FormatMilli":          func(dur cos.Duration) string { return fmtMilli(dur, cos.UnitsIEC) },
 		"FormatDuration":       FormatDuration,
 		"FormatUnixNano":       fmtUnixNano,
+		"FormatNBIAge":         fmtNBIAge,
+		"FormatNBIStale":       fmtNBIStale,
 		"FormatStart":          FmtTime,
 		"FormatEnd":            FmtTime,
 		"FormatDsortStatus":    dsortJobInfoStatus,
@@ -617,3 +619,25 @@ func fmtUnixNano(v int64) string {
 	}
 	return time.Unix(0, v).UTC().Format(time.RFC3339)
 }
+
+// time since the inventory was created
+func fmtNBIAge(info *apc.NBIInfo) string {
+	if info.Finished == 0 {
+		return "-"
+	}
+	return FormatDuration(time.Since(time.Unix(0, info.Finished)).Truncate(time.Second))
+}
+
+// (best-effort) staleness: bucket changes since the latest inventory was created
+func fmtNBIStale(info *apc.NBIInfo) string {
+	switch {
+	case !info.Latest:
+		return "superseded"
+	case info.Changes == 0:
+		return "up to date"
+	case info.Schedule.IsDue(info, time.Now().UnixNano()):
+		return fmt.Sprintf("%d changes (re-sync due)", info.Changes)
+	default:
+		return fmt.Sprintf("%d changes", info.Changes)
+	}
+}
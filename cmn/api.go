@@ -61,8 +61,16 @@ type (
 		RateLimit   RateLimitConf   `json:"rate_limit"`                       // frontend and backend rate limiting - bursty and adaptive, respectively
 		EC          ECConf          `json:"ec"`                               // erasure coding
 		Chunks      ChunksConf      `json:"chunks"`                           // chunks and chunk manifests; multipart upload
+		Compression CompressionConf `json:"compression"`                      // transparent at-rest compression (lz4 | zstd)
+		Encryption  EncryptionConf  `json:"encryption"`                       // transparent at-rest encryption (AES-256-GCM)
 		Mirror      MirrorConf      `json:"mirror"`                           // n-way mirroring
 		LRU         LRUConf         `json:"lru"`                              // LRU watermarks and enable/disable
+		Lifecycle   LifecycleConf   `json:"lifecycle"`                        // expiration, eviction, and abort-multipart rules
+		CORS        CORSConf        `json:"cors"`                             // cross-origin resource sharing (browser clients)
+		Tags        Tags            `json:"tags,omitempty" list:"readonly"`   // bucket tags (S3 PutBucketTagging)
+		ObjectLock  ObjectLockConf  `json:"object_lock"`                      // WORM: default retention; once enabled, cannot be disabled
+		Notif       NotifConf       `json:"notification"`                     // event notifications (webhooks)
+		Quota       QuotaConf       `json:"quota"`                            // hard and soft limits: size and number of objects
 		Access      apc.AccessAttrs `json:"access,string"`                    // access permissions
 		Features    feat.Flags      `json:"features,string"`                  // to flip assorted enumerated defaults (e.g. "S3-Use-Path-Style"; see cmn/feat)
 		BID         uint64          `json:"bid,string" list:"omit"`           // unique ID
@@ -140,8 +148,16 @@ type (
 		Versioning  *VersionConfToSet     `json:"versioning,omitempty"`
 		Cksum       *CksumConfToSet       `json:"checksum,omitempty"`
 		LRU         *LRUConfToSet         `json:"lru,omitempty"`
+		Lifecycle   *LifecycleConfToSet   `json:"lifecycle,omitempty"`
+		CORS        *CORSConfToSet        `json:"cors,omitempty"`
+		Tags        *Tags                 `json:"tags,omitempty"`
+		ObjectLock  *ObjectLockConfToSet  `json:"object_lock,omitempty"`
+		Notif       *NotifConfToSet       `json:"notification,omitempty"`
+		Quota       *QuotaConfToSet       `json:"quota,omitempty"`
 		Mirror      *MirrorConfToSet      `json:"mirror,omitempty"`
 		Chunks      *ChunksConfToSet      `json:"chunks,omitempty"`
+		Compression *CompressionConfToSet `json:"compression,omitempty"`
+		Encryption  *EncryptionConfToSet  `json:"encryption,omitempty"`
 		EC          *ECConfToSet          `json:"ec,omitempty"`
 		Access      *apc.AccessAttrs      `json:"access,string,omitempty"`
 		RateLimit   *RateLimitConfToSet   `json:"rate_limit,omitempty"`
@@ -244,7 +260,7 @@ func (bp *Bprops) Validate(targetCnt int) error {
 
 	// run assorted props validators
 	var softErr error
-	for _, pv := range []propsValidator{&bp.Cksum, &bp.Mirror, &bp.EC, &bp.Extra, &bp.WritePolicy, &bp.RateLimit, &bp.Chunks, &bp.LRU, &bp.Features} {
+	for _, pv := range []propsValidator{&bp.Cksum, &bp.Mirror, &bp.EC, &bp.Extra, &bp.WritePolicy, &bp.RateLimit, &bp.Chunks, &bp.Compression, &bp.Encryption, &bp.LRU, &bp.Lifecycle, &bp.CORS, &bp.Tags, &bp.ObjectLock, &bp.Notif, &bp.Quota, &bp.Features} {
 		var err error
 		switch {
 		case pv == &bp.EC:
@@ -503,7 +519,7 @@ type (
 	// ArchiveBckMsg contains parameters to archive multiple objects from the specified (source) bucket.
 	// Destination bucket may the same as the source or a different one.
 	// --------------------  NOTE on terminology:   ---------------------
-	// "archive" is any (.tar, .tgz/.tar.gz, .zip, .tar.lz4) formatted object often also called "shard"
+	// "archive" is any (.tar, .tgz/.tar.gz, .zip, .tar.lz4, .tar.zst) formatted object often also called "shard"
 	//
 	// See also: apc.PutApndArchArgs
 	ArchiveBckMsg struct {
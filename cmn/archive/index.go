@@ -0,0 +1,230 @@
+// Package archive: write, read, copy, append, list primitives
+// across all supported formats
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package archive
+
+import (
+	"archive/tar"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+
+	onexxh "github.com/OneOfOne/xxhash"
+)
+
+// TAR index -----------------------------------------------------------
+// Maps archived filenames to their respective data (offset, size) in a
+// plain (uncompressed) TAR, to read any given file without scanning the
+// archive header by header, and to list the archive without reading it.
+//
+// - built in a single pass that seeks over file contents (tar.Reader does
+//   that when the underlying reader is an io.Seeker);
+// - entries are kept in the archive order; directories are not indexed
+//   (same as List); duplicate names: the first one wins (same as ReadOne);
+// - sparse files cannot be indexed (their data is not contiguous);
+// - `Size` and `Mtime` identify the indexed archive - the caller's
+//   responsibility to set `Mtime` and validate both prior to using
+//   a persisted (packed) index.
+// ---------------------------------------------------------------------
+
+const tarIndexVer = 1
+
+type (
+	TarEntry struct {
+		Name   string
+		Offset int64 // data offset
+		Size   int64
+	}
+	TarIndex struct {
+		m       map[string]int // [name w/ no leading separator (see namesEq) => index in Entries]
+		Entries []TarEntry
+		Size    int64 // archive size
+		Mtime   int64 // archive mtime (ns)
+	}
+)
+
+var ErrTarSparse = errors.New("sparse TAR entries cannot be indexed")
+
+func IndexTar(r io.ReaderAt, size int64) (*TarIndex, error) {
+	var (
+		sr  = io.NewSectionReader(r, 0, size)
+		tr  = tar.NewReader(sr)
+		idx = &TarIndex{Entries: make([]TarEntry, 0, 64), Size: size}
+	)
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			if err == io.EOF {
+				idx.init()
+				return idx, nil
+			}
+			return nil, err
+		}
+		if _isSparse(hdr) {
+			return nil, ErrTarSparse
+		}
+		if hdr.FileInfo().IsDir() {
+			continue
+		}
+		// positioned at the beginning of the file's data
+		offset, err := sr.Seek(0, io.SeekCurrent)
+		if err != nil {
+			debug.AssertNoErr(err) // unlikely
+			return nil, err
+		}
+		idx.Entries = append(idx.Entries, TarEntry{Name: hdr.Name, Offset: offset, Size: hdr.Size})
+	}
+}
+
+func _isSparse(hdr *tar.Header) bool {
+	if hdr.Typeflag == tar.TypeGNUSparse {
+		return true
+	}
+	for k := range hdr.PAXRecords {
+		if strings.HasPrefix(k, "GNU.sparse.") {
+			return true
+		}
+	}
+	return false
+}
+
+func _trimSep(name string) string {
+	if name != "" && name[0] == filepath.Separator {
+		return name[1:]
+	}
+	return name
+}
+
+func (idx *TarIndex) init() {
+	idx.m = make(map[string]int, len(idx.Entries))
+	for i := range idx.Entries {
+		name := _trimSep(idx.Entries[i].Name)
+		if _, ok := idx.m[name]; !ok {
+			idx.m[name] = i
+		}
+	}
+}
+
+func (idx *TarIndex) Len() int { return len(idx.Entries) }
+
+func (idx *TarIndex) Find(filename string) (TarEntry, bool) {
+	i, ok := idx.m[_trimSep(filename)]
+	if !ok {
+		return TarEntry{}, false
+	}
+	return idx.Entries[i], true
+}
+
+// returns nil when not found (compare with Reader.ReadOne)
+func (idx *TarIndex) ReadOne(r io.ReaderAt, filename string) cos.ReadCloseSizer {
+	debug.Assert(filename != "", "missing archived filename (pathname)")
+	e, ok := idx.Find(filename)
+	if !ok {
+		return nil
+	}
+	return &cslLimited{LimitedReader: io.LimitedReader{R: io.NewSectionReader(r, e.Offset, e.Size), N: e.Size}}
+}
+
+// same as List but without reading the archive
+func (idx *TarIndex) List() []*Entry {
+	lst := make([]*Entry, len(idx.Entries))
+	for i := range idx.Entries {
+		lst[i] = &Entry{Name: idx.Entries[i].Name, Size: idx.Entries[i].Size}
+	}
+	sort.Slice(lst, func(i, j int) bool { return lst[i].Name < lst[j].Name })
+	return lst
+}
+
+//
+// persistence: [version, size, mtime, num entries, entries..., xxhash64]
+//
+
+func (idx *TarIndex) PackedSize() int {
+	l := 1 + cos.SizeofI64*2 + cos.SizeofI32
+	for i := range idx.Entries {
+		l += cos.PackedStrLen(idx.Entries[i].Name) + cos.SizeofI64*2
+	}
+	return l + cos.SizeXXHash64
+}
+
+func (idx *TarIndex) Pack() []byte {
+	var (
+		l  = idx.PackedSize()
+		bw = cos.NewPacker(nil, l)
+	)
+	bw.WriteUint8(tarIndexVer)
+	bw.WriteInt64(idx.Size)
+	bw.WriteInt64(idx.Mtime)
+	bw.WriteInt32(int32(len(idx.Entries)))
+	for i := range idx.Entries {
+		e := &idx.Entries[i]
+		bw.WriteString(e.Name)
+		bw.WriteInt64(e.Offset)
+		bw.WriteInt64(e.Size)
+	}
+	b := bw.Bytes()
+	b = binary.BigEndian.AppendUint64(b[:len(b):l], onexxh.Checksum64S(b, cos.MLCG32))
+	debug.Assert(len(b) == l, len(b), " vs ", l)
+	return b
+}
+
+func UnpackTarIndex(b []byte) (*TarIndex, error) {
+	if len(b) < 1+cos.SizeofI64*2+cos.SizeofI32+cos.SizeXXHash64 {
+		return nil, fmt.Errorf("invalid TAR index: too short (%d)", len(b))
+	}
+	var (
+		l        = len(b) - cos.SizeXXHash64
+		expected = binary.BigEndian.Uint64(b[l:])
+	)
+	if actual := onexxh.Checksum64S(b[:l], cos.MLCG32); actual != expected {
+		return nil, cos.NewErrMetaCksum(expected, actual, "TAR index")
+	}
+	var (
+		br  = cos.NewUnpacker(b[:l])
+		idx = &TarIndex{}
+	)
+	ver, err := br.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	if ver != tarIndexVer {
+		return nil, fmt.Errorf("unsupported TAR index version %d (expecting %d)", ver, tarIndexVer)
+	}
+	if idx.Size, err = br.ReadInt64(); err != nil {
+		return nil, err
+	}
+	if idx.Mtime, err = br.ReadInt64(); err != nil {
+		return nil, err
+	}
+	n, err := br.ReadInt32()
+	if err != nil {
+		return nil, err
+	}
+	if n < 0 || int(n) > br.Len() {
+		return nil, fmt.Errorf("invalid TAR index: number of entries %d", n)
+	}
+	idx.Entries = make([]TarEntry, n)
+	for i := range idx.Entries {
+		e := &idx.Entries[i]
+		if e.Name, err = br.ReadString(); err != nil {
+			return nil, err
+		}
+		if e.Offset, err = br.ReadInt64(); err != nil {
+			return nil, err
+		}
+		if e.Size, err = br.ReadInt64(); err != nil {
+			return nil, err
+		}
+	}
+	idx.init()
+	return idx, nil
+}
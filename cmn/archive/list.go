@@ -1,7 +1,7 @@
 // Package archive: write, read, copy, append, list primitives
 // across all supported formats
 /*
- * Copyright (c) 2018-2025, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2018-2026, NVIDIA CORPORATION. All rights reserved.
  */
 package archive
 
@@ -17,6 +17,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/pierrec/lz4/v4"
 )
 
@@ -28,35 +29,46 @@ type Entry struct {
 	Size int64 // uncompressed size
 }
 
+// list (plain) archive file
 func List(fqn string) ([]*Entry, error) {
+	fh, err := os.Open(fqn)
+	if err != nil {
+		return nil, err
+	}
 	var (
 		lst   []*Entry
 		finfo os.FileInfo
 	)
-	fh, err := os.Open(fqn)
-	if err != nil {
-		return nil, err
+	if finfo, err = fh.Stat(); err == nil {
+		lst, err = ListReader(fh, fqn, finfo.Size())
 	}
-	mime, err := MimeFile(fh, nil /*NOTE: not reading file magic*/, "", fqn)
+	cos.Close(fh)
+	return lst, err
+}
+
+// list archive via an open reader, e.g., the one that decompresses and/or decrypts
+// stored object (see core.LOM.Open); the format is determined by the archname's
+// extension (NOTE: not reading file magic); size (of the archive itself) is needed for zip
+func ListReader(r cos.ReadReaderAt, archname string, size int64) ([]*Entry, error) {
+	mime, err := Mime("", archname)
 	if err != nil {
 		return nil, err
 	}
+	var lst []*Entry
 	switch mime {
 	case ExtTar:
-		lst, err = lsTar(fh)
+		lst, err = lsTar(r)
 	case ExtTgz, ExtTarGz:
-		lst, err = lsTgz(fh)
+		lst, err = lsTgz(r)
 	case ExtZip:
-		finfo, err = os.Stat(fqn)
-		if err == nil {
-			lst, err = lsZip(fh, finfo.Size())
-		}
+		lst, err = lsZip(r, size)
 	case ExtTarLz4:
-		lst, err = lsLz4(fh)
+		lst, err = lsLz4(r)
+	case ExtTarZst:
+		lst, err = lsZst(r)
 	default:
 		debug.Assert(false, mime)
 	}
-	cos.Close(fh)
 	if err != nil {
 		return nil, err
 	}
@@ -116,6 +128,16 @@ func lsLz4(reader io.Reader) ([]*Entry, error) {
 	return lsTar(lzr)
 }
 
+func lsZst(reader io.Reader) ([]*Entry, error) {
+	zsr, err := zstd.NewReader(reader, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		return nil, err
+	}
+	lst, err := lsTar(zsr)
+	zsr.Close()
+	return lst, err
+}
+
 // Split a path at the first archive extension boundary, e.g.:
 // "a/b/c/shard.tar/dir/file.bin" -> ("a/b/c/shard.tar", "dir/file.bin")
 // "plain/object/path" -> ("plain/object/path", "").
@@ -1,7 +1,7 @@
 // Package archive: write, read, copy, append, list primitives
 // across all supported formats
 /*
- * Copyright (c) 2018-2025, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2018-2026, NVIDIA CORPORATION. All rights reserved.
  */
 package archive
 
@@ -18,6 +18,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/pierrec/lz4/v4"
 )
 
@@ -97,6 +98,10 @@ type (
 		tr  tarReader
 		lzr *lz4.Reader
 	}
+	zstReader struct {
+		tr  tarReader
+		zsr *zstd.Decoder
+	}
 )
 
 // interface guard
@@ -105,6 +110,7 @@ var (
 	_ Reader = (*tgzReader)(nil)
 	_ Reader = (*zipReader)(nil)
 	_ Reader = (*lz4Reader)(nil)
+	_ Reader = (*zstReader)(nil)
 )
 
 func NewReader(mime string, fh io.Reader, size ...int64) (ar Reader, err error) {
@@ -118,6 +124,8 @@ func NewReader(mime string, fh io.Reader, size ...int64) (ar Reader, err error)
 		ar = &zipReader{size: size[0]}
 	case ExtTarLz4:
 		ar = &lz4Reader{}
+	case ExtTarZst:
+		ar = &zstReader{}
 	default:
 		debug.Assert(false, mime)
 	}
@@ -327,6 +335,40 @@ func (lzr *lz4Reader) ReadOne(filename string) (cos.ReadCloseSizer, error) {
 	return lzr.tr.ReadOne(filename)
 }
 
+// zstReader
+// (compare with tgzReader: zstd.Decoder must be closed to release its resources)
+
+func (zsr *zstReader) init(fh io.Reader) (err error) {
+	zsr.zsr, err = zstd.NewReader(fh, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		return
+	}
+	zsr.tr.baseR.init(zsr.zsr)
+	zsr.tr.tr = tar.NewReader(zsr.zsr)
+	return
+}
+
+func (zsr *zstReader) ReadUntil(rcb ArchRCB, regex, mmode string) error {
+	err := zsr.tr.ReadUntil(rcb, regex, mmode)
+	zsr.zsr.Close()
+	return err
+}
+
+func (zsr *zstReader) ReadOne(filename string) (cos.ReadCloseSizer, error) {
+	reader, err := zsr.tr.ReadOne(filename)
+	if err != nil {
+		zsr.zsr.Close()
+		return reader, err
+	}
+	if reader != nil {
+		// the caller closes the returned reader (and, via the latter, the decoder)
+		csc := &cslClose{gzr: zsr.zsr.IOReadCloser(), R: reader, N: reader.Size()}
+		return csc, nil
+	}
+	zsr.zsr.Close()
+	return nil, nil
+}
+
 //
 // more limited readers
 //
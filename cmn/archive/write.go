@@ -1,7 +1,7 @@
 // Package archive: write, read, copy, append, list primitives
 // across all supported formats
 /*
- * Copyright (c) 2018-2025, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2018-2026, NVIDIA CORPORATION. All rights reserved.
  */
 package archive
 
@@ -20,6 +20,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/feat"
 	"github.com/NVIDIA/aistore/memsys"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/pierrec/lz4/v4"
 )
 
@@ -73,6 +74,10 @@ type (
 		lzw *lz4.Writer
 		tw  tarWriter
 	}
+	zstWriter struct {
+		zsw *zstd.Encoder
+		tw  tarWriter
+	}
 )
 
 // interface guard
@@ -81,6 +86,7 @@ var (
 	_ Writer = (*tgzWriter)(nil)
 	_ Writer = (*zipWriter)(nil)
 	_ Writer = (*lz4Writer)(nil)
+	_ Writer = (*zstWriter)(nil)
 )
 
 // calls init() -> open(),alloc()
@@ -94,6 +100,8 @@ func NewWriter(mime string, w io.Writer, cksum *cos.CksumHashSize, opts *Opts) (
 		aw = &zipWriter{}
 	case ExtTarLz4:
 		aw = &lz4Writer{}
+	case ExtTarZst:
+		aw = &zstWriter{}
 	default:
 		debug.Assert(false, mime)
 	}
@@ -301,3 +309,40 @@ func (lzw *lz4Writer) Copy(src io.Reader, _ ...int64) error {
 }
 
 func (lzw *lz4Writer) Flush() error { return lzw.tw.Flush() }
+
+// zstWriter
+// (single-threaded encoding - concurrency comes from writing multiple archives in parallel)
+
+func (zsw *zstWriter) init(w io.Writer, cksum *cos.CksumHashSize, opts *Opts) {
+	var err error
+	zsw.tw.baseW.init(w, cksum, opts)
+	zsw.zsw, err = zstd.NewWriter(zsw.tw.wmul, zstd.WithEncoderLevel(zstd.SpeedFastest), zstd.WithEncoderConcurrency(1))
+	debug.AssertNoErr(err)
+	zsw.tw.tw = tar.NewWriter(zsw.zsw)
+}
+
+func (zsw *zstWriter) Fini() error {
+	// close (and note: tar.close flushes)
+	if err := zsw.tw.Fini(); err != nil {
+		zsw.zsw.Close() // Try to close zstd anyway
+		return err
+	}
+
+	return zsw.zsw.Close()
+}
+
+func (zsw *zstWriter) Write(fullname string, oah cos.OAH, reader io.Reader) error {
+	return zsw.tw.Write(fullname, oah, reader)
+}
+
+func (zsw *zstWriter) Copy(src io.Reader, _ ...int64) error {
+	zsr, err := zstd.NewReader(src, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		return err
+	}
+	err = cpTar(zsr, zsw.tw.tw, zsw.tw.buf)
+	zsr.Close()
+	return err
+}
+
+func (zsw *zstWriter) Flush() error { return zsw.tw.Flush() }
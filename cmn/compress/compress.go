@@ -0,0 +1,124 @@
+// Package compress provides seekable (framed) compression of object and chunk
+// data stored at rest.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package compress
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+)
+
+// On-disk layout:
+//
+// | frame #1 | frame #2 | ... | frame #N | seek table | footer |
+//
+// * frames - independently compressed, each holding FrameSize bytes of the original
+//   (logical) content except the last one that may be shorter;
+//   a frame that does not compress is stored as is;
+// * seek table - N 4-byte entries: physical (on-disk) size of each frame,
+//   with the most significant bit indicating a stored (uncompressed) frame;
+// * footer (24 bytes):
+//   | logical size (8) | frame size (4) | number of frames (4) | algo (1) | version (1) | reserved (2) | magic (4) |
+//
+// Given the seek table, reading at any logical offset requires decompressing
+// a single frame - see Reader.ReadAt.
+
+const (
+	FrameSize = 256 * cos.KiB
+
+	footerLen = 24
+	entryLen  = 4 // seek table entry
+	version   = 1
+	storedBit = uint32(1) << 31
+)
+
+var magic = [4]byte{'a', 'i', 's', 'z'}
+
+type Algo uint8
+
+const (
+	None Algo = iota
+	LZ4
+	Zstd
+)
+
+var (
+	ErrCorrupted = errors.New("corrupted compressed data")
+
+	errNegOffset = errors.New("compress: negative offset")
+)
+
+func ParseAlgo(s string) (Algo, error) {
+	switch s {
+	case "":
+		return None, nil
+	case apc.CompressLZ4:
+		return LZ4, nil
+	case apc.CompressZstd:
+		return Zstd, nil
+	default:
+		return None, fmt.Errorf("invalid compression algorithm %q (expecting one of: %v)", s, apc.SupportedCompressAlgos)
+	}
+}
+
+func (a Algo) String() string {
+	switch a {
+	case None:
+		return ""
+	case LZ4:
+		return apc.CompressLZ4
+	case Zstd:
+		return apc.CompressZstd
+	default:
+		return fmt.Sprintf("algo(%d)", a)
+	}
+}
+
+func (a Algo) valid() bool { return a == LZ4 || a == Zstd }
+
+func corrupted(format string, a ...any) error {
+	return fmt.Errorf("%w: %s", ErrCorrupted, fmt.Sprintf(format, a...))
+}
+
+//
+// shared state: zstd encoder and decoder (both safe for concurrent EncodeAll and DecodeAll),
+// lz4 compressors, and frame buffers
+//
+
+var (
+	zonce sync.Once
+	zenc  *zstd.Encoder
+	zdec  *zstd.Decoder
+
+	lz4Pool = sync.Pool{New: func() any { return &lz4.Compressor{} }}
+
+	framePool = sync.Pool{New: func() any {
+		b := make([]byte, FrameSize)
+		return &b
+	}}
+)
+
+func zinit() {
+	var err error
+	zenc, err = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault))
+	cos.AssertNoErr(err)
+	zdec, err = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+	cos.AssertNoErr(err)
+}
+
+func allocFrame() *[]byte { return framePool.Get().(*[]byte) }
+
+func freeFrame(b *[]byte) {
+	if b != nil {
+		framePool.Put(b)
+	}
+}
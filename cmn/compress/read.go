@@ -0,0 +1,218 @@
+// Package compress provides seekable (framed) compression of object and chunk
+// data stored at rest.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package compress
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/pierrec/lz4/v4"
+)
+
+// Reader provides sequential (Read, Seek) and random (ReadAt) access to the
+// logical (decompressed) content; closing the Reader closes the underlying
+// io.ReaderAt if the latter is an io.Closer.
+// (not thread-safe; the most recently decompressed frame is cached)
+type Reader struct {
+	ra    io.ReaderAt
+	offs  []int64  // physical offset of each frame, plus the end of the last one
+	sizes []uint32 // seek table (as stored)
+	frame *[]byte  // cached frame (decompressed)
+	zbuf  *[]byte  // compressed frame
+	size  int64    // logical size
+	fsize int64    // frame size
+	off   int64    // Read/Seek offset
+	cur   int      // index of the cached frame or -1
+	algo  Algo
+}
+
+// interface guard
+var (
+	_ io.ReadSeekCloser = (*Reader)(nil)
+	_ io.ReaderAt       = (*Reader)(nil)
+)
+
+// psize: physical size of the compressed content (e.g., file size)
+func NewReader(ra io.ReaderAt, psize int64) (*Reader, error) {
+	if psize < footerLen {
+		return nil, corrupted("size %d is too small", psize)
+	}
+	var ftr [footerLen]byte
+	if err := readFull(ra, ftr[:], psize-footerLen); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(ftr[footerLen-len(magic):], magic[:]) {
+		return nil, corrupted("bad magic %x", ftr[footerLen-len(magic):])
+	}
+	if ftr[17] != version {
+		return nil, corrupted("unsupported version %d", ftr[17])
+	}
+	r := &Reader{
+		ra:    ra,
+		size:  int64(binary.BigEndian.Uint64(ftr[:])),
+		fsize: int64(binary.BigEndian.Uint32(ftr[8:])),
+		algo:  Algo(ftr[16]),
+		cur:   -1,
+	}
+	num := int64(binary.BigEndian.Uint32(ftr[12:]))
+	switch {
+	case !r.algo.valid():
+		return nil, corrupted("unknown algorithm %d", ftr[16])
+	case r.fsize <= 0 || r.fsize > FrameSize:
+		return nil, corrupted("invalid frame size %d", r.fsize)
+	case r.size < 0 || num != (r.size+r.fsize-1)/r.fsize:
+		return nil, corrupted("invalid number of frames %d (size %d)", num, r.size)
+	case psize < footerLen+num*entryLen:
+		return nil, corrupted("size %d is too small (frames %d)", psize, num)
+	}
+	if r.algo == Zstd {
+		zonce.Do(zinit)
+	}
+
+	// seek table
+	var (
+		tlen  = num * entryLen
+		table = make([]byte, tlen)
+	)
+	if err := readFull(ra, table, psize-footerLen-tlen); err != nil {
+		return nil, err
+	}
+	r.sizes = make([]uint32, num)
+	r.offs = make([]int64, num+1)
+	for i := range r.sizes {
+		l := binary.BigEndian.Uint32(table[i*entryLen:])
+		r.sizes[i] = l
+		plen := int64(l &^ storedBit)
+		if flen := r.flen(i); plen > flen || (l&storedBit != 0 && plen != flen) {
+			return nil, corrupted("invalid frame #%d size %d (expecting <= %d)", i, plen, flen)
+		}
+		r.offs[i+1] = r.offs[i] + plen
+	}
+	if r.offs[num] != psize-footerLen-tlen {
+		return nil, corrupted("frames total %d vs %d", r.offs[num], psize-footerLen-tlen)
+	}
+	return r, nil
+}
+
+func (r *Reader) Algo() Algo  { return r.algo }
+func (r *Reader) Size() int64 { return r.size }
+
+func (r *Reader) Read(p []byte) (n int, err error) {
+	n, err = r.ReadAt(p, r.off)
+	r.off += int64(n)
+	if err == io.EOF && n > 0 {
+		err = nil
+	}
+	return n, err
+}
+
+func (r *Reader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.off
+	case io.SeekEnd:
+		offset += r.size
+	default:
+		return 0, errors.New("compress: invalid whence")
+	}
+	if offset < 0 {
+		return 0, errNegOffset
+	}
+	r.off = offset
+	return offset, nil
+}
+
+// consistent with io.ReaderAt: returns io.EOF when reading less than len(p)
+// (unlike io.ReaderAt, uses and modifies the cached frame)
+func (r *Reader) ReadAt(p []byte, off int64) (n int, err error) {
+	if off < 0 {
+		return 0, errNegOffset
+	}
+	for n < len(p) {
+		if off >= r.size {
+			return n, io.EOF
+		}
+		idx := int(off / r.fsize)
+		if err := r.load(idx); err != nil {
+			return n, err
+		}
+		m := copy(p[n:], (*r.frame)[off-int64(idx)*r.fsize:r.flen(idx)])
+		n += m
+		off += int64(m)
+	}
+	return n, nil
+}
+
+func (r *Reader) Close() (err error) {
+	freeFrame(r.frame)
+	freeFrame(r.zbuf)
+	r.frame, r.zbuf, r.cur = nil, nil, -1
+	if c, ok := r.ra.(io.Closer); ok {
+		err = c.Close()
+	}
+	return err
+}
+
+// logical length of a given frame
+func (r *Reader) flen(idx int) int64 {
+	return min(r.fsize, r.size-int64(idx)*r.fsize)
+}
+
+func (r *Reader) load(idx int) error {
+	if r.cur == idx {
+		return nil
+	}
+	if r.frame == nil {
+		r.frame, r.zbuf = allocFrame(), allocFrame()
+	}
+	r.cur = -1
+
+	var (
+		off   = r.offs[idx]
+		plen  = r.offs[idx+1] - off
+		frame = (*r.frame)[:r.flen(idx)]
+	)
+	if r.sizes[idx]&storedBit != 0 {
+		if err := readFull(r.ra, frame, off); err != nil {
+			return err
+		}
+		r.cur = idx
+		return nil
+	}
+
+	src := (*r.zbuf)[:plen]
+	if err := readFull(r.ra, src, off); err != nil {
+		return err
+	}
+	switch r.algo {
+	case LZ4:
+		n, err := lz4.UncompressBlock(src, frame)
+		if err != nil || n != len(frame) {
+			return corrupted("frame #%d: lz4 (%d, %v)", idx, n, err)
+		}
+	case Zstd:
+		out, err := zdec.DecodeAll(src, frame[:0])
+		if err != nil || len(out) != len(frame) {
+			return corrupted("frame #%d: zstd (%d, %v)", idx, len(out), err)
+		}
+	}
+	r.cur = idx
+	return nil
+}
+
+func readFull(ra io.ReaderAt, b []byte, off int64) error {
+	n, err := ra.ReadAt(b, off)
+	if n == len(b) {
+		return nil
+	}
+	if err == nil || err == io.EOF {
+		err = corrupted("short read (%d < %d) at offset %d", n, len(b), off)
+	}
+	return err
+}
@@ -0,0 +1,150 @@
+// Package compress provides seekable (framed) compression of object and chunk
+// data stored at rest.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package compress
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/NVIDIA/aistore/cmn/debug"
+
+	"github.com/pierrec/lz4/v4"
+)
+
+// Writer compresses everything written into it, one frame at a time;
+// Fini must be called to flush the last frame and write the seek table.
+// (not thread-safe)
+type Writer struct {
+	w     io.Writer
+	err   error
+	buf   *[]byte  // current frame (logical)
+	zbuf  *[]byte  // compressed frame
+	sizes []uint32 // seek table
+	size  int64    // logical size
+	psize int64    // physical size
+	n     int      // buffered in the current frame
+	algo  Algo
+	done  bool
+}
+
+// interface guard
+var _ io.Writer = (*Writer)(nil)
+
+func NewWriter(w io.Writer, algo Algo) *Writer {
+	debug.Assert(algo.valid(), algo)
+	if algo == Zstd {
+		zonce.Do(zinit)
+	}
+	return &Writer{w: w, algo: algo, buf: allocFrame(), zbuf: allocFrame()}
+}
+
+func (zw *Writer) Algo() Algo { return zw.algo }
+
+// logical (uncompressed) size written so far
+func (zw *Writer) Size() int64 { return zw.size }
+
+// physical size: valid upon Fini()
+func (zw *Writer) Psize() int64 { return zw.psize }
+
+func (zw *Writer) Write(p []byte) (n int, err error) {
+	if zw.err != nil {
+		return 0, zw.err
+	}
+	debug.Assert(!zw.done)
+	buf := *zw.buf
+	for len(p) > 0 {
+		m := copy(buf[zw.n:], p)
+		zw.n += m
+		zw.size += int64(m)
+		n += m
+		p = p[m:]
+		if zw.n == FrameSize {
+			if err = zw.flush(); err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// flush the last frame, write seek table and footer; does not close the underlying writer
+// (idempotent)
+func (zw *Writer) Fini() error {
+	if zw.done || zw.err != nil {
+		return zw.err
+	}
+	if zw.n > 0 {
+		if err := zw.flush(); err != nil {
+			return err
+		}
+	}
+	zw.done = true
+	freeFrame(zw.buf)
+	freeFrame(zw.zbuf)
+	zw.buf, zw.zbuf = nil, nil
+
+	var (
+		num   = len(zw.sizes)
+		trail = make([]byte, num*entryLen+footerLen)
+		ftr   = trail[num*entryLen:]
+	)
+	for i, l := range zw.sizes {
+		binary.BigEndian.PutUint32(trail[i*entryLen:], l)
+	}
+	binary.BigEndian.PutUint64(ftr, uint64(zw.size))
+	binary.BigEndian.PutUint32(ftr[8:], FrameSize)
+	binary.BigEndian.PutUint32(ftr[12:], uint32(num))
+	ftr[16] = byte(zw.algo)
+	ftr[17] = version
+	copy(ftr[footerLen-len(magic):], magic[:])
+
+	if _, err := zw.w.Write(trail); err != nil {
+		zw.err = err
+		return err
+	}
+	zw.psize += int64(len(trail))
+	return nil
+}
+
+func (zw *Writer) flush() error {
+	src := (*zw.buf)[:zw.n]
+	zw.n = 0
+
+	out, stored := zw.compress(src)
+	if _, err := zw.w.Write(out); err != nil {
+		zw.err = err
+		return err
+	}
+	l := uint32(len(out))
+	if stored {
+		l |= storedBit
+	}
+	zw.sizes = append(zw.sizes, l)
+	zw.psize += int64(len(out))
+	return nil
+}
+
+// returns src itself when the frame does not compress
+func (zw *Writer) compress(src []byte) ([]byte, bool) {
+	switch zw.algo {
+	case LZ4:
+		// destination smaller than lz4.CompressBlockBound: zero return means incompressible
+		var (
+			dst = (*zw.zbuf)[:len(src)]
+			c   = lz4Pool.Get().(*lz4.Compressor)
+		)
+		n, err := c.CompressBlock(src, dst)
+		lz4Pool.Put(c)
+		if err == nil && n > 0 && n < len(src) {
+			return dst[:n], false
+		}
+	case Zstd:
+		if out := zenc.EncodeAll(src, (*zw.zbuf)[:0]); len(out) < len(src) {
+			return out, false
+		}
+	}
+	return src, true
+}
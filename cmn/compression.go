@@ -0,0 +1,64 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Bucket (at-rest) compression: when enabled, targets transparently compress
+// object data on PUT - and chunk data, for chunked objects - using the
+// configured algorithm.
+//
+// Compressed content is stored as a sequence of independently compressed
+// frames followed by a seek table (see cmn/compress), so that GET, range
+// reads, and archive reads keep working without decompressing the whole
+// object. Objects record both logical and physical (on-disk) size.
+//
+// Changing (or disabling) compression affects only newly written objects;
+// existing objects remain readable as stored.
+
+type (
+	CompressionConf struct {
+		Algo    string      `json:"algo,omitempty"`     // enum { "" (disabled), apc.CompressLZ4, apc.CompressZstd }
+		MinSize cos.SizeIEC `json:"min_size,omitempty"` // do not compress objects (and chunks) smaller than
+	}
+	CompressionConfToSet struct {
+		Algo    *string      `json:"algo,omitempty"`
+		MinSize *cos.SizeIEC `json:"min_size,omitempty"`
+	}
+)
+
+// interface guard
+var _ propsValidator = (*CompressionConf)(nil)
+
+func (c *CompressionConf) IsActive() bool { return c.Algo != "" }
+
+func (c *CompressionConf) ValidateAsProps(...any) error {
+	switch c.Algo {
+	case "", apc.CompressLZ4, apc.CompressZstd:
+	default:
+		return fmt.Errorf("invalid compression.algo %q (expecting one of: %v, or empty to disable)",
+			c.Algo, apc.SupportedCompressAlgos)
+	}
+	if c.MinSize < 0 {
+		return fmt.Errorf("invalid compression.min_size %d (expecting a non-negative integer)", c.MinSize)
+	}
+	return nil
+}
+
+func (c *CompressionConf) String() string {
+	if !c.IsActive() {
+		return confDisabled
+	}
+	if c.MinSize > 0 {
+		return c.Algo + "; min-size: " + c.MinSize.String()
+	}
+	return c.Algo
+}
@@ -136,6 +136,7 @@ type (
 		Version     int64           `json:"config_version,string"`
 		Versioning  VersionConf     `json:"versioning" allow:"cluster"`
 		Resilver    ResilverConf    `json:"resilver"`
+		Webhook     WebhookConf     `json:"webhook" allow:"cluster"` // bucket notification destinations (see cmn/notif.go)
 	}
 	// contains ClusterConfig and LocalConfig
 	ConfigToSet struct {
@@ -172,6 +173,7 @@ type (
 		RateLimit   *RateLimitConfToSet   `json:"rate_limit,omitempty"`
 		Features    *feat.Flags           `json:"features,string,omitempty"`
 		GetBatch    *GetBatchConfToSet    `json:"get_batch,omitempty"`
+		Webhook     *WebhookConfToSet     `json:"webhook,omitempty"`
 
 		// LocalConfig
 		FSP *FSPConf `json:"fspaths,omitempty"`
@@ -267,7 +269,8 @@ type (
 		// duration of the upload.
 		CheckpointEvery int `json:"checkpoint_every,omitempty"`
 
-		// Reserved bitwise field for future advanced behaviors (compression, GC, placement, etc.)
+		// Reserved bitwise field for future advanced behaviors (GC, placement, etc.)
+		// (for compression of chunks and objects, see bucket property `compression` - cmn.CompressionConf)
 		Flags uint64 `json:"flags,omitempty"`
 	}
 
@@ -429,6 +432,15 @@ type (
 		// - SpaceConf.Validate()
 		// - lru.dont_evict_time
 		DontCleanupTime cos.Duration `json:"dont_cleanup_time,omitempty"`
+
+		// Quarantined content older than QuarantineTime gets purged automatically;
+		// zero value _translates_ as a system default 7 days (quarantineTimeDflt)
+		QuarantineTime cos.Duration `json:"quarantine_time,omitempty"`
+
+		// When enabled, space cleanup moves (rather than removes) invalid FQNs, objects
+		// with missing or corrupted metadata, misplaced objects, orphaned EC slices,
+		// and orphaned chunks into per-mountpath quarantine
+		Quarantine bool `json:"quarantine,omitempty"`
 	}
 	SpaceConfToSet struct {
 		CleanupWM       *int64        `json:"cleanupwm,omitempty"`
@@ -437,6 +449,8 @@ type (
 		OOS             *int64        `json:"out_of_space,omitempty"`
 		BatchSize       *int64        `json:"batch_size,omitempty"`
 		DontCleanupTime *cos.Duration `json:"dont_cleanup_time,omitempty"`
+		QuarantineTime  *cos.Duration `json:"quarantine_time,omitempty"`
+		Quarantine      *bool         `json:"quarantine,omitempty"`
 	}
 
 	LRUConf struct {
@@ -453,14 +467,30 @@ type (
 		// - LRUConf.Validate()
 		BatchSize int64 `json:"batch_size,omitempty"`
 
+		// Eviction policy: which objects get evicted first (apc.EvictLRU by default)
+		// See also:
+		// - apc.SupportedEvictPolicy
+		Policy apc.EvictPolicy `json:"policy,omitempty" list:"omitempty"`
+
+		// TTL: time since cold GET (or PUT) after which objects become eligible for eviction;
+		// required with (and only used by) apc.EvictTTL
+		TTL cos.Duration `json:"ttl,omitempty" list:"omitempty"`
+
+		// Priority: when space.highwm is exceeded buckets with higher eviction priority
+		// are drained first; ties are resolved by bucket size (larger first)
+		Priority int `json:"priority,omitempty" list:"omitempty"`
+
 		// Enabled: LRU will only run when set to true
 		Enabled bool `json:"enabled"`
 	}
 	LRUConfToSet struct {
-		DontEvictTime   *cos.Duration `json:"dont_evict_time,omitempty" swaggertype:"primitive,string"`
-		CapacityUpdTime *cos.Duration `json:"capacity_upd_time,omitempty" swaggertype:"primitive,string"`
-		BatchSize       *int64        `json:"batch_size,omitempty"`
-		Enabled         *bool         `json:"enabled,omitempty"`
+		DontEvictTime   *cos.Duration    `json:"dont_evict_time,omitempty" swaggertype:"primitive,string"`
+		CapacityUpdTime *cos.Duration    `json:"capacity_upd_time,omitempty" swaggertype:"primitive,string"`
+		BatchSize       *int64           `json:"batch_size,omitempty"`
+		Policy          *apc.EvictPolicy `json:"policy,omitempty"`
+		TTL             *cos.Duration    `json:"ttl,omitempty" swaggertype:"primitive,string"`
+		Priority        *int             `json:"priority,omitempty"`
+		Enabled         *bool            `json:"enabled,omitempty"`
 	}
 
 	DiskConf struct {
@@ -854,13 +884,24 @@ type (
 	ArchConfToSet struct{ XactConfToSet }
 
 	WritePolicyConf struct {
-		Data apc.WritePolicy `json:"data"`
+		Data apc.WritePolicy `json:"data"` // remote buckets: immediate (write-through) | delayed (write-back)
 		MD   apc.WritePolicy `json:"md"`
 	}
 	WritePolicyConfToSet struct {
-		Data *apc.WritePolicy `json:"data,omitempty" list:"readonly"` // NOTE: NIY
+		Data *apc.WritePolicy `json:"data,omitempty"`
 		MD   *apc.WritePolicy `json:"md,omitempty"`
 	}
+
+	// bucket notifications: allowed and denied webhook hosts - each entry is a host name
+	// (or "*.domain" to match all subdomains), IP address, or CIDR
+	WebhookConf struct {
+		Allow []string `json:"allow"` // empty: any host (that is not denied)
+		Deny  []string `json:"deny"`  // takes precedence
+	}
+	WebhookConfToSet struct {
+		Allow *[]string `json:"allow,omitempty"`
+		Deny  *[]string `json:"deny,omitempty"`
+	}
 )
 
 // global config that can be used to manage:
@@ -1442,6 +1483,8 @@ func (c *DiskConf) Validate() (err error) {
 const (
 	dontCleanupTimeDflt = 2 * time.Hour
 	dontCleanupTimeMin  = time.Hour
+	quarantineTimeDflt  = 7 * 24 * time.Hour
+	quarantineTimeMin   = time.Hour
 )
 
 // common for both SpaceConf and LRUConf
@@ -1467,6 +1510,12 @@ func (c *SpaceConf) Validate() error {
 	} else if n := c.BatchSize; n < GCBatchSizeMin || n > GCBatchSizeMax {
 		return fmt.Errorf("invalid space.batch_size=%d (expecting range [%d - %d])", n, GCBatchSizeMin, GCBatchSizeMax)
 	}
+
+	if c.QuarantineTime == 0 {
+		c.QuarantineTime = cos.Duration(quarantineTimeDflt)
+	} else if c.QuarantineTime.D() < quarantineTimeMin {
+		return fmt.Errorf("invalid space.quarantine_time=%v (expecting >= %v)", c.QuarantineTime, quarantineTimeMin)
+	}
 	return nil
 }
 
@@ -1488,7 +1537,17 @@ func (c *LRUConf) String() string {
 	if !c.Enabled {
 		return confDisabled
 	}
-	return fmt.Sprintf("lru: dont_evict_time=%v, capacity_upd_time=%v, batch_size=%d", c.DontEvictTime, c.CapacityUpdTime, c.BatchSize)
+	s := fmt.Sprintf("lru: dont_evict_time=%v, capacity_upd_time=%v, batch_size=%d", c.DontEvictTime, c.CapacityUpdTime, c.BatchSize)
+	if !c.Policy.IsLRU() {
+		s += fmt.Sprintf(", policy=%s", c.Policy)
+		if c.Policy == apc.EvictTTL {
+			s += fmt.Sprintf(", ttl=%v", c.TTL)
+		}
+	}
+	if c.Priority != 0 {
+		s += fmt.Sprintf(", priority=%d", c.Priority)
+	}
+	return s
 }
 
 func (c *LRUConf) Validate() (err error) {
@@ -1501,7 +1560,16 @@ func (c *LRUConf) Validate() (err error) {
 		return fmt.Errorf("invalid lru.batch_size=%d (expecting range [%d - %d])", n, GCBatchSizeMin, GCBatchSizeMax)
 	}
 	if c.DontEvictTime.D() < dontEvictTimeMin {
-		err = fmt.Errorf("invalid %+v (expecting: lru.dont_evict_time >= %v)", c, dontEvictTimeMin)
+		return fmt.Errorf("invalid %+v (expecting: lru.dont_evict_time >= %v)", c, dontEvictTimeMin)
+	}
+	if err = c.Policy.Validate(); err != nil {
+		return err
+	}
+	switch {
+	case c.Policy == apc.EvictTTL && c.TTL <= 0:
+		err = fmt.Errorf("invalid lru.ttl=%v (expecting positive value with lru.policy=%q)", c.TTL, c.Policy)
+	case c.TTL < 0:
+		err = fmt.Errorf("invalid lru.ttl=%v (expecting non-negative value)", c.TTL)
 	}
 	return
 }
@@ -1770,14 +1838,22 @@ func (c *ChunksConf) String() string {
 func (c *WritePolicyConf) Validate() (err error) {
 	err = c.Data.Validate()
 	if err == nil {
-		if !c.Data.IsImmediate() {
-			return fmt.Errorf("invalid write policy for data: %q not implemented yet", c.Data)
+		if c.Data == apc.WriteNever {
+			return fmt.Errorf("invalid write policy for data: %q is not supported (expecting %q or %q)",
+				c.Data, apc.WriteImmediate, apc.WriteDelayed)
 		}
 		err = c.MD.Validate()
 	}
+	if err == nil && c.Data == apc.WriteDelayed && c.MD == apc.WriteNever {
+		// the "dirty" state must survive restarts
+		err = fmt.Errorf("invalid write policy: data %q requires persistent metadata (md %q)", c.Data, c.MD)
+	}
 	return
 }
 
+// write-back: applies to remote buckets only (see xact/xs/wback.go)
+func (c *WritePolicyConf) IsWriteBack() bool { return c.Data == apc.WriteDelayed }
+
 func (c *WritePolicyConf) ValidateAsProps(...any) error { return c.Validate() }
 
 ///////////////////
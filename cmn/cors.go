@@ -0,0 +1,187 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Bucket CORS (cross-origin resource sharing): an ordered list of rules that
+// determine which cross-origin (browser) requests are permitted.
+//
+// A request matches a rule when:
+// - its origin matches one of the rule's allowed origins;
+// - its method (or, for preflight, Access-Control-Request-Method) is one of the allowed methods;
+// - (preflight only) each of the Access-Control-Request-Headers matches one of the allowed headers.
+// The first matching rule wins.
+//
+// Origins and headers may contain a single '*' wildcard (e.g., "https://*.example.com");
+// header names are case-insensitive.
+// See also: S3 PutBucketCors (ais/s3/cors.go).
+
+const (
+	MaxCORSRules     = 100 // as per S3
+	maxCORSRuleIDLen = 255 // ditto
+
+	corsWildcard = "*"
+)
+
+type (
+	CORSConf struct {
+		Rules []CORSRule `json:"rules,omitempty" list:"readonly"`
+	}
+	CORSConfToSet struct {
+		Rules *[]CORSRule `json:"rules,omitempty"`
+	}
+	CORSRule struct {
+		ID             string   `json:"id,omitempty"`
+		AllowedOrigins []string `json:"allowed_origins"`
+		AllowedMethods []string `json:"allowed_methods"`
+		AllowedHeaders []string `json:"allowed_headers,omitempty"`
+		ExposeHeaders  []string `json:"expose_headers,omitempty"`
+		MaxAge         int      `json:"max_age,omitempty"` // preflight response cache time (seconds)
+	}
+)
+
+// interface guard
+var _ propsValidator = (*CORSConf)(nil)
+
+//////////////
+// CORSConf //
+//////////////
+
+func (c *CORSConf) IsActive() bool { return len(c.Rules) > 0 }
+
+func (c *CORSConf) ValidateAsProps(...any) error {
+	if len(c.Rules) > MaxCORSRules {
+		return fmt.Errorf("invalid CORS: number of rules (%d) exceeds the maximum %d", len(c.Rules), MaxCORSRules)
+	}
+	for i := range c.Rules {
+		if err := c.Rules[i].validate(); err != nil {
+			return fmt.Errorf("invalid CORS rule #%d: %v", i+1, err)
+		}
+	}
+	return nil
+}
+
+func (c *CORSConf) String() string {
+	if !c.IsActive() {
+		return confDisabled
+	}
+	return fmt.Sprintf("%d rule(s)", len(c.Rules))
+}
+
+// returns the first rule that permits a given cross-origin request, or nil
+// (reqHeaders: preflight Access-Control-Request-Headers, if any)
+func (c *CORSConf) Match(origin, method string, reqHeaders []string) *CORSRule {
+	for i := range c.Rules {
+		rule := &c.Rules[i]
+		if rule.matchOrigin(origin) && rule.matchMethod(method) && rule.matchHeaders(reqHeaders) {
+			return rule
+		}
+	}
+	return nil
+}
+
+//////////////
+// CORSRule //
+//////////////
+
+func (rule *CORSRule) validate() error {
+	if len(rule.ID) > maxCORSRuleIDLen {
+		return fmt.Errorf("ID %q is too long (%d > %d)", cos.SHead(rule.ID), len(rule.ID), maxCORSRuleIDLen)
+	}
+	if len(rule.AllowedOrigins) == 0 {
+		return errors.New("expecting at least one allowed origin")
+	}
+	if len(rule.AllowedMethods) == 0 {
+		return errors.New("expecting at least one allowed method")
+	}
+	for _, origin := range rule.AllowedOrigins {
+		if origin == "" || strings.Count(origin, corsWildcard) > 1 {
+			return fmt.Errorf("invalid allowed origin %q (expecting non-empty value with at most one wildcard)", origin)
+		}
+	}
+	for _, method := range rule.AllowedMethods {
+		switch method {
+		case http.MethodGet, http.MethodPut, http.MethodPost, http.MethodDelete, http.MethodHead:
+		default:
+			return fmt.Errorf("invalid allowed method %q (expecting one of: GET, PUT, POST, DELETE, HEAD)", method)
+		}
+	}
+	for _, hdr := range rule.AllowedHeaders {
+		if hdr == "" || strings.Count(hdr, corsWildcard) > 1 {
+			return fmt.Errorf("invalid allowed header %q (expecting non-empty value with at most one wildcard)", hdr)
+		}
+	}
+	for _, hdr := range rule.ExposeHeaders {
+		if hdr == "" || strings.Contains(hdr, corsWildcard) {
+			return fmt.Errorf("invalid expose header %q (wildcards are not permitted)", hdr)
+		}
+	}
+	if rule.MaxAge < 0 {
+		return fmt.Errorf("negative max age %d", rule.MaxAge)
+	}
+	return nil
+}
+
+// true when the rule allows any origin (in which case responses carry "*" rather than the origin)
+func (rule *CORSRule) AnyOrigin() bool {
+	for _, o := range rule.AllowedOrigins {
+		if o == corsWildcard {
+			return true
+		}
+	}
+	return false
+}
+
+func (rule *CORSRule) matchOrigin(origin string) bool {
+	for _, o := range rule.AllowedOrigins {
+		if wildcardMatch(o, origin, false) {
+			return true
+		}
+	}
+	return false
+}
+
+func (rule *CORSRule) matchMethod(method string) bool {
+	for _, m := range rule.AllowedMethods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+func (rule *CORSRule) matchHeaders(reqHeaders []string) bool {
+outer:
+	for _, hdr := range reqHeaders {
+		for _, h := range rule.AllowedHeaders {
+			if wildcardMatch(h, hdr, true) {
+				continue outer
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// pattern with at most one '*' (see validate)
+func wildcardMatch(pattern, s string, fold bool) bool {
+	if fold {
+		pattern, s = strings.ToLower(pattern), strings.ToLower(s)
+	}
+	pre, suf, found := strings.Cut(pattern, corsWildcard)
+	if !found {
+		return pattern == s
+	}
+	return len(s) >= len(pre)+len(suf) && strings.HasPrefix(s, pre) && strings.HasSuffix(s, suf)
+}
@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"hash"
 	"hash/crc32"
+	"hash/crc64"
 	"io"
 	"sort"
 	"strings"
@@ -20,10 +21,11 @@ import (
 	onexxh "github.com/OneOfOne/xxhash"
 	cesxxh "github.com/cespare/xxhash/v2"
 	jsoniter "github.com/json-iterator/go"
+	"lukechampine.com/blake3"
 )
 
 // [NOTE]
-// - currently, we have only two crypto-secure types: sha256 and sha512 (SHA-2 family)
+// - currently, we have three crypto-secure types: sha256 and sha512 (SHA-2 family), and blake3
 // - see related object comparison logic in cmn/objattrs
 // - now that SHA-3 is in the standard library, it can be easily added (as in: ck.H = sha3.New512())
 //   not adding it yet, though, as there's no pressing need
@@ -37,8 +39,14 @@ const (
 	ChecksumCRC32C = "crc32c"
 	ChecksumSHA256 = "sha256" // crypto.SHA512_256 (SHA-2)
 	ChecksumSHA512 = "sha512" // crypto.SHA512 (SHA-2)
+	ChecksumBLAKE3 = "blake3" // BLAKE3, 256-bit digest
+
+	ChecksumCRC64NVME = "crc64nvme" // CRC-64/NVME (aka Rocksoft; S3 `x-amz-checksum-crc64nvme`)
 )
 
+// CRC-64/NVME polynomial (reversed representation)
+const crc64NVME = 0x9a6c9329ac4bc9b5
+
 const LenMD5Hash = 16
 
 const (
@@ -78,8 +86,13 @@ var checksums = StrSet{
 	ChecksumCRC32C: {},
 	ChecksumSHA256: {},
 	ChecksumSHA512: {},
+	ChecksumBLAKE3: {},
+
+	ChecksumCRC64NVME: {},
 }
 
+var crc64NVMETable = crc64.MakeTable(crc64NVME)
+
 var NoneCksum = NewCksum(ChecksumNone, "")
 
 func NoneC(ck *Cksum) bool {
@@ -142,6 +155,10 @@ func (ck *CksumHash) Init(ty string) {
 		ck.H = sha256.New()
 	case ChecksumSHA512:
 		ck.H = sha512.New()
+	case ChecksumBLAKE3:
+		ck.H = blake3.New(32, nil)
+	case ChecksumCRC64NVME:
+		ck.H = NewCRC64NVME()
 	default:
 		AssertMsg(false, "unknown checksum type: "+ty)
 	}
@@ -224,6 +241,16 @@ func (ck *Cksum) Validate() error {
 			return fmt.Errorf("checksum: sha512 must be 128 hex chars, have (%q, %q)", ck.ty, ck.value)
 		}
 		return nil
+	case ChecksumBLAKE3:
+		if !isHexN(ck.value, 64) {
+			return fmt.Errorf("checksum: blake3 must be 64 hex chars, have (%q, %q)", ck.ty, ck.value)
+		}
+		return nil
+	case ChecksumCRC64NVME:
+		if !isHexN(ck.value, 16) {
+			return fmt.Errorf("checksum: crc64nvme must be 16 hex chars, have (%q, %q)", ck.ty, ck.value)
+		}
+		return nil
 	default:
 		return fmt.Errorf("checksum: unsupported type, have (%q, %q)", ck.ty, ck.value)
 	}
@@ -284,6 +311,16 @@ func NewCRC32C() hash.Hash {
 	return crc32.New(crc32.MakeTable(crc32.Castagnoli))
 }
 
+func NewCRC64NVME() hash.Hash { return crc64.New(crc64NVMETable) }
+
+// cryptographically secure (see feat.TrustCryptoSafeChecksums)
+func IsCryptoCksum(ty string) bool {
+	return ty == ChecksumSHA256 || ty == ChecksumSHA512 || ty == ChecksumBLAKE3
+}
+
+// error-detecting codes that can be combined (see CRC32CCombine and CRC64NVMECombine)
+func IsCRC(ty string) bool { return ty == ChecksumCRC32C || ty == ChecksumCRC64NVME }
+
 //
 // CRC32 combine algorithm from zlib (https://github.com/madler/zlib)
 // see zlib's crc32_combine for reference
@@ -351,6 +388,65 @@ func CRC32CCombine(crc1, crc2 uint32, len2 int64) uint32 {
 	return crc1n ^ crc2
 }
 
+// same as above, for 64-bit CRC
+func gf2MatrixTimes64(mat *[64]uint64, vec uint64) (sum uint64) {
+	for i := 0; vec != 0; i++ {
+		if vec&1 != 0 {
+			sum ^= mat[i]
+		}
+		vec >>= 1
+	}
+	return
+}
+
+func gf2MatrixSquare64(square, mat *[64]uint64) {
+	for n := range 64 {
+		square[n] = gf2MatrixTimes64(mat, mat[n])
+	}
+}
+
+// CRC64NVMECombine combines two CRC-64/NVME checksums.
+// Given CRC64(A) and CRC64(B) with len(B), returns CRC64(A || B).
+func CRC64NVMECombine(crc1, crc2 uint64, len2 int64) uint64 {
+	if len2 <= 0 {
+		return crc1
+	}
+
+	var even, odd [64]uint64
+
+	odd[0] = crc64NVME
+	row := uint64(1)
+	for n := 1; n < 64; n++ {
+		odd[n] = row
+		row <<= 1
+	}
+
+	gf2MatrixSquare64(&even, &odd)
+	gf2MatrixSquare64(&odd, &even)
+
+	crc1n := crc1
+	for {
+		gf2MatrixSquare64(&even, &odd)
+		if len2&1 != 0 {
+			crc1n = gf2MatrixTimes64(&even, crc1n)
+		}
+		len2 >>= 1
+		if len2 == 0 {
+			break
+		}
+		gf2MatrixSquare64(&odd, &even)
+		if len2&1 != 0 {
+			crc1n = gf2MatrixTimes64(&odd, crc1n)
+		}
+		len2 >>= 1
+		if len2 == 0 {
+			break
+		}
+	}
+
+	return crc1n ^ crc2
+}
+
 func SupportedChecksums() (types []string) {
 	types = make([]string, 0, len(checksums))
 	for ty := range checksums {
@@ -177,3 +177,64 @@ func TestCRC32CCombineAssociativity(t *testing.T) {
 		}
 	}
 }
+
+func computeCRC64(t *testing.T, data []byte) uint64 {
+	t.Helper()
+	h := cos.NewCksumHash(cos.ChecksumCRC64NVME)
+	h.H.Write(data)
+	h.Finalize()
+	val, err := strconv.ParseUint(h.Value(), 16, 64)
+	if err != nil {
+		t.Fatalf("computeCRC64: failed to parse checksum value %q: %v", h.Value(), err)
+	}
+	return val
+}
+
+// known answers: CRC-64/NVME check value and BLAKE3("abc")
+func TestCksumKnownValues(t *testing.T) {
+	tests := []struct {
+		ty, in, out string
+	}{
+		{cos.ChecksumCRC64NVME, "123456789", "ae8b14860a799888"},
+		{cos.ChecksumBLAKE3, "abc", "6437b3ac38465133ffb63b75273a8db548c558465d79db03fd359c6cd5bd9d85"},
+		{cos.ChecksumBLAKE3, "", "af1349b9f5f9a1a6a0404dea36dcc9499bcb25c9adc112b7cc9a93cae41f3262"},
+	}
+	for _, tc := range tests {
+		if v := cos.ChecksumB2S([]byte(tc.in), tc.ty); v != tc.out {
+			t.Errorf("%s(%q): expected %s, got %s", tc.ty, tc.in, tc.out, v)
+		}
+		if err := cos.NewCksum(tc.ty, tc.out).Validate(); err != nil {
+			t.Error(err)
+		}
+		if err := cos.ValidateCksumType(tc.ty); err != nil {
+			t.Error(err)
+		}
+	}
+	if err := cos.NewCksum(cos.ChecksumCRC64NVME, "ae8b1486").Validate(); err == nil {
+		t.Error("expected invalid crc64nvme length to fail validation")
+	}
+}
+
+func TestCRC64NVMECombine(t *testing.T) {
+	rng := rand.New(rand.NewPCG(7, 11))
+	for range 50 {
+		var (
+			numParts = int(rng.UintN(8)) + 1
+			all      []byte
+			combined uint64
+		)
+		for i := range numParts {
+			part := randomBytes(rng, int(rng.UintN(5000)))
+			all = append(all, part...)
+			crc := computeCRC64(t, part)
+			if i == 0 {
+				combined = crc
+			} else {
+				combined = cos.CRC64NVMECombine(combined, crc, int64(len(part)))
+			}
+		}
+		if expected := computeCRC64(t, all); combined != expected {
+			t.Fatalf("%d parts, %d bytes: expected %016x, got %016x", numParts, len(all), expected, combined)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+// Package encrypt provides authenticated (AES-256-GCM) encryption of object
+// and chunk data stored at rest.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package encrypt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// On-disk layout:
+//
+// | header | segment #1 | segment #2 | ... | segment #N |
+//
+// * header - fixed part (68 bytes) followed by the key ID:
+//   | magic (4) | version (1) | key ID length (1) | reserved (2) | wrapped data key (60) | key ID |
+//   where the data key is a random per-file AES-256 key sealed (AES-GCM) with the
+//   key-encryption key (KEK) that the key ID identifies;
+// * segments - each holding SegSize bytes of the original (plaintext) content
+//   except the last one that may be shorter; each segment is sealed with the data key
+//   and followed by its 16-byte authentication tag;
+// * segment nonce: 4 zero bytes followed by the segment's (big-endian) index;
+//   additional data: a single byte marking the last segment (truncation protection);
+// * empty content is stored as a single empty (tag-only) segment.
+//
+// Logical size is derived from physical size, and reading at any logical offset
+// requires opening a single segment - see Reader.ReadAt.
+
+const (
+	SegSize = 64 * cos.KiB
+	KeySize = 32 // AES-256
+
+	tagLen   = 16
+	nonceLen = 12
+	wrapLen  = nonceLen + KeySize + tagLen
+	fixedLen = 8 + wrapLen
+	sealLen  = SegSize + tagLen
+	version  = 1
+)
+
+var magic = [4]byte{'a', 'i', 's', 'e'}
+
+var (
+	ErrCorrupted = errors.New("corrupted encrypted data")
+	ErrBadKey    = errors.New("failed to unwrap data key (wrong key?)")
+
+	errNegOffset = errors.New("encrypt: negative offset")
+)
+
+// resolves key ID (as stored in the header) to the key-encryption key
+type KeyFunc func(keyID string) ([]byte, error)
+
+func corrupted(format string, a ...any) error {
+	return fmt.Errorf("%w: %s", ErrCorrupted, fmt.Sprintf(format, a...))
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("invalid key length %d (expecting %d)", len(key), KeySize)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// header: generate and wrap data key
+func newHeader(kek []byte, keyID string) (hdr []byte, aead cipher.AEAD, err error) {
+	if keyID == "" || len(keyID) > 255 {
+		return nil, nil, fmt.Errorf("invalid key ID %q", keyID)
+	}
+	kaead, err := newAEAD(kek)
+	if err != nil {
+		return nil, nil, err
+	}
+	var dek [KeySize]byte
+	if _, err := rand.Read(dek[:]); err != nil {
+		return nil, nil, err
+	}
+	hdr = make([]byte, fixedLen, fixedLen+len(keyID))
+	copy(hdr, magic[:])
+	hdr[4] = version
+	hdr[5] = byte(len(keyID))
+	hdr = append(hdr, keyID...)
+
+	nonce := hdr[8 : 8+nonceLen]
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, nil, err
+	}
+	kaead.Seal(hdr[8+nonceLen:8+nonceLen], nonce, dek[:], wrapAD(hdr))
+	aead, err = newAEAD(dek[:])
+	return hdr, aead, err
+}
+
+// header: unwrap data key
+func parseHeader(b []byte, kf KeyFunc) (cipher.AEAD, error) {
+	kek, err := kf(string(b[fixedLen:]))
+	if err != nil {
+		return nil, err
+	}
+	kaead, err := newAEAD(kek)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		dek   [KeySize]byte
+		nonce = b[8 : 8+nonceLen]
+	)
+	if _, err := kaead.Open(dek[:0], nonce, b[8+nonceLen:fixedLen], wrapAD(b)); err != nil {
+		return nil, ErrBadKey
+	}
+	return newAEAD(dek[:])
+}
+
+// wrapped data key is bound to the fixed header fields and the key ID
+func wrapAD(hdr []byte) []byte {
+	ad := make([]byte, 0, 8+len(hdr)-fixedLen)
+	ad = append(ad, hdr[:8]...)
+	return append(ad, hdr[fixedLen:]...)
+}
+
+func segNonce(nonce []byte, idx int) {
+	clear(nonce[:4])
+	binary.BigEndian.PutUint64(nonce[4:], uint64(idx))
+}
+
+// additional data
+var (
+	adLast = []byte{1}
+	adMid  = []byte{0}
+)
+
+// physical size given logical size and key ID
+func Psize(size int64, keyID string) int64 {
+	num := max((size+SegSize-1)/SegSize, 1)
+	return int64(fixedLen+len(keyID)) + size + num*tagLen
+}
+
+//
+// shared state: segment buffers
+//
+
+var segPool = sync.Pool{New: func() any {
+	b := make([]byte, sealLen)
+	return &b
+}}
+
+func allocSeg() *[]byte { return segPool.Get().(*[]byte) }
+
+func freeSeg(b *[]byte) {
+	if b != nil {
+		segPool.Put(b)
+	}
+}
@@ -0,0 +1,178 @@
+// Package encrypt provides authenticated (AES-256-GCM) encryption of object
+// and chunk data stored at rest.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package encrypt
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/env"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Key IDs stored with encrypted content have the form "<provider>:<id>" where
+// the provider is one of the apc.SupportedEncryptProviders or apc.EncryptSSEC
+// (the latter resolved by the caller - see core).
+//
+// The only provider so far is a local keyfile: a JSON map of key IDs to
+// base64-encoded 256-bit keys (see env.AisEncryptionKeyfile), e.g.:
+//
+// {"k1": "x7v0...=", "k2": "Qm9v...="}
+//
+// Keys must never be removed from the keyfile as long as there's content
+// encrypted with them; to rotate, add a new key and point bucket(s) to it.
+
+type KeyProvider interface {
+	Key(id string) ([]byte, error)
+}
+
+var (
+	providers = map[string]KeyProvider{
+		apc.EncryptKeyfile: &keyfile{},
+	}
+
+	ErrNoKeyfile = errors.New("encryption keyfile not configured (see " + env.AisEncryptionKeyfile + ")")
+)
+
+func MakeKeyID(provider, id string) string { return provider + ":" + id }
+
+// S3 SSE-C: customer-provided key is identified by its (base64-encoded) MD5
+func SSECKeyID(key []byte) string { return MakeKeyID(apc.EncryptSSEC, SSECKeyMD5(key)) }
+
+func SSECKeyMD5(key []byte) string {
+	sum := md5.Sum(key)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+func ParseKeyID(keyID string) (provider, id string, err error) {
+	var ok bool
+	if provider, id, ok = strings.Cut(keyID, ":"); !ok || provider == "" || id == "" {
+		return "", "", fmt.Errorf("invalid key ID %q", keyID)
+	}
+	return provider, id, nil
+}
+
+// KEK by key ID (compare with KeyFunc)
+func Key(keyID string) ([]byte, error) {
+	provider, id, err := ParseKeyID(keyID)
+	if err != nil {
+		return nil, err
+	}
+	p, ok := providers[provider]
+	if !ok {
+		return nil, fmt.Errorf("unknown encryption key provider %q (key ID %q)", provider, keyID)
+	}
+	return p.Key(id)
+}
+
+/////////////
+// keyfile //
+/////////////
+
+// re-check (stat) the keyfile at most every so often - to add keys without restarting
+const keyfileRecheck = 5 * time.Second
+
+type keyfile struct {
+	keys    map[string][]byte
+	err     error // (when there are no keys to serve)
+	fqn     string
+	mtime   time.Time
+	size    int64
+	checked atomic.Int64 // mono time
+	mu      sync.RWMutex
+}
+
+// ReloadKeys re-reads the keyfile right away (see also keyfileRecheck)
+func ReloadKeys() error {
+	return providers[apc.EncryptKeyfile].(*keyfile).check(true)
+}
+
+// returns a copy of the cached key
+func (kf *keyfile) Key(id string) ([]byte, error) {
+	if mono.Since(kf.checked.Load()) >= keyfileRecheck {
+		kf.check(false)
+	}
+	kf.mu.RLock()
+	key, ok := kf.keys[id]
+	fqn, err := kf.fqn, kf.err
+	kf.mu.RUnlock()
+	switch {
+	case ok:
+		return bytes.Clone(key), nil
+	case err != nil:
+		return nil, err
+	default:
+		return nil, fmt.Errorf("encryption key %q not found in %s", id, fqn)
+	}
+}
+
+// (re)load upon modification; when the keyfile becomes unreadable or invalid,
+// keep serving the keys loaded previously (keys are never removed - see above)
+func (kf *keyfile) check(force bool) error {
+	kf.mu.Lock()
+	defer kf.mu.Unlock()
+	if !force && mono.Since(kf.checked.Load()) < keyfileRecheck {
+		return kf.err // (checked by another goroutine)
+	}
+	kf.checked.Store(mono.NanoTime())
+
+	fqn := os.Getenv(env.AisEncryptionKeyfile)
+	if fqn == "" {
+		kf.keys, kf.fqn, kf.err = nil, "", ErrNoKeyfile
+		return ErrNoKeyfile
+	}
+	finfo, err := os.Stat(fqn)
+	if err == nil {
+		if !force && fqn == kf.fqn && finfo.ModTime().Equal(kf.mtime) && finfo.Size() == kf.size {
+			return nil // unchanged
+		}
+		var keys map[string][]byte
+		if keys, err = loadKeyfile(fqn); err == nil {
+			kf.keys, kf.fqn, kf.err = keys, fqn, nil
+			kf.mtime, kf.size = finfo.ModTime(), finfo.Size()
+			return nil
+		}
+	} else {
+		err = fmt.Errorf("encryption keyfile: %w", err)
+	}
+	if kf.keys != nil && fqn == kf.fqn {
+		nlog.Errorln(err, "- keeping previously loaded keys")
+		return err
+	}
+	kf.keys, kf.fqn, kf.err = nil, fqn, err
+	return err
+}
+
+func loadKeyfile(fqn string) (map[string][]byte, error) {
+	b, err := os.ReadFile(fqn)
+	if err != nil {
+		return nil, fmt.Errorf("encryption keyfile: %w", err)
+	}
+	var m map[string]string
+	if err := jsoniter.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("encryption keyfile %s: %w", fqn, err)
+	}
+	keys := make(map[string][]byte, len(m))
+	for id, v := range m {
+		key, err := base64.StdEncoding.DecodeString(v)
+		if err != nil || len(key) != KeySize {
+			return nil, fmt.Errorf("encryption keyfile %s: invalid key %q (expecting base64-encoded %d bytes)", fqn, id, KeySize)
+		}
+		keys[id] = key
+	}
+	return keys, nil
+}
@@ -0,0 +1,181 @@
+// Package encrypt provides authenticated (AES-256-GCM) encryption of object
+// and chunk data stored at rest.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package encrypt
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"errors"
+	"io"
+)
+
+// Reader decrypts (and authenticates) content written by Writer;
+// supports sequential reads and random access (io.ReaderAt).
+// (not thread-safe)
+type Reader struct {
+	ra    io.ReaderAt
+	aead  cipher.AEAD
+	seg   *[]byte // cached segment (plaintext)
+	keyID string
+	hlen  int64 // header length
+	size  int64 // logical size
+	off   int64 // Read/Seek offset
+	num   int   // number of segments
+	cur   int   // index of the cached segment or -1
+}
+
+// interface guard
+var _ io.ReaderAt = (*Reader)(nil)
+
+func NewReader(ra io.ReaderAt, psize int64, kf KeyFunc) (*Reader, error) {
+	var fixed [fixedLen]byte
+	if psize < fixedLen+tagLen {
+		return nil, corrupted("invalid size %d", psize)
+	}
+	if err := readFull(ra, fixed[:], 0); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(fixed[:len(magic)], magic[:]) {
+		return nil, corrupted("bad magic")
+	}
+	if fixed[4] != version {
+		return nil, corrupted("unsupported version %d", fixed[4])
+	}
+	var (
+		idlen = int(fixed[5])
+		hlen  = int64(fixedLen + idlen)
+		body  = psize - hlen
+	)
+	if idlen == 0 || body < tagLen {
+		return nil, corrupted("invalid header (%d, %d)", idlen, psize)
+	}
+	hdr := make([]byte, hlen)
+	copy(hdr, fixed[:])
+	if err := readFull(ra, hdr[fixedLen:], fixedLen); err != nil {
+		return nil, err
+	}
+	aead, err := parseHeader(hdr, kf)
+	if err != nil {
+		return nil, err
+	}
+	num := (body + sealLen - 1) / sealLen
+	r := &Reader{
+		ra:    ra,
+		aead:  aead,
+		keyID: string(hdr[fixedLen:]),
+		hlen:  hlen,
+		size:  body - num*tagLen,
+		num:   int(num),
+		cur:   -1,
+	}
+	if body-(num-1)*sealLen < tagLen {
+		return nil, corrupted("invalid size %d (last segment)", psize)
+	}
+	return r, nil
+}
+
+func (r *Reader) KeyID() string { return r.keyID }
+func (r *Reader) Size() int64   { return r.size }
+
+func (r *Reader) Read(p []byte) (n int, err error) {
+	n, err = r.ReadAt(p, r.off)
+	r.off += int64(n)
+	if err == io.EOF && n > 0 {
+		err = nil
+	}
+	return n, err
+}
+
+func (r *Reader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.off
+	case io.SeekEnd:
+		offset += r.size
+	default:
+		return 0, errors.New("encrypt: invalid whence")
+	}
+	if offset < 0 {
+		return 0, errNegOffset
+	}
+	r.off = offset
+	return offset, nil
+}
+
+// consistent with io.ReaderAt: returns io.EOF when reading less than len(p)
+// (unlike io.ReaderAt, uses and modifies the cached segment)
+func (r *Reader) ReadAt(p []byte, off int64) (n int, err error) {
+	if off < 0 {
+		return 0, errNegOffset
+	}
+	for n < len(p) {
+		if off >= r.size {
+			return n, io.EOF
+		}
+		idx := int(off / SegSize)
+		if err := r.load(idx); err != nil {
+			return n, err
+		}
+		m := copy(p[n:], (*r.seg)[off-int64(idx)*SegSize:r.slen(idx)])
+		n += m
+		off += int64(m)
+	}
+	return n, nil
+}
+
+func (r *Reader) Close() (err error) {
+	freeSeg(r.seg)
+	r.seg, r.cur = nil, -1
+	if c, ok := r.ra.(io.Closer); ok {
+		err = c.Close()
+	}
+	return err
+}
+
+// logical length of a given segment
+func (r *Reader) slen(idx int) int64 {
+	return min(SegSize, r.size-int64(idx)*SegSize)
+}
+
+func (r *Reader) load(idx int) error {
+	if r.cur == idx {
+		return nil
+	}
+	if r.seg == nil {
+		r.seg = allocSeg()
+	}
+	r.cur = -1
+
+	var (
+		nonce [nonceLen]byte
+		ad    = adMid
+		src   = (*r.seg)[:r.slen(idx)+tagLen]
+	)
+	if err := readFull(r.ra, src, r.hlen+int64(idx)*sealLen); err != nil {
+		return err
+	}
+	if idx == r.num-1 {
+		ad = adLast
+	}
+	segNonce(nonce[:], idx)
+	if _, err := r.aead.Open(src[:0], nonce[:], src, ad); err != nil {
+		return corrupted("segment #%d: authentication failed", idx)
+	}
+	r.cur = idx
+	return nil
+}
+
+func readFull(ra io.ReaderAt, b []byte, off int64) error {
+	n, err := ra.ReadAt(b, off)
+	if n == len(b) {
+		return nil
+	}
+	if err == nil || err == io.EOF {
+		err = corrupted("short read (%d < %d) at offset %d", n, len(b), off)
+	}
+	return err
+}
@@ -0,0 +1,110 @@
+// Package encrypt provides authenticated (AES-256-GCM) encryption of object
+// and chunk data stored at rest.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package encrypt
+
+import (
+	"crypto/cipher"
+	"io"
+
+	"github.com/NVIDIA/aistore/cmn/debug"
+)
+
+// Writer encrypts everything written into it, one segment at a time;
+// Fini must be called to seal the last segment.
+// (not thread-safe)
+type Writer struct {
+	w     io.Writer
+	aead  cipher.AEAD
+	err   error
+	buf   *[]byte // current segment (plaintext)
+	hdr   []byte  // pending header (written along with the first segment)
+	size  int64   // logical size
+	psize int64   // physical size
+	n     int     // buffered in the current segment
+	idx   int     // current segment index
+	done  bool
+}
+
+// interface guard
+var _ io.Writer = (*Writer)(nil)
+
+func NewWriter(w io.Writer, kek []byte, keyID string) (*Writer, error) {
+	hdr, aead, err := newHeader(kek, keyID)
+	if err != nil {
+		return nil, err
+	}
+	return &Writer{w: w, aead: aead, hdr: hdr, buf: allocSeg()}, nil
+}
+
+// logical (plaintext) size written so far
+func (ew *Writer) Size() int64 { return ew.size }
+
+// physical size: valid upon Fini()
+func (ew *Writer) Psize() int64 { return ew.psize }
+
+func (ew *Writer) Write(p []byte) (n int, err error) {
+	if ew.err != nil {
+		return 0, ew.err
+	}
+	debug.Assert(!ew.done)
+	buf := (*ew.buf)[:SegSize]
+	for len(p) > 0 {
+		// seal the full segment only when there's more to come
+		// (the last one is sealed differently - see Fini)
+		if ew.n == SegSize {
+			if err = ew.seal(false); err != nil {
+				return n, err
+			}
+		}
+		m := copy(buf[ew.n:], p)
+		ew.n += m
+		ew.size += int64(m)
+		n += m
+		p = p[m:]
+	}
+	return n, nil
+}
+
+// seal the last segment; does not close the underlying writer
+// (idempotent)
+func (ew *Writer) Fini() error {
+	if ew.done || ew.err != nil {
+		return ew.err
+	}
+	err := ew.seal(true)
+	ew.done = true
+	freeSeg(ew.buf)
+	ew.buf = nil
+	return err
+}
+
+func (ew *Writer) seal(last bool) error {
+	var (
+		nonce [nonceLen]byte
+		ad    = adMid
+	)
+	if last {
+		ad = adLast
+	}
+	segNonce(nonce[:], ew.idx)
+	out := ew.aead.Seal((*ew.buf)[:0], nonce[:], (*ew.buf)[:ew.n], ad)
+	if ew.hdr != nil {
+		if _, err := ew.w.Write(ew.hdr); err != nil {
+			ew.err = err
+			return err
+		}
+		ew.psize += int64(len(ew.hdr))
+		ew.hdr = nil
+	}
+	if _, err := ew.w.Write(out); err != nil {
+		ew.err = err
+		return err
+	}
+	ew.psize += int64(len(out))
+	ew.n = 0
+	ew.idx++
+	return nil
+}
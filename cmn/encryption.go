@@ -0,0 +1,61 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/aistore/api/apc"
+)
+
+// Bucket (at-rest) encryption: when enabled, targets transparently encrypt
+// object data on PUT - and chunk data, for chunked objects - with AES-256-GCM,
+// using per-file data keys wrapped with the configured key-encryption key
+// (see cmn/encrypt).
+//
+// Encryption is applied after compression (if configured). Encrypted content
+// is moved within the cluster (rebalance, erasure coding, mirroring) as is.
+//
+// Changing (or disabling) encryption affects only newly written objects;
+// existing objects remain readable as long as their keys remain available.
+
+type (
+	EncryptionConf struct {
+		Provider string `json:"provider,omitempty"` // enum { "" (disabled), apc.EncryptKeyfile }
+		KeyID    string `json:"key_id,omitempty"`   // key ID (provider-specific)
+	}
+	EncryptionConfToSet struct {
+		Provider *string `json:"provider,omitempty"`
+		KeyID    *string `json:"key_id,omitempty"`
+	}
+)
+
+// interface guard
+var _ propsValidator = (*EncryptionConf)(nil)
+
+func (c *EncryptionConf) IsActive() bool { return c.Provider != "" }
+
+func (c *EncryptionConf) ValidateAsProps(...any) error {
+	switch c.Provider {
+	case "":
+		return nil
+	case apc.EncryptKeyfile:
+	default:
+		return fmt.Errorf("invalid encryption.provider %q (expecting one of: %v, or empty to disable)",
+			c.Provider, apc.SupportedEncryptProviders)
+	}
+	if c.KeyID == "" {
+		return fmt.Errorf("encryption.key_id is required (provider %q)", c.Provider)
+	}
+	return nil
+}
+
+func (c *EncryptionConf) String() string {
+	if !c.IsActive() {
+		return confDisabled
+	}
+	return c.Provider + "; key: " + c.KeyID
+}
@@ -1295,6 +1295,12 @@ func WriteErr(w http.ResponseWriter, r *http.Request, err error, opts ...int /*[
 			status = http.StatusNotFound
 		case IsErrCapExceeded(err):
 			status = http.StatusInsufficientStorage
+		case IsErrQuotaExceeded(err):
+			var e *ErrQuotaExceeded
+			errors.As(err, &e)
+			status = e.Status()
+		case errors.Is(err, ErrQuotaSizeUnknown):
+			status = http.StatusLengthRequired
 		case IsErrRangeNotSatisfiable(err):
 			status = http.StatusRequestedRangeNotSatisfiable
 		case isErrUnsupp(err), isErrNotImpl(err):
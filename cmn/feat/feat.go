@@ -49,6 +49,7 @@ const (
 	ResumeInterruptedMPU      // resume interrupted multipart uploads from persisted partial manifests
 	KeepUnknownFQN            // do not delete unrecognized/invalid FQNs during space cleanup ('ais space-cleanup')
 	LoadBalanceGET            // when bucket is n-way mirrored read object replica from the least-utilized mountpath
+	AllowETLProcess           // allow ETL process runtime: user-specified commands executed on target hosts (caution: advanced usage only)
 )
 
 var Cluster = [...]string{
@@ -76,6 +77,7 @@ var Cluster = [...]string{
 	"Resume-Interrupted-MPU",
 	"Keep-Unknown-FQN",
 	"Load-Balance-GET",
+	"Allow-ETL-Process-Runtime",
 
 	// apc.ResetToken ("none") ===========
 }
@@ -33,6 +33,9 @@ const (
 	Vmd         = ".ais.vmd"    // vmd persistent file basename
 	Emd         = ".ais.emd"    // emd persistent file basename
 
+	// bucket event notifications: on-disk queue (directory)
+	NotifSpool = ".ais.notif_spool"
+
 	// CLI config
 	CliConfig = "cli.json" // see jsp/app.go
 
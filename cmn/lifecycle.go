@@ -0,0 +1,139 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Bucket lifecycle: a set of rules periodically evaluated by each target
+// against its locally stored objects (see xact/xs/lifecycle.go).
+//
+// Each rule applies to objects whose names start with the rule's prefix
+// (empty prefix: all objects) and may specify one or more actions:
+// - expiration: delete objects last modified more than `expiration` ago;
+// - evict:      evict cached remote objects not accessed for more than `evict`;
+// - abort_mpt:  abort multipart uploads initiated more than `abort_mpt` ago.
+//
+// When multiple rules match the same object, expiration takes precedence over eviction.
+// See also: S3 PutBucketLifecycleConfiguration (ais/s3/lifecycle.go).
+
+const (
+	MaxLifecycleRules = 1000 // as per S3
+	maxLcyRuleIDLen   = 255  // ditto
+
+	minLcyAge = time.Minute
+)
+
+type (
+	LifecycleConf struct {
+		Rules   []LifecycleRule `json:"rules,omitempty" list:"readonly"`
+		Enabled bool            `json:"enabled"`
+	}
+	LifecycleConfToSet struct {
+		Rules   *[]LifecycleRule `json:"rules,omitempty"`
+		Enabled *bool            `json:"enabled,omitempty"`
+	}
+	LifecycleRule struct {
+		ID         string       `json:"id"`
+		Prefix     string       `json:"prefix,omitempty"`
+		Expiration cos.Duration `json:"expiration,omitempty"` // delete when (now - mtime) > expiration
+		Evict      cos.Duration `json:"evict,omitempty"`      // evict (remote) when (now - atime) > evict
+		AbortMpt   cos.Duration `json:"abort_mpt,omitempty"`  // abort incomplete multipart upload when (now - initiated) > abort_mpt
+		Disabled   bool         `json:"disabled,omitempty"`
+	}
+)
+
+// interface guard
+var _ propsValidator = (*LifecycleConf)(nil)
+
+///////////////////
+// LifecycleConf //
+///////////////////
+
+func (c *LifecycleConf) IsActive() bool { return c.Enabled && len(c.Rules) > 0 }
+
+func (c *LifecycleConf) ValidateAsProps(...any) error {
+	if len(c.Rules) > MaxLifecycleRules {
+		return fmt.Errorf("invalid lifecycle: number of rules (%d) exceeds the maximum %d", len(c.Rules), MaxLifecycleRules)
+	}
+	ids := make(cos.StrSet, len(c.Rules))
+	for i := range c.Rules {
+		rule := &c.Rules[i]
+		if err := rule.validate(); err != nil {
+			return err
+		}
+		if ids.Contains(rule.ID) {
+			return fmt.Errorf("invalid lifecycle: duplicate rule ID %q", rule.ID)
+		}
+		ids.Add(rule.ID)
+	}
+	return nil
+}
+
+func (c *LifecycleConf) String() string {
+	if !c.IsActive() {
+		return confDisabled
+	}
+	var n int
+	for i := range c.Rules {
+		if !c.Rules[i].Disabled {
+			n++
+		}
+	}
+	return fmt.Sprintf("%d rule(s), %d enabled", len(c.Rules), n)
+}
+
+// returns the shortest abort-multipart age across enabled rules matching
+// a given object name, or zero if there are none
+func (c *LifecycleConf) AbortMptAge(objName string) (age time.Duration) {
+	for i := range c.Rules {
+		rule := &c.Rules[i]
+		if rule.Disabled || rule.AbortMpt == 0 || !strings.HasPrefix(objName, rule.Prefix) {
+			continue
+		}
+		if age == 0 || rule.AbortMpt.D() < age {
+			age = rule.AbortMpt.D()
+		}
+	}
+	return age
+}
+
+///////////////////
+// LifecycleRule //
+///////////////////
+
+func (rule *LifecycleRule) validate() error {
+	if rule.ID == "" {
+		return errors.New("invalid lifecycle rule: empty ID")
+	}
+	if len(rule.ID) > maxLcyRuleIDLen {
+		return fmt.Errorf("invalid lifecycle rule %q: ID is too long (%d > %d)", cos.SHead(rule.ID), len(rule.ID), maxLcyRuleIDLen)
+	}
+	if rule.Prefix != "" {
+		if err := cos.ValidatePrefix("invalid lifecycle rule prefix", rule.Prefix); err != nil {
+			return err
+		}
+	}
+	if rule.Expiration == 0 && rule.Evict == 0 && rule.AbortMpt == 0 {
+		return fmt.Errorf("invalid lifecycle rule %q: expecting at least one action (expiration, evict, abort_mpt)", rule.ID)
+	}
+	for _, d := range []cos.Duration{rule.Expiration, rule.Evict, rule.AbortMpt} {
+		if d != 0 && d.D() < minLcyAge {
+			return fmt.Errorf("invalid lifecycle rule %q: age %v is either negative or below the minimum %v", rule.ID, d, minLcyAge)
+		}
+	}
+	return nil
+}
+
+func (rule *LifecycleRule) Match(objName string) bool {
+	return !rule.Disabled && strings.HasPrefix(objName, rule.Prefix)
+}
@@ -0,0 +1,249 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Bucket event notifications: an ordered list of rules, each selecting event types
+// and (optionally) object names by prefix and/or suffix, and specifying the HTTP(S)
+// webhook to deliver matching events to.
+//
+// Event names follow S3 ("s3:ObjectCreated:Put", etc.); a name that ends with ":*"
+// selects all events of a given category (e.g., "s3:ObjectCreated:*").
+// Targets deliver events in S3 event-message format - batched, with retries, and
+// at-least-once once spooled (see package bnotif for details). Bucket-level events (soft quota) are delivered
+// by the primary proxy, with an empty object key - rules that filter by prefix or
+// suffix do not match them.
+// Webhook hosts are subject to cluster-wide allow and deny lists (see WebhookConf).
+// See also: S3 PutBucketNotificationConfiguration (ais/s3/notif.go).
+
+const (
+	NotifObjCreated = "s3:ObjectCreated:"
+	NotifObjRemoved = "s3:ObjectRemoved:"
+	NotifObjRecover = "ais:ObjectRecovered:"
+	NotifQuota      = "ais:QuotaExceeded:"
+
+	NotifPut         = NotifObjCreated + "Put"                     // PUT, promote, archive
+	NotifMptComplete = NotifObjCreated + "CompleteMultipartUpload" // S3 and native multipart upload
+	NotifCopy        = NotifObjCreated + "Copy"                    // copy, move, and ETL (transform) output
+	NotifDelete      = NotifObjRemoved + "Delete"                  // delete, rename (old name)
+	NotifECRecover   = NotifObjRecover + "EC"                      // erasure-coded object restored from slices
+	NotifQuotaSoft   = NotifQuota + "Soft"                         // bucket usage crossed soft quota (see QuotaConf)
+
+	MaxNotifRules     = 100
+	maxNotifRuleIDLen = 255
+
+	notifWildcard = "*"
+)
+
+var notifEvents = []string{NotifPut, NotifMptComplete, NotifCopy, NotifDelete, NotifECRecover, NotifQuotaSoft}
+
+type (
+	NotifConf struct {
+		Rules []NotifRule `json:"rules,omitempty" list:"readonly"`
+	}
+	NotifConfToSet struct {
+		Rules *[]NotifRule `json:"rules,omitempty"`
+	}
+	NotifRule struct {
+		ID     string   `json:"id,omitempty"`
+		Events []string `json:"events"`
+		Prefix string   `json:"prefix,omitempty"`
+		Suffix string   `json:"suffix,omitempty"`
+		URL    string   `json:"url"` // webhook
+	}
+)
+
+// interface guard
+var _ propsValidator = (*NotifConf)(nil)
+
+// returns the event name for a given write type (empty: not a notification event)
+func OwtNotifEvent(owt OWT) string {
+	switch owt {
+	case OwtPut, OwtPromote, OwtArchive:
+		return NotifPut
+	case OwtCopy, OwtTransform:
+		return NotifCopy
+	default:
+		return "" // re-chunking, rebalance, and cold GET
+	}
+}
+
+///////////////
+// NotifConf //
+///////////////
+
+func (c *NotifConf) IsActive() bool { return len(c.Rules) > 0 }
+
+func (c *NotifConf) ValidateAsProps(...any) error {
+	if len(c.Rules) > MaxNotifRules {
+		return fmt.Errorf("invalid notification: number of rules (%d) exceeds the maximum %d", len(c.Rules), MaxNotifRules)
+	}
+	for i := range c.Rules {
+		if err := c.Rules[i].validate(); err != nil {
+			return fmt.Errorf("invalid notification rule #%d: %v", i+1, err)
+		}
+	}
+	return nil
+}
+
+func (c *NotifConf) String() string {
+	if !c.IsActive() {
+		return confDisabled
+	}
+	return fmt.Sprintf("%d rule(s)", len(c.Rules))
+}
+
+// returns all rules that match a given event and object name
+func (c *NotifConf) Match(event, objName string) (rules []*NotifRule) {
+	for i := range c.Rules {
+		rule := &c.Rules[i]
+		if rule.matchEvent(event) && strings.HasPrefix(objName, rule.Prefix) && strings.HasSuffix(objName, rule.Suffix) {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+///////////////
+// NotifRule //
+///////////////
+
+func (rule *NotifRule) validate() error {
+	if len(rule.ID) > maxNotifRuleIDLen {
+		return fmt.Errorf("ID %q is too long (%d > %d)", cos.SHead(rule.ID), len(rule.ID), maxNotifRuleIDLen)
+	}
+	if rule.URL == "" {
+		return errors.New("missing webhook URL")
+	}
+	u, err := url.Parse(rule.URL)
+	if err != nil {
+		return fmt.Errorf("invalid webhook URL %q: %v", rule.URL, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid webhook URL %q (expecting http(s)://host[:port]/path)", rule.URL)
+	}
+	if len(rule.Events) == 0 {
+		return errors.New("expecting at least one event")
+	}
+	for _, event := range rule.Events {
+		if !validNotifEvent(event) {
+			return fmt.Errorf("invalid event %q (expecting one of: %s, or category wildcard, e.g. %q)",
+				event, strings.Join(notifEvents, ", "), NotifObjCreated+notifWildcard)
+		}
+	}
+	return nil
+}
+
+func validNotifEvent(event string) bool {
+	for _, ev := range notifEvents {
+		if event == ev {
+			return true
+		}
+	}
+	switch event {
+	case NotifObjCreated + notifWildcard, NotifObjRemoved + notifWildcard, NotifObjRecover + notifWildcard,
+		NotifQuota + notifWildcard:
+		return true
+	}
+	return false
+}
+
+func (rule *NotifRule) matchEvent(event string) bool {
+	for _, ev := range rule.Events {
+		if ev == event {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(ev, notifWildcard); ok && strings.HasPrefix(event, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+/////////////////
+// WebhookConf //
+/////////////////
+
+func (c *WebhookConf) IsActive() bool { return len(c.Allow) > 0 || len(c.Deny) > 0 }
+
+func (c *WebhookConf) Validate() error {
+	for _, list := range [][]string{c.Allow, c.Deny} {
+		for _, e := range list {
+			switch {
+			case e == "" || e == "*.":
+				return fmt.Errorf("invalid webhook host %q", e)
+			case strings.IndexByte(e, '/') >= 0:
+				if _, _, err := net.ParseCIDR(e); err != nil {
+					return fmt.Errorf("invalid webhook host %q: %v", e, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Check webhook host and its IP addresses (nil when not resolved) against
+// the allow and deny lists:
+// - denied if the host or any of its addresses is denied;
+// - otherwise, allowed if the allow list is empty, or the host is allowed, or all its addresses are.
+func (c *WebhookConf) Check(host string, ips []net.IP) error {
+	if ip := net.ParseIP(host); ip != nil {
+		ips = []net.IP{ip}
+	}
+	if matchHost(c.Deny, host, nil) {
+		return fmt.Errorf("webhook host %q is denied (see config webhook.deny)", host)
+	}
+	for _, ip := range ips {
+		if matchHost(c.Deny, "", ip) {
+			return fmt.Errorf("webhook host %q (%s) is denied (see config webhook.deny)", host, ip)
+		}
+	}
+	if len(c.Allow) == 0 || matchHost(c.Allow, host, nil) {
+		return nil
+	}
+	allowed := len(ips) > 0
+	for _, ip := range ips {
+		allowed = allowed && matchHost(c.Allow, "", ip)
+	}
+	if !allowed {
+		return fmt.Errorf("webhook host %q is not allowed (see config webhook.allow)", host)
+	}
+	return nil
+}
+
+// match host name (non-empty) or IP address
+func matchHost(entries []string, host string, ip net.IP) bool {
+	for _, e := range entries {
+		switch {
+		case strings.IndexByte(e, '/') >= 0:
+			if _, ipnet, err := net.ParseCIDR(e); err == nil && ip != nil && ipnet.Contains(ip) {
+				return true
+			}
+		case ip != nil:
+			if eip := net.ParseIP(e); eip != nil && eip.Equal(ip) {
+				return true
+			}
+		case strings.HasPrefix(e, "*."):
+			if len(host) > len(e)-1 && strings.EqualFold(host[len(host)-len(e)+1:], e[1:]) {
+				return true
+			}
+		default:
+			if strings.EqualFold(host, e) {
+				return true
+			}
+		}
+	}
+	return false
+}
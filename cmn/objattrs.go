@@ -31,6 +31,7 @@ const (
 
 	VersionObjMD = "version" // "generation" for GCP, "version" for AWS but only if the bucket is versioned, etc.
 	CRC32CObjMD  = cos.ChecksumCRC32C
+	CRC64ObjMD   = cos.ChecksumCRC64NVME // e.g., S3 `x-amz-checksum-crc64nvme` (hex-encoded)
 	MD5ObjMD     = cos.ChecksumMD5
 	ETag         = cos.HdrETag
 
@@ -42,6 +43,17 @@ const (
 
 	// as the name implies
 	OrigFntl = "orig_fntl"
+
+	// S3 SSE-C: base64-encoded MD5 of the customer-provided key (the key itself is never stored)
+	SSECKeyMD5ObjMD = "sse_c_key_md5"
+
+	// intra-cluster only: compressed and/or encrypted content is being moved as is
+	// (value: core.LOM.RawMD; never stored)
+	RawObjMD = "ais_raw"
+
+	// intra-cluster only: migrating object that is yet to be written back
+	// to its remote backend (see xs.XactWB; never stored)
+	DirtyWBObjMD = "ais_dirty_wb"
 )
 
 const (
@@ -582,11 +594,11 @@ func (oa *ObjAttrs) CheckEq(rem cos.OAH) error {
 			cksumVal = a.Val()
 			switch {
 			case Rom.Features().IsSet(feat.TrustCryptoSafeChecksums):
-				sameCksum = (ty == cos.ChecksumSHA256 || ty == cos.ChecksumSHA512)
+				sameCksum = cos.IsCryptoCksum(ty)
 			default:
 				// NOTE trust non-cryptographic checksums except crc (unless overridden by feature flag)
 				debug.Assert(ty != cos.ChecksumNone)
-				sameCksum = ty != cos.ChecksumCRC32C
+				sameCksum = !cos.IsCRC(ty)
 			}
 			count++
 		}
@@ -616,6 +628,16 @@ func (oa *ObjAttrs) CheckEq(rem cos.OAH) error {
 			}
 		}
 	}
+	if remMeta, ok := rem.GetCustomKey(CRC64ObjMD); ok && remMeta != "" {
+		if locMeta, ok := oa.GetCustomKey(CRC64ObjMD); ok && locMeta != "" {
+			if remMeta != locMeta {
+				return fmt.Errorf("CRC64NVME %s != %s remote", locMeta, remMeta)
+			}
+			if cksumVal != locMeta {
+				count++
+			}
+		}
+	}
 
 	// 4.2. custom MD: MD5 iff count < 2
 	// (ETag ambiguity, see: https://docs.aws.amazon.com/AmazonS3/latest/API/API_Object.htm)
@@ -0,0 +1,297 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/debug"
+)
+
+// Object Lock (WORM: write once, read many) - S3-compatible retention and legal hold.
+//
+// Object lock is a bucket property (Bprops.ObjectLock) that, once enabled, cannot be disabled.
+// Per-object lock state is stored in the object's custom metadata (see ObjLock*MD keys below):
+// - retention: mode (GOVERNANCE | COMPLIANCE) and retain-until date;
+// - legal hold: ON (or absent) - independent of retention and without expiration.
+// Objects written into an object-locked bucket inherit its default retention, if configured,
+// unless the writer specifies retention explicitly.
+//
+// While retention is in effect or legal hold is on, the object cannot be deleted, overwritten,
+// renamed, or evicted, and its bucket cannot be destroyed. Governance-mode retention can be
+// bypassed by (sufficiently privileged) requests that explicitly ask for it; compliance-mode
+// retention and legal hold cannot.
+//
+// See also: S3 object lock APIs (ais/s3/objlock.go).
+
+const (
+	ObjLockGovernance = "GOVERNANCE"
+	ObjLockCompliance = "COMPLIANCE"
+
+	LegalHoldOn  = "ON"
+	LegalHoldOff = "OFF"
+
+	// LOM custom metadata keys (reserved: modifiable only via object lock APIs)
+	ObjLockModeMD      = "lock_mode"
+	ObjLockRetainMD    = "lock_retain_until" // RFC 3339, UTC
+	ObjLockLegalHoldMD = "lock_legal_hold"   // LegalHoldOn (or absent)
+
+	// as per S3
+	maxObjLockDays  = 36500
+	maxObjLockYears = 100
+)
+
+var ObjLockMDKeys = []string{ObjLockModeMD, ObjLockRetainMD, ObjLockLegalHoldMD}
+
+type (
+	ObjectLockConf struct {
+		Enabled bool   `json:"enabled"`
+		Mode    string `json:"mode,omitempty"`  // default retention mode (empty: no default retention)
+		Days    int    `json:"days,omitempty"`  // default retention period in days, or
+		Years   int    `json:"years,omitempty"` // in years
+	}
+	ObjectLockConfToSet struct {
+		Enabled *bool   `json:"enabled,omitempty"`
+		Mode    *string `json:"mode,omitempty"`
+		Days    *int    `json:"days,omitempty"`
+		Years   *int    `json:"years,omitempty"`
+	}
+
+	// per-object retention
+	ObjRetention struct {
+		Until time.Time
+		Mode  string
+	}
+
+	ErrObjLocked struct {
+		cname  string
+		reason string
+	}
+)
+
+// interface guard
+var _ propsValidator = (*ObjectLockConf)(nil)
+
+func ValidObjLockMode(mode string) bool {
+	return mode == ObjLockGovernance || mode == ObjLockCompliance
+}
+
+func IsObjLockMD(key string) bool {
+	return key == ObjLockModeMD || key == ObjLockRetainMD || key == ObjLockLegalHoldMD
+}
+
+////////////////////
+// ObjectLockConf //
+////////////////////
+
+func (c *ObjectLockConf) ValidateAsProps(...any) error {
+	if !c.Enabled {
+		if c.Mode != "" || c.Days != 0 || c.Years != 0 {
+			return errors.New("invalid object_lock: default retention requires object_lock.enabled")
+		}
+		return nil
+	}
+	switch {
+	case c.Days < 0 || c.Days > maxObjLockDays:
+		return fmt.Errorf("invalid object_lock.days %d (expecting 0 to %d)", c.Days, maxObjLockDays)
+	case c.Years < 0 || c.Years > maxObjLockYears:
+		return fmt.Errorf("invalid object_lock.years %d (expecting 0 to %d)", c.Years, maxObjLockYears)
+	case c.Days > 0 && c.Years > 0:
+		return errors.New("invalid object_lock: default retention period is specified in either days or years, not both")
+	case c.Mode == "":
+		if c.Days > 0 || c.Years > 0 {
+			return errors.New("invalid object_lock: default retention period requires mode")
+		}
+		return nil
+	case !ValidObjLockMode(c.Mode):
+		return fmt.Errorf("invalid object_lock.mode %q (expecting %s or %s)", c.Mode, ObjLockGovernance, ObjLockCompliance)
+	case c.Days == 0 && c.Years == 0:
+		return fmt.Errorf("invalid object_lock: default retention mode %q requires retention period (days or years)", c.Mode)
+	}
+	return nil
+}
+
+func (c *ObjectLockConf) String() string {
+	switch {
+	case !c.Enabled:
+		return confDisabled
+	case c.Mode == "":
+		return "enabled (no default retention)"
+	case c.Years > 0:
+		return c.Mode + ", " + strconv.Itoa(c.Years) + "y"
+	default:
+		return c.Mode + ", " + strconv.Itoa(c.Days) + "d"
+	}
+}
+
+// default retention for objects written at a given time, if configured
+func (c *ObjectLockConf) DefaultRetention(now time.Time) (*ObjRetention, bool) {
+	if !c.Enabled || c.Mode == "" {
+		return nil, false
+	}
+	return &ObjRetention{Mode: c.Mode, Until: now.AddDate(c.Years, 0, c.Days).UTC()}, true
+}
+
+// new object (PUT and friends): validate explicitly specified lock metadata
+// and, in its absence, apply default retention
+func (c *ObjectLockConf) Apply(oa *ObjAttrs, now time.Time) error {
+	debug.Assert(c.Enabled)
+	if err := oa.validateObjLock(); err != nil {
+		return err
+	}
+	if _, ok := oa.GetCustomKey(ObjLockModeMD); ok {
+		return nil
+	}
+	if r, ok := c.DefaultRetention(now); ok {
+		oa.SetRetention(r)
+	}
+	return nil
+}
+
+//////////////////
+// ObjRetention //
+//////////////////
+
+func NewObjRetention(mode, until string) (*ObjRetention, error) {
+	if !ValidObjLockMode(mode) {
+		return nil, fmt.Errorf("invalid object retention mode %q (expecting %s or %s)", mode, ObjLockGovernance, ObjLockCompliance)
+	}
+	t, err := time.Parse(time.RFC3339, until)
+	if err != nil {
+		return nil, fmt.Errorf("invalid object retain-until date %q: %v", until, err)
+	}
+	return &ObjRetention{Mode: mode, Until: t.UTC()}, nil
+}
+
+func (r *ObjRetention) Active(now time.Time) bool { return r.Until.After(now) }
+
+/////////////////////////////////
+// ObjAttrs: object lock state //
+/////////////////////////////////
+
+// returns false when there's no retention (or it cannot be parsed)
+func (oa *ObjAttrs) Retention() (*ObjRetention, bool) {
+	mode, ok := oa.GetCustomKey(ObjLockModeMD)
+	if !ok {
+		return nil, false
+	}
+	until, _ := oa.GetCustomKey(ObjLockRetainMD)
+	r, err := NewObjRetention(mode, until)
+	if err != nil {
+		return nil, false
+	}
+	return r, true
+}
+
+// nil removes retention
+func (oa *ObjAttrs) SetRetention(r *ObjRetention) {
+	if r == nil {
+		oa.DelCustomKey(ObjLockModeMD)
+		oa.DelCustomKey(ObjLockRetainMD)
+		return
+	}
+	oa.SetCustomKey(ObjLockModeMD, r.Mode)
+	oa.SetCustomKey(ObjLockRetainMD, r.Until.UTC().Format(time.RFC3339))
+}
+
+func (oa *ObjAttrs) LegalHold() bool {
+	v, ok := oa.GetCustomKey(ObjLockLegalHoldMD)
+	return ok && v == LegalHoldOn
+}
+
+func (oa *ObjAttrs) SetLegalHold(on bool) {
+	if on {
+		oa.SetCustomKey(ObjLockLegalHoldMD, LegalHoldOn)
+	} else {
+		oa.DelCustomKey(ObjLockLegalHoldMD)
+	}
+}
+
+func (oa *ObjAttrs) IsObjLocked(now time.Time) bool { return oa.objLockReason(now, false) != "" }
+
+// returns ErrObjLocked if the object cannot be deleted or modified
+func (oa *ObjAttrs) CheckObjLock(cname string, now time.Time, bypassGovernance bool) error {
+	if reason := oa.objLockReason(now, bypassGovernance); reason != "" {
+		return &ErrObjLocked{cname: cname, reason: reason}
+	}
+	return nil
+}
+
+func (oa *ObjAttrs) objLockReason(now time.Time, bypassGovernance bool) string {
+	if len(oa.CustomMD) == 0 {
+		return ""
+	}
+	if oa.LegalHold() {
+		return "legal hold is on"
+	}
+	mode, ok := oa.GetCustomKey(ObjLockModeMD)
+	if !ok {
+		return ""
+	}
+	r, ok := oa.Retention()
+	if !ok {
+		return "invalid retention " + mode // (unlikely; err on the side of caution)
+	}
+	if !r.Active(now) || (r.Mode == ObjLockGovernance && bypassGovernance) {
+		return ""
+	}
+	return r.Mode + " retention until " + r.Until.Format(time.RFC3339)
+}
+
+// S3 PutObjectRetention semantics:
+//   - extending the retain-until date (same mode, or governance => compliance) is always permitted;
+//   - otherwise, active governance retention requires bypass, while active compliance retention
+//     cannot be shortened, changed, or removed
+//
+// (nr == nil: remove retention)
+func (oa *ObjAttrs) CheckRetentionUpdate(cname string, nr *ObjRetention, now time.Time, bypassGovernance bool) error {
+	cur, ok := oa.Retention()
+	if !ok || !cur.Active(now) {
+		return nil
+	}
+	if nr != nil && !nr.Until.Before(cur.Until) && (nr.Mode == cur.Mode || nr.Mode == ObjLockCompliance) {
+		return nil
+	}
+	if cur.Mode == ObjLockGovernance && bypassGovernance {
+		return nil
+	}
+	return &ErrObjLocked{cname: cname, reason: "cannot shorten, change, or remove " + cur.Mode +
+		" retention until " + cur.Until.Format(time.RFC3339)}
+}
+
+func (oa *ObjAttrs) validateObjLock() error {
+	if v, ok := oa.GetCustomKey(ObjLockLegalHoldMD); ok && v != LegalHoldOn {
+		return fmt.Errorf("invalid legal hold %q (expecting %s or none)", v, LegalHoldOn)
+	}
+	mode, okm := oa.GetCustomKey(ObjLockModeMD)
+	until, oku := oa.GetCustomKey(ObjLockRetainMD)
+	if okm != oku {
+		return errors.New("invalid object retention: expecting both mode and retain-until date")
+	}
+	if okm {
+		_, err := NewObjRetention(mode, until)
+		return err
+	}
+	return nil
+}
+
+//////////////////
+// ErrObjLocked //
+//////////////////
+
+func NewErrObjLocked(cname, reason string) *ErrObjLocked {
+	return &ErrObjLocked{cname: cname, reason: reason}
+}
+
+func (e *ErrObjLocked) Error() string { return e.cname + " is locked (object lock): " + e.reason }
+
+func IsErrObjLocked(err error) bool {
+	var e *ErrObjLocked
+	return errors.As(err, &e)
+}
@@ -0,0 +1,150 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Bucket quotas: hard and soft limits on the bucket's (logical) size and number of objects.
+//
+// Usage is aggregated cluster-wide from bucket summary (present objects only); in between
+// summaries, proxies account for accepted writes incrementally and conservatively
+// (each PUT counts as a new object; deletions are reflected by the next summary).
+// - hard quota: writes that would exceed it fail with ErrQuotaExceeded:
+//   507 (insufficient storage) for size, 403 (forbidden) for number of objects;
+// - soft quota: writes succeed; crossings are logged and counted (see stats),
+//   and delivered as bucket notifications (see NotifQuotaSoft).
+// Zero value of any given limit means "no limit".
+
+const (
+	QuotaSize = "size"
+	QuotaObjs = "objects"
+)
+
+type (
+	QuotaConf struct {
+		SizeHard cos.SizeIEC `json:"size_hard,omitempty"`
+		SizeSoft cos.SizeIEC `json:"size_soft,omitempty"`
+		ObjsHard int64       `json:"objs_hard,omitempty"`
+		ObjsSoft int64       `json:"objs_soft,omitempty"`
+	}
+	QuotaConfToSet struct {
+		SizeHard *cos.SizeIEC `json:"size_hard,omitempty"`
+		SizeSoft *cos.SizeIEC `json:"size_soft,omitempty"`
+		ObjsHard *int64       `json:"objs_hard,omitempty"`
+		ObjsSoft *int64       `json:"objs_soft,omitempty"`
+	}
+
+	ErrQuotaExceeded struct {
+		bck   string
+		what  string // QuotaSize | QuotaObjs
+		used  int64
+		limit int64
+	}
+)
+
+// writing an object of unknown size (e.g., chunked transfer encoding)
+// to a bucket with hard size quota (411)
+var ErrQuotaSizeUnknown = errors.New("content length required to enforce hard size quota")
+
+// interface guard
+var _ propsValidator = (*QuotaConf)(nil)
+
+///////////////
+// QuotaConf //
+///////////////
+
+func (c *QuotaConf) IsActive() bool {
+	return c.SizeHard > 0 || c.SizeSoft > 0 || c.ObjsHard > 0 || c.ObjsSoft > 0
+}
+
+func (c *QuotaConf) ValidateAsProps(...any) error {
+	switch {
+	case c.SizeHard < 0 || c.SizeSoft < 0:
+		return errors.New("invalid quota: size cannot be negative")
+	case c.ObjsHard < 0 || c.ObjsSoft < 0:
+		return errors.New("invalid quota: number of objects cannot be negative")
+	case c.SizeHard > 0 && c.SizeSoft > c.SizeHard:
+		return fmt.Errorf("invalid quota: soft size limit (%s) exceeds hard limit (%s)",
+			cos.IEC(int64(c.SizeSoft), 2), cos.IEC(int64(c.SizeHard), 2))
+	case c.ObjsHard > 0 && c.ObjsSoft > c.ObjsHard:
+		return fmt.Errorf("invalid quota: soft number-of-objects limit (%d) exceeds hard limit (%d)", c.ObjsSoft, c.ObjsHard)
+	}
+	return nil
+}
+
+func (c *QuotaConf) String() string {
+	if !c.IsActive() {
+		return confDisabled
+	}
+	sb := make([]string, 0, 2)
+	if c.SizeHard > 0 || c.SizeSoft > 0 {
+		sb = append(sb, QuotaSize+" "+_quotaLimits(cos.IEC(int64(c.SizeHard), 2), cos.IEC(int64(c.SizeSoft), 2), c.SizeHard > 0, c.SizeSoft > 0))
+	}
+	if c.ObjsHard > 0 || c.ObjsSoft > 0 {
+		sb = append(sb, QuotaObjs+" "+_quotaLimits(strconv.FormatInt(c.ObjsHard, 10), strconv.FormatInt(c.ObjsSoft, 10), c.ObjsHard > 0, c.ObjsSoft > 0))
+	}
+	return strings.Join(sb, ", ")
+}
+
+func _quotaLimits(shard, ssoft string, hard, soft bool) string {
+	switch {
+	case hard && soft:
+		return shard + " (soft " + ssoft + ")"
+	case hard:
+		return shard
+	default:
+		return "soft " + ssoft
+	}
+}
+
+// returns ErrQuotaExceeded if adding `size` bytes and `objs` objects
+// to the current usage would exceed the hard quota
+func (c *QuotaConf) Check(cname string, usedSize, usedObjs, size, objs int64) error {
+	if c.SizeHard > 0 && usedSize+size > int64(c.SizeHard) {
+		return &ErrQuotaExceeded{bck: cname, what: QuotaSize, used: usedSize, limit: int64(c.SizeHard)}
+	}
+	if c.ObjsHard > 0 && usedObjs+objs > c.ObjsHard {
+		return &ErrQuotaExceeded{bck: cname, what: QuotaObjs, used: usedObjs, limit: c.ObjsHard}
+	}
+	return nil
+}
+
+func (c *QuotaConf) SoftExceeded(usedSize, usedObjs int64) bool {
+	return (c.SizeSoft > 0 && usedSize > int64(c.SizeSoft)) || (c.ObjsSoft > 0 && usedObjs > c.ObjsSoft)
+}
+
+//////////////////////
+// ErrQuotaExceeded //
+//////////////////////
+
+func (e *ErrQuotaExceeded) Error() string {
+	if e.what == QuotaSize {
+		return fmt.Sprintf("%s: hard quota exceeded (%s): used %s out of %s", e.bck, e.what,
+			cos.IEC(e.used, 2), cos.IEC(e.limit, 2))
+	}
+	return fmt.Sprintf("%s: hard quota exceeded (%s): used %d out of %d", e.bck, e.what, e.used, e.limit)
+}
+
+// 507 for size, 403 for number of objects
+func (e *ErrQuotaExceeded) Status() int {
+	if e.what == QuotaSize {
+		return http.StatusInsufficientStorage
+	}
+	return http.StatusForbidden
+}
+
+func IsErrQuotaExceeded(err error) bool {
+	var e *ErrQuotaExceeded
+	return errors.As(err, &e)
+}
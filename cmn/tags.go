@@ -0,0 +1,226 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Object and bucket tags: S3-compatible sets of key-value pairs.
+//
+// Object tags are stored in the object's custom metadata under TagsObjMD,
+// URL-encoded (the same "k1=v1&k2=v2" format S3 uses in the `x-amz-tagging` header);
+// bucket tags are part of bucket properties (Bprops.Tags).
+//
+// Limits and validation follow S3:
+// - at most 10 tags per object and 50 per bucket;
+// - keys are unique, 1 to 128 characters; values 0 to 256 characters;
+// - allowed characters: letters, digits, spaces, and `+ - = . _ : / @`;
+// - the "aws:" prefix is reserved.
+// In addition, the encoded object tag set must not exceed 2KiB (stored in LOM metadata).
+//
+// Multi-object operations select tagged objects via apc.ListRange.Tags (see MatchTags).
+// See also: S3 Get/Put/DeleteObjectTagging (ais/s3/tagging.go).
+
+const (
+	TagsObjMD = "tags" // LOM custom metadata key
+
+	MaxObjTags     = 10
+	MaxBckTags     = 50
+	MaxTagKeyLen   = 128
+	MaxTagValueLen = 256
+
+	maxSizeObjTags = 2 * cos.KiB // encoded
+	tagsReserved   = "aws:"
+)
+
+type (
+	Tag struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	}
+	Tags []Tag
+)
+
+// interface guard
+var _ propsValidator = (*Tags)(nil)
+
+// parse URL-encoded tag set (as in `x-amz-tagging` header and TagsObjMD)
+func ParseTags(s string) (Tags, error) {
+	if s == "" {
+		return nil, nil
+	}
+	q, err := url.ParseQuery(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tag set %q: %v", cos.SHead(s), err)
+	}
+	tags := make(Tags, 0, len(q))
+	for k, vals := range q {
+		if len(vals) > 1 {
+			return nil, fmt.Errorf("invalid tag set: duplicate key %q", k)
+		}
+		tags = append(tags, Tag{Key: k, Value: vals[0]})
+	}
+	tags.sort()
+	return tags, nil
+}
+
+// the number of tags in a URL-encoded tag set (without parsing)
+func NumTags(s string) int {
+	if s == "" {
+		return 0
+	}
+	return strings.Count(s, "&") + 1
+}
+
+func (tags Tags) sort() {
+	sort.Slice(tags, func(i, j int) bool { return tags[i].Key < tags[j].Key })
+}
+
+// URL-encoded and sorted by key
+func (tags Tags) Encode() string {
+	if len(tags) == 0 {
+		return ""
+	}
+	q := make(url.Values, len(tags))
+	for _, tag := range tags {
+		q.Set(tag.Key, tag.Value)
+	}
+	return q.Encode()
+}
+
+func (tags Tags) Get(key string) (string, bool) {
+	for _, tag := range tags {
+		if tag.Key == key {
+			return tag.Value, true
+		}
+	}
+	return "", false
+}
+
+// validate object tag set
+func (tags Tags) ValidateObj() error {
+	if err := tags.validate(MaxObjTags); err != nil {
+		return err
+	}
+	if l := len(tags.Encode()); l > maxSizeObjTags {
+		return fmt.Errorf("invalid tag set: encoded size %d exceeds the maximum %d bytes", l, maxSizeObjTags)
+	}
+	return nil
+}
+
+// validate bucket tag set
+func (tags *Tags) ValidateAsProps(...any) error { return tags.validate(MaxBckTags) }
+
+func (tags Tags) validate(maxn int) error {
+	if len(tags) > maxn {
+		return fmt.Errorf("invalid tag set: number of tags (%d) exceeds the maximum %d", len(tags), maxn)
+	}
+	keys := make(cos.StrSet, len(tags))
+	for i := range tags {
+		tag := &tags[i]
+		if err := tag.validate(); err != nil {
+			return err
+		}
+		if keys.Contains(tag.Key) {
+			return fmt.Errorf("invalid tag set: duplicate key %q", tag.Key)
+		}
+		keys.Set(tag.Key)
+	}
+	return nil
+}
+
+func (tags Tags) String() string {
+	if len(tags) == 0 {
+		return confDisabled
+	}
+	s := make([]string, len(tags))
+	for i, tag := range tags {
+		s[i] = tag.Key + "=" + tag.Value
+	}
+	return strings.Join(s, ", ")
+}
+
+/////////
+// Tag //
+/////////
+
+func (tag *Tag) validate() error {
+	if tag.Key == "" {
+		return errors.New("invalid tag: empty key")
+	}
+	if l := utf8.RuneCountInString(tag.Key); l > MaxTagKeyLen {
+		return fmt.Errorf("invalid tag key %q: length %d exceeds the maximum %d", cos.SHead(tag.Key), l, MaxTagKeyLen)
+	}
+	if l := utf8.RuneCountInString(tag.Value); l > MaxTagValueLen {
+		return fmt.Errorf("invalid tag value %q: length %d exceeds the maximum %d", cos.SHead(tag.Value), l, MaxTagValueLen)
+	}
+	if strings.HasPrefix(strings.ToLower(tag.Key), tagsReserved) {
+		return fmt.Errorf("invalid tag key %q: prefix %q is reserved", tag.Key, tagsReserved)
+	}
+	if !validTagChars(tag.Key) {
+		return fmt.Errorf("invalid tag key %q: contains characters other than letters, digits, spaces, and '+-=._:/@'", tag.Key)
+	}
+	if !validTagChars(tag.Value) {
+		return fmt.Errorf("invalid tag value %q: contains characters other than letters, digits, spaces, and '+-=._:/@'", tag.Value)
+	}
+	return nil
+}
+
+func validTagChars(s string) bool {
+	for _, c := range s {
+		switch {
+		case unicode.IsLetter(c), unicode.IsDigit(c), unicode.IsSpace(c):
+		case strings.ContainsRune("+-=._:/@", c):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+//
+// selection by tags (multi-object operations)
+//
+
+// each selector is either "key=value" (exact match) or "key" (any value)
+func ValidateTagSelectors(sels []string) error {
+	for _, sel := range sels {
+		k, v, _ := strings.Cut(sel, "=")
+		tag := Tag{Key: k, Value: v}
+		if err := tag.validate(); err != nil {
+			return fmt.Errorf("invalid tag selector %q: %v", sel, err)
+		}
+	}
+	return nil
+}
+
+// true if a given URL-encoded tag set (TagsObjMD) matches all selectors
+func MatchTags(encoded string, sels []string) bool {
+	if encoded == "" {
+		return len(sels) == 0
+	}
+	tags, err := ParseTags(encoded)
+	if err != nil {
+		return false
+	}
+	for _, sel := range sels {
+		k, v, hasValue := strings.Cut(sel, "=")
+		val, ok := tags.Get(k)
+		if !ok || (hasValue && val != v) {
+			return false
+		}
+	}
+	return true
+}
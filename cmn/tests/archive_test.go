@@ -0,0 +1,209 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests_test
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// write, list, read (one and all), detect by magic, and append - all supported formats
+func TestArchiveFormats(t *testing.T) {
+	const numFiles = 10
+	for _, mime := range archive.FileExtensions {
+		t.Run(mime, func(t *testing.T) {
+			var (
+				dir     = t.TempDir()
+				fqn     = filepath.Join(dir, "shard"+mime)
+				content = make(map[string][]byte, numFiles+1)
+			)
+			for i := range numFiles {
+				content["dir/file-"+strconv.Itoa(i)+".txt"] = bytes.Repeat([]byte{byte('a' + i)}, 1000+i*100)
+			}
+			writeArch(t, fqn, mime, content, nil)
+
+			// list
+			lst, err := archive.List(fqn)
+			tassert.CheckFatal(t, err)
+			tassert.Fatalf(t, len(lst) == numFiles, "%s: expected %d entries, got %d", mime, numFiles, len(lst))
+			for _, e := range lst {
+				tassert.Errorf(t, e.Size == int64(len(content[e.Name])), "%s: %s size %d", mime, e.Name, e.Size)
+			}
+
+			// detect by magic (no extension)
+			noext := filepath.Join(dir, "noext")
+			tassert.CheckFatal(t, os.Link(fqn, noext))
+			detected, err := archive.MimeFQN(memsys.ByteMM(), "", noext)
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, archive.EqExt(detected, mime) || (mime == archive.ExtTgz && detected == archive.ExtTarGz),
+				"%s: detected %q", mime, detected)
+
+			// read one
+			name := "dir/file-7.txt"
+			readOne(t, fqn, mime, name, content[name])
+
+			// read all
+			fh, err := os.Open(fqn)
+			tassert.CheckFatal(t, err)
+			ar, err := archive.NewReader(mime, fh, fsize(t, fqn))
+			tassert.CheckFatal(t, err)
+			drain := &archive.Drain{}
+			tassert.CheckFatal(t, ar.ReadUntil(drain, "", ""))
+			cos.Close(fh)
+			_, num := drain.Totals()
+			tassert.Errorf(t, num == numFiles, "%s: read %d files, expected %d", mime, num, numFiles)
+
+			// append: copy existing and add one more
+			var (
+				appended = filepath.Join(dir, "appended"+mime)
+				extra    = map[string][]byte{"extra.bin": []byte("extra")}
+			)
+			writeArch(t, appended, mime, extra, &fqn)
+			lst, err = archive.List(appended)
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, len(lst) == numFiles+1, "%s: expected %d entries after append, got %d", mime, numFiles+1, len(lst))
+			readOne(t, appended, mime, "extra.bin", extra["extra.bin"])
+			readOne(t, appended, mime, name, content[name])
+		})
+	}
+}
+
+// index TAR and read archived files directly (compare with readOne)
+func TestArchiveTarIndex(t *testing.T) {
+	const numFiles = 20
+	var (
+		dir     = t.TempDir()
+		fqn     = filepath.Join(dir, "shard.tar")
+		content = make(map[string][]byte, numFiles+1)
+	)
+	for i := range numFiles {
+		content["dir/file-"+strconv.Itoa(i)+".bin"] = bytes.Repeat([]byte{byte('a' + i)}, 100+i*1000)
+	}
+	writeArch(t, fqn, archive.ExtTar, content, nil)
+
+	// append one more (index must include both copied and added)
+	var (
+		appended = filepath.Join(dir, "appended.tar")
+		extra    = map[string][]byte{"extra.bin": []byte("extra")}
+	)
+	writeArch(t, appended, archive.ExtTar, extra, &fqn)
+	content["extra.bin"] = extra["extra.bin"]
+
+	fh, err := os.Open(appended)
+	tassert.CheckFatal(t, err)
+	defer cos.Close(fh)
+	tidx, err := archive.IndexTar(fh, fsize(t, appended))
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, tidx.Len() == len(content), "expected %d indexed files, got %d", len(content), tidx.Len())
+
+	for name, expected := range content {
+		for _, archpath := range []string{name, "/" + name} {
+			r := tidx.ReadOne(fh, archpath)
+			tassert.Fatalf(t, r != nil, "%q not found", archpath)
+			tassert.Errorf(t, r.Size() == int64(len(expected)), "%q: size %d, expected %d", archpath, r.Size(), len(expected))
+			b, err := io.ReadAll(r)
+			tassert.CheckFatal(t, err)
+			tassert.CheckFatal(t, r.Close())
+			tassert.Errorf(t, bytes.Equal(b, expected), "%q: content mismatch", archpath)
+		}
+	}
+	tassert.Errorf(t, tidx.ReadOne(fh, "dir/nonexistent") == nil, "expecting not found")
+
+	// listing: same as reading the archive
+	lst, err := archive.List(appended)
+	tassert.CheckFatal(t, err)
+	ilst := tidx.List()
+	tassert.Fatalf(t, len(ilst) == len(lst), "list: expected %d entries, got %d", len(lst), len(ilst))
+	for i := range lst {
+		tassert.Errorf(t, *ilst[i] == *lst[i], "list: %+v vs %+v", *ilst[i], *lst[i])
+	}
+}
+
+func TestArchiveTarIndexPack(t *testing.T) {
+	var (
+		fqn     = filepath.Join(t.TempDir(), "shard.tar")
+		content = map[string][]byte{"a.txt": []byte("aaa"), "b/c.txt": bytes.Repeat([]byte("c"), 1000)}
+	)
+	writeArch(t, fqn, archive.ExtTar, content, nil)
+	fh, err := os.Open(fqn)
+	tassert.CheckFatal(t, err)
+	defer cos.Close(fh)
+	tidx, err := archive.IndexTar(fh, fsize(t, fqn))
+	tassert.CheckFatal(t, err)
+	tidx.Mtime = time.Now().UnixNano()
+
+	b := tidx.Pack()
+	tassert.Fatalf(t, len(b) == tidx.PackedSize(), "packed size %d vs %d", len(b), tidx.PackedSize())
+	uidx, err := archive.UnpackTarIndex(b)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, uidx.Size == tidx.Size && uidx.Mtime == tidx.Mtime, "size/mtime mismatch")
+	tassert.Fatalf(t, uidx.Len() == tidx.Len(), "expected %d entries, got %d", tidx.Len(), uidx.Len())
+	for i := range tidx.Entries {
+		tassert.Errorf(t, uidx.Entries[i] == tidx.Entries[i], "%+v vs %+v", uidx.Entries[i], tidx.Entries[i])
+	}
+	for name, expected := range content {
+		r := uidx.ReadOne(fh, name)
+		tassert.Fatalf(t, r != nil, "%q not found", name)
+		got, err := io.ReadAll(r)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, bytes.Equal(got, expected), "%q: content mismatch", name)
+	}
+
+	// corrupted
+	b[len(b)/2] ^= 0xff
+	_, err = archive.UnpackTarIndex(b)
+	tassert.Errorf(t, err != nil, "expecting checksum error")
+	_, err = archive.UnpackTarIndex(b[:4])
+	tassert.Errorf(t, err != nil, "expecting error (too short)")
+}
+
+func writeArch(t *testing.T, fqn, mime string, content map[string][]byte, src *string) {
+	fh, err := os.Create(fqn)
+	tassert.CheckFatal(t, err)
+	aw := archive.NewWriter(mime, fh, nil, nil)
+	if src != nil {
+		sfh, err := os.Open(*src)
+		tassert.CheckFatal(t, err)
+		tassert.CheckFatal(t, aw.Copy(sfh, fsize(t, *src)))
+		cos.Close(sfh)
+	}
+	for name, b := range content {
+		oah := &cos.SimpleOAH{Size: int64(len(b)), Atime: time.Now().UnixNano()}
+		tassert.CheckFatal(t, aw.Write(name, oah, bytes.NewReader(b)))
+	}
+	tassert.CheckFatal(t, aw.Fini())
+	tassert.CheckFatal(t, fh.Close())
+}
+
+func readOne(t *testing.T, fqn, mime, name string, expected []byte) {
+	fh, err := os.Open(fqn)
+	tassert.CheckFatal(t, err)
+	defer cos.Close(fh)
+	ar, err := archive.NewReader(mime, fh, fsize(t, fqn))
+	tassert.CheckFatal(t, err)
+	r, err := ar.ReadOne(name)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, r != nil, "%s: %q not found", mime, name)
+	b, err := io.ReadAll(r)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, r.Close())
+	tassert.Errorf(t, bytes.Equal(b, expected), "%s: %q content mismatch (%d vs %d bytes)", mime, name, len(b), len(expected))
+}
+
+func fsize(t *testing.T, fqn string) int64 {
+	finfo, err := os.Stat(fqn)
+	tassert.CheckFatal(t, err)
+	return finfo.Size()
+}
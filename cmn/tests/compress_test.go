@@ -0,0 +1,115 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/compress"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestCompressRoundTrip(t *testing.T) {
+	sizes := []int{0, 1, 1000, compress.FrameSize - 1, compress.FrameSize, 3*compress.FrameSize + 17}
+	for _, algo := range []compress.Algo{compress.LZ4, compress.Zstd} {
+		for _, compressible := range []bool{true, false} {
+			for _, size := range sizes {
+				data := genCompressData(size, compressible)
+				zdata := zcompress(t, algo, data)
+				if compressible && size >= compress.FrameSize {
+					tassert.Errorf(t, len(zdata) < size, "%s: expecting compression (%d vs %d)", algo, len(zdata), size)
+				}
+				zr, err := compress.NewReader(bytes.NewReader(zdata), int64(len(zdata)))
+				tassert.CheckFatal(t, err)
+				tassert.Errorf(t, zr.Size() == int64(size) && zr.Algo() == algo, "%s: size %d vs %d", algo, zr.Size(), size)
+
+				// sequential
+				out, err := io.ReadAll(zr)
+				tassert.CheckFatal(t, err)
+				tassert.Fatalf(t, bytes.Equal(out, data), "%s(%d, %t): content mismatch", algo, size, compressible)
+
+				if size < 2 {
+					continue
+				}
+				// random access
+				for range 8 {
+					off := rand.IntN(size)
+					n := rand.IntN(size-off) + 1
+					b := make([]byte, n)
+					m, err := zr.ReadAt(b, int64(off))
+					tassert.CheckFatal(t, err)
+					tassert.Fatalf(t, m == n && bytes.Equal(b, data[off:off+n]), "%s: read-at(%d, %d) mismatch", algo, off, n)
+				}
+				// seek + read
+				off := int64(size / 2)
+				pos, err := zr.Seek(off, io.SeekStart)
+				tassert.CheckFatal(t, err)
+				tassert.Errorf(t, pos == off, "seek: %d vs %d", pos, off)
+				out, err = io.ReadAll(zr)
+				tassert.CheckFatal(t, err)
+				tassert.Errorf(t, bytes.Equal(out, data[off:]), "%s: seek-read mismatch", algo)
+				tassert.CheckError(t, zr.Close())
+			}
+		}
+	}
+}
+
+func TestCompressCorrupted(t *testing.T) {
+	data := genCompressData(2*compress.FrameSize+100, true)
+	zdata := zcompress(t, compress.Zstd, data)
+
+	// truncated
+	_, err := compress.NewReader(bytes.NewReader(zdata[:len(zdata)-1]), int64(len(zdata)-1))
+	tassert.Errorf(t, errors.Is(err, compress.ErrCorrupted), "truncated: expecting corrupted, got %v", err)
+
+	// not compressed at all
+	_, err = compress.NewReader(bytes.NewReader(data), int64(len(data)))
+	tassert.Errorf(t, errors.Is(err, compress.ErrCorrupted), "raw: expecting corrupted, got %v", err)
+
+	// damaged frame
+	bad := bytes.Clone(zdata)
+	bad[10] ^= 0xff
+	zr, err := compress.NewReader(bytes.NewReader(bad), int64(len(bad)))
+	tassert.CheckFatal(t, err)
+	out, err := io.ReadAll(zr)
+	tassert.Errorf(t, err != nil || !bytes.Equal(out, data), "damaged frame went undetected")
+}
+
+func zcompress(t *testing.T, algo compress.Algo, data []byte) []byte {
+	var (
+		buf bytes.Buffer
+		zw  = compress.NewWriter(&buf, algo)
+	)
+	// write in odd-sized pieces
+	for b := data; len(b) > 0; {
+		n := min(len(b), 7777)
+		_, err := zw.Write(b[:n])
+		tassert.CheckFatal(t, err)
+		b = b[n:]
+	}
+	tassert.CheckFatal(t, zw.Fini())
+	tassert.Errorf(t, zw.Size() == int64(len(data)), "size %d vs %d", zw.Size(), len(data))
+	tassert.Errorf(t, zw.Psize() == int64(buf.Len()), "psize %d vs %d", zw.Psize(), buf.Len())
+	return buf.Bytes()
+}
+
+func genCompressData(size int, compressible bool) []byte {
+	b := make([]byte, size)
+	if !compressible {
+		for i := range b {
+			b[i] = byte(rand.Uint32())
+		}
+		return b
+	}
+	const words = "the quick brown fox jumps over the lazy dog "
+	for i := range b {
+		b[i] = words[(i+i/97)%len(words)]
+	}
+	return b
+}
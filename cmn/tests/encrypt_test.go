@@ -0,0 +1,204 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests_test
+
+import (
+	"bytes"
+	cryptorand "crypto/rand"
+	"encoding/base64"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/env"
+	"github.com/NVIDIA/aistore/cmn/encrypt"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+const testKeyID = "keyfile:k1"
+
+func genKey(t *testing.T) []byte {
+	key := make([]byte, encrypt.KeySize)
+	_, err := cryptorand.Read(key)
+	tassert.CheckFatal(t, err)
+	return key
+}
+
+func keyFunc(kek []byte) encrypt.KeyFunc {
+	return func(keyID string) ([]byte, error) {
+		if keyID != testKeyID {
+			return nil, errors.New("unknown key " + keyID)
+		}
+		return kek, nil
+	}
+}
+
+func seal(t *testing.T, kek, data []byte) []byte {
+	var (
+		buf bytes.Buffer
+		ew  *encrypt.Writer
+		err error
+	)
+	ew, err = encrypt.NewWriter(&buf, kek, testKeyID)
+	tassert.CheckFatal(t, err)
+	// write in random-size pieces
+	for b := data; len(b) > 0; {
+		n := min(rand.IntN(3*encrypt.SegSize/2)+1, len(b))
+		_, err := ew.Write(b[:n])
+		tassert.CheckFatal(t, err)
+		b = b[n:]
+	}
+	tassert.CheckFatal(t, ew.Fini())
+	tassert.CheckFatal(t, ew.Fini()) // idempotent
+	tassert.Errorf(t, ew.Size() == int64(len(data)), "size %d vs %d", ew.Size(), len(data))
+	tassert.Errorf(t, ew.Psize() == int64(buf.Len()), "psize %d vs %d", ew.Psize(), buf.Len())
+	tassert.Errorf(t, encrypt.Psize(int64(len(data)), testKeyID) == int64(buf.Len()),
+		"computed psize %d vs %d", encrypt.Psize(int64(len(data)), testKeyID), buf.Len())
+	return buf.Bytes()
+}
+
+func TestEncryptRoundTrip(t *testing.T) {
+	kek := genKey(t)
+	sizes := []int{0, 1, 1000, encrypt.SegSize - 1, encrypt.SegSize, encrypt.SegSize + 1, 3*encrypt.SegSize + 17}
+	for _, size := range sizes {
+		data := make([]byte, size)
+		_, _ = cryptorand.Read(data)
+		edata := seal(t, kek, data)
+		if size > 16 {
+			tassert.Errorf(t, !bytes.Contains(edata, data[:16]), "size %d: plaintext in ciphertext", size)
+		}
+		er, err := encrypt.NewReader(bytes.NewReader(edata), int64(len(edata)), keyFunc(kek))
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, er.Size() == int64(size) && er.KeyID() == testKeyID, "size %d vs %d", er.Size(), size)
+
+		// sequential
+		out, err := io.ReadAll(er)
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, bytes.Equal(out, data), "size %d: content mismatch", size)
+
+		if size < 2 {
+			continue
+		}
+		// random access
+		for range 8 {
+			off := rand.IntN(size)
+			n := rand.IntN(size-off) + 1
+			b := make([]byte, n)
+			m, err := er.ReadAt(b, int64(off))
+			tassert.CheckFatal(t, err)
+			tassert.Fatalf(t, m == n && bytes.Equal(b, data[off:off+n]), "read-at(%d, %d) mismatch", off, n)
+		}
+		// seek + read
+		off := int64(size / 2)
+		pos, err := er.Seek(off, io.SeekStart)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, pos == off, "seek: %d vs %d", pos, off)
+		out, err = io.ReadAll(er)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, bytes.Equal(out, data[off:]), "size %d: seek-read mismatch", size)
+		tassert.CheckError(t, er.Close())
+	}
+}
+
+func TestEncryptTamper(t *testing.T) {
+	var (
+		kek   = genKey(t)
+		data  = make([]byte, 2*encrypt.SegSize+100)
+		edata []byte
+	)
+	_, _ = cryptorand.Read(data)
+	edata = seal(t, kek, data)
+
+	// wrong key
+	_, err := encrypt.NewReader(bytes.NewReader(edata), int64(len(edata)), keyFunc(genKey(t)))
+	tassert.Errorf(t, errors.Is(err, encrypt.ErrBadKey), "expecting bad key, got %v", err)
+
+	// flipped bit in the body
+	bad := bytes.Clone(edata)
+	bad[len(bad)-encrypt.SegSize/2] ^= 1
+	er, err := encrypt.NewReader(bytes.NewReader(bad), int64(len(bad)), keyFunc(kek))
+	tassert.CheckFatal(t, err)
+	_, err = io.ReadAll(er)
+	tassert.Errorf(t, errors.Is(err, encrypt.ErrCorrupted), "expecting corruption, got %v", err)
+
+	// flipped bit in the wrapped data key
+	bad = bytes.Clone(edata)
+	bad[30] ^= 1
+	_, err = encrypt.NewReader(bytes.NewReader(bad), int64(len(bad)), keyFunc(kek))
+	tassert.Errorf(t, errors.Is(err, encrypt.ErrBadKey), "expecting bad key, got %v", err)
+
+	// truncated at the segment boundary
+	psize := encrypt.Psize(2*encrypt.SegSize, testKeyID)
+	er, err = encrypt.NewReader(bytes.NewReader(edata[:psize]), psize, keyFunc(kek))
+	if err == nil {
+		_, err = io.ReadAll(er)
+	}
+	tassert.Errorf(t, errors.Is(err, encrypt.ErrCorrupted), "expecting truncation error, got %v", err)
+}
+
+func TestEncryptKeyfile(t *testing.T) {
+	var (
+		fqn = filepath.Join(t.TempDir(), "keys.json")
+		k1  = genKey(t)
+		k2  = genKey(t)
+	)
+	t.Setenv(env.AisEncryptionKeyfile, fqn)
+	writeKeyfile := func(keys map[string][]byte) {
+		var buf bytes.Buffer
+		buf.WriteString("{")
+		i := 0
+		for id, key := range keys {
+			if i > 0 {
+				buf.WriteString(",")
+			}
+			buf.WriteString(`"` + id + `":"` + base64.StdEncoding.EncodeToString(key) + `"`)
+			i++
+		}
+		buf.WriteString("}")
+		tassert.CheckFatal(t, os.WriteFile(fqn, buf.Bytes(), 0o600))
+	}
+	writeKeyfile(map[string][]byte{"k1": k1})
+	tassert.CheckFatal(t, encrypt.ReloadKeys())
+
+	key, err := encrypt.Key(encrypt.MakeKeyID(apc.EncryptKeyfile, "k1"))
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, bytes.Equal(key, k1), "k1 mismatch")
+
+	// returns a copy
+	clear(key)
+	key, err = encrypt.Key(encrypt.MakeKeyID(apc.EncryptKeyfile, "k1"))
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, bytes.Equal(key, k1), "k1 modified via returned key")
+
+	_, err = encrypt.Key(encrypt.MakeKeyID(apc.EncryptKeyfile, "k2"))
+	tassert.Errorf(t, err != nil, "expecting k2 not found")
+
+	// rotate: add k2 (and make sure mtime changes)
+	writeKeyfile(map[string][]byte{"k1": k1, "k2": k2})
+	future := time.Now().Add(time.Second)
+	tassert.CheckFatal(t, os.Chtimes(fqn, future, future))
+	tassert.CheckFatal(t, encrypt.ReloadKeys())
+	key, err = encrypt.Key(encrypt.MakeKeyID(apc.EncryptKeyfile, "k2"))
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, bytes.Equal(key, k2), "k2 mismatch")
+
+	// invalid keyfile: keep serving previously loaded keys
+	tassert.CheckFatal(t, os.WriteFile(fqn, []byte("{"), 0o600))
+	tassert.Errorf(t, encrypt.ReloadKeys() != nil, "expecting invalid keyfile")
+	key, err = encrypt.Key(encrypt.MakeKeyID(apc.EncryptKeyfile, "k2"))
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, bytes.Equal(key, k2), "k2 mismatch")
+
+	// invalid
+	_, err = encrypt.Key("k1")
+	tassert.Errorf(t, err != nil, "expecting invalid key ID")
+	_, err = encrypt.Key(encrypt.MakeKeyID("kms", "k1"))
+	tassert.Errorf(t, err != nil, "expecting unknown provider")
+}
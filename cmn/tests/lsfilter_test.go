@@ -0,0 +1,68 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestParseLsoFilter(t *testing.T) {
+	before := time.Now()
+	f, err := apc.ParseLsoFilter("size>=1GiB; size<2GiB; mtime>24h; name~\\.tar$; name=shard-*; custom.owner=alice; custom.tag; cksum")
+	tassert.CheckFatal(t, err)
+
+	tassert.Errorf(t, f.MinSize == cos.GiB, "min size: %d", f.MinSize)
+	tassert.Errorf(t, f.MaxSize == 2*cos.GiB-1, "max size: %d", f.MaxSize)
+	tassert.Errorf(t, f.NameRegex == "\\.tar$" && f.NameGlob == "shard-*", "name: %q, %q", f.NameRegex, f.NameGlob)
+	tassert.Errorf(t, f.Custom["owner"] == "alice", "custom: %v", f.Custom)
+	_, ok := f.Custom["tag"]
+	tassert.Errorf(t, ok && f.Custom["tag"] == "", "custom: %v", f.Custom)
+	tassert.Errorf(t, f.HasCksum != nil && *f.HasCksum, "cksum: %v", f.HasCksum)
+	tassert.Errorf(t, f.MtimeBefore == 0 && f.AtimeAfter == 0 && f.AtimeBefore == 0, "unexpected time bounds: %+v", f)
+
+	// mtime>24h => modified during the last 24 hours
+	after := time.Unix(0, f.MtimeAfter)
+	tassert.Errorf(t, after.Before(before.Add(-23*time.Hour)) && after.After(before.Add(-25*time.Hour)),
+		"mtime after: %v", after)
+	tassert.Errorf(t, f.NeedsMD(), "expecting filter to require metadata")
+
+	// round trip
+	f2, err := apc.ParseLsoFilter(f.String())
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, f2.MinSize == f.MinSize && f2.MaxSize == f.MaxSize && f2.NameRegex == f.NameRegex,
+		"round trip: %+v vs %+v", f, f2)
+
+	f, err = apc.ParseLsoFilter("name=*.jpg; atime<2026-01-01T00:00:00Z; !cksum")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, f.AtimeBefore == time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC).UnixNano(), "atime before: %d", f.AtimeBefore)
+	tassert.Errorf(t, f.HasCksum != nil && !*f.HasCksum, "cksum: %v", f.HasCksum)
+
+	f, err = apc.ParseLsoFilter("name=dir/*")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, !f.NeedsMD(), "name-only filter must not require metadata")
+}
+
+func TestParseLsoFilterInvalid(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		";;",
+		"size>=abc",
+		"size>=2GiB; size<=1GiB",
+		"mtime>yesterday",
+		"color=red",
+		"name~[",
+		"name=[",
+		"custom.=x",
+		"mtime>1h; mtime<2h",
+	} {
+		_, err := apc.ParseLsoFilter(expr)
+		tassert.Errorf(t, err != nil, "expecting %q to fail", expr)
+	}
+}
@@ -0,0 +1,125 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+const webhook = "https://hooks.example.com/ais"
+
+func TestNotifValidate(t *testing.T) {
+	tests := []struct {
+		rule  cmn.NotifRule
+		valid bool
+	}{
+		{cmn.NotifRule{Events: []string{cmn.NotifPut}, URL: webhook}, true},
+		{cmn.NotifRule{Events: []string{cmn.NotifObjCreated + "*", cmn.NotifDelete}, Prefix: "a/", Suffix: ".jpg", URL: "http://localhost:8000"}, true},
+		{cmn.NotifRule{Events: []string{cmn.NotifECRecover}, URL: webhook}, true},
+		{cmn.NotifRule{Events: []string{cmn.NotifPut}}, false},
+		{cmn.NotifRule{Events: []string{cmn.NotifPut}, URL: "ftp://example.com"}, false},
+		{cmn.NotifRule{Events: []string{cmn.NotifPut}, URL: "https://"}, false},
+		{cmn.NotifRule{URL: webhook}, false},
+		{cmn.NotifRule{Events: []string{"s3:ObjectCreated:Post"}, URL: webhook}, false},
+		{cmn.NotifRule{Events: []string{"*"}, URL: webhook}, false},
+	}
+	for i, test := range tests {
+		conf := cmn.NotifConf{Rules: []cmn.NotifRule{test.rule}}
+		err := conf.ValidateAsProps()
+		tassert.Errorf(t, (err == nil) == test.valid, "%d: %+v: valid=%t, err=%v", i, test.rule, test.valid, err)
+	}
+}
+
+func TestNotifMatch(t *testing.T) {
+	conf := cmn.NotifConf{Rules: []cmn.NotifRule{
+		{ID: "created", Events: []string{cmn.NotifObjCreated + "*"}, Prefix: "img/", Suffix: ".jpg", URL: webhook},
+		{ID: "removed", Events: []string{cmn.NotifDelete}, URL: webhook + "/del"},
+	}}
+	tassert.CheckFatal(t, conf.ValidateAsProps())
+
+	tests := []struct {
+		event, name string
+		ids         []string
+	}{
+		{cmn.NotifPut, "img/a.jpg", []string{"created"}},
+		{cmn.NotifMptComplete, "img/b/c.jpg", []string{"created"}},
+		{cmn.NotifCopy, "img/a.png", nil},
+		{cmn.NotifPut, "a.jpg", nil},
+		{cmn.NotifDelete, "img/a.jpg", []string{"removed"}},
+		{cmn.NotifECRecover, "img/a.jpg", nil},
+	}
+	for _, test := range tests {
+		rules := conf.Match(test.event, test.name)
+		tassert.Fatalf(t, len(rules) == len(test.ids), "%s %s: expecting %v, got %d rule(s)", test.event, test.name, test.ids, len(rules))
+		for i, rule := range rules {
+			tassert.Errorf(t, rule.ID == test.ids[i], "%s %s: expecting %s, got %s", test.event, test.name, test.ids[i], rule.ID)
+		}
+	}
+
+	tassert.Errorf(t, cmn.OwtNotifEvent(cmn.OwtPut) == cmn.NotifPut, "put")
+	tassert.Errorf(t, cmn.OwtNotifEvent(cmn.OwtTransform) == cmn.NotifCopy, "transform")
+	tassert.Errorf(t, cmn.OwtNotifEvent(cmn.OwtRebalance) == "", "rebalance")
+	tassert.Errorf(t, cmn.OwtNotifEvent(cmn.OwtChunks) == "", "re-chunk")
+}
+
+func TestNotifSetProps(t *testing.T) {
+	var (
+		bp    = &cmn.Bprops{}
+		rules = []cmn.NotifRule{{Events: []string{cmn.NotifPut}, URL: webhook}}
+	)
+	bp.Apply(&cmn.BpropsToSet{Notif: &cmn.NotifConfToSet{Rules: &rules}})
+	tassert.Fatalf(t, bp.Notif.IsActive() && bp.Notif.Rules[0].URL == webhook, "expecting active: %+v", bp.Notif)
+
+	none := []cmn.NotifRule{}
+	bp.Apply(&cmn.BpropsToSet{Notif: &cmn.NotifConfToSet{Rules: &none}})
+	tassert.Errorf(t, !bp.Notif.IsActive(), "expecting inactive: %+v", bp.Notif)
+}
+
+func TestWebhookConf(t *testing.T) {
+	conf := &cmn.WebhookConf{
+		Allow: []string{"hooks.example.com", "*.internal.example.com", "192.168.0.0/16"},
+		Deny:  []string{"169.254.0.0/16", "metadata.internal.example.com", "10.1.1.1"},
+	}
+	tassert.CheckFatal(t, conf.Validate())
+	tests := []struct {
+		host    string
+		ips     []string
+		allowed bool
+	}{
+		{"hooks.example.com", nil, true},
+		{"HOOKS.example.com", []string{"8.8.8.8"}, true},
+		{"a.internal.example.com", nil, true},
+		{"internal.example.com", nil, false},                      // (subdomains only)
+		{"metadata.internal.example.com", nil, false},             // denied by name
+		{"hooks.example.com", []string{"169.254.169.254"}, false}, // denied by address
+		{"192.168.1.10", nil, true},
+		{"10.1.1.1", nil, false},
+		{"other.example.com", []string{"192.168.1.10"}, true},
+		{"other.example.com", []string{"192.168.1.10", "8.8.8.8"}, false}, // (all addresses must be allowed)
+		{"other.example.com", nil, false},
+	}
+	for _, test := range tests {
+		var ips []net.IP
+		for _, s := range test.ips {
+			ips = append(ips, net.ParseIP(s))
+		}
+		err := conf.Check(test.host, ips)
+		tassert.Errorf(t, (err == nil) == test.allowed, "%s %v: expected allowed=%t, got %v", test.host, test.ips, test.allowed, err)
+	}
+
+	// empty allow list: any (not denied) host
+	conf.Allow = nil
+	tassert.CheckError(t, conf.Check("other.example.com", nil))
+	tassert.Errorf(t, conf.Check("localhost", []net.IP{net.ParseIP("169.254.1.1")}) != nil, "expected denied")
+
+	for _, invalid := range []string{"", "*.", "10.0.0.0/33"} {
+		conf := &cmn.WebhookConf{Deny: []string{invalid}}
+		tassert.Errorf(t, conf.Validate() != nil, "expected %q to fail validation", invalid)
+	}
+}
@@ -0,0 +1,121 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestObjLockConfValidate(t *testing.T) {
+	tests := []struct {
+		conf  cmn.ObjectLockConf
+		valid bool
+	}{
+		{cmn.ObjectLockConf{}, true},
+		{cmn.ObjectLockConf{Enabled: true}, true},
+		{cmn.ObjectLockConf{Enabled: true, Mode: cmn.ObjLockGovernance, Days: 30}, true},
+		{cmn.ObjectLockConf{Enabled: true, Mode: cmn.ObjLockCompliance, Years: 7}, true},
+		{cmn.ObjectLockConf{Mode: cmn.ObjLockGovernance, Days: 30}, false},
+		{cmn.ObjectLockConf{Enabled: true, Mode: cmn.ObjLockGovernance}, false},
+		{cmn.ObjectLockConf{Enabled: true, Days: 30}, false},
+		{cmn.ObjectLockConf{Enabled: true, Mode: "LEGAL", Days: 30}, false},
+		{cmn.ObjectLockConf{Enabled: true, Mode: cmn.ObjLockGovernance, Days: 30, Years: 1}, false},
+		{cmn.ObjectLockConf{Enabled: true, Mode: cmn.ObjLockGovernance, Days: -1}, false},
+		{cmn.ObjectLockConf{Enabled: true, Mode: cmn.ObjLockCompliance, Years: 101}, false},
+	}
+	for i, test := range tests {
+		err := test.conf.ValidateAsProps()
+		tassert.Errorf(t, (err == nil) == test.valid, "%d: %+v: valid=%t, err=%v", i, test.conf, test.valid, err)
+	}
+}
+
+func TestObjLockDefaultRetention(t *testing.T) {
+	var (
+		now  = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		conf = cmn.ObjectLockConf{Enabled: true, Mode: cmn.ObjLockGovernance, Days: 30}
+		oa   = &cmn.ObjAttrs{}
+	)
+	tassert.CheckFatal(t, conf.Apply(oa, now))
+	r, ok := oa.Retention()
+	tassert.Fatalf(t, ok, "expecting default retention")
+	tassert.Errorf(t, r.Mode == cmn.ObjLockGovernance && r.Until.Equal(now.AddDate(0, 0, 30)), "retention: %+v", r)
+
+	// explicit retention takes precedence
+	oa = &cmn.ObjAttrs{}
+	explicit := &cmn.ObjRetention{Mode: cmn.ObjLockCompliance, Until: now.Add(time.Hour)}
+	oa.SetRetention(explicit)
+	tassert.CheckFatal(t, conf.Apply(oa, now))
+	r, _ = oa.Retention()
+	tassert.Errorf(t, r.Mode == cmn.ObjLockCompliance && r.Until.Equal(explicit.Until), "retention: %+v", r)
+
+	// invalid explicit metadata
+	oa = &cmn.ObjAttrs{}
+	oa.SetCustomKey(cmn.ObjLockModeMD, cmn.ObjLockCompliance)
+	tassert.Errorf(t, conf.Apply(oa, now) != nil, "expecting error (missing retain-until date)")
+}
+
+func TestObjLockCheck(t *testing.T) {
+	var (
+		now   = time.Now()
+		cname = "ais://abc/obj"
+		oa    = &cmn.ObjAttrs{}
+	)
+	tassert.CheckError(t, oa.CheckObjLock(cname, now, false))
+
+	// governance: bypass permitted
+	oa.SetRetention(&cmn.ObjRetention{Mode: cmn.ObjLockGovernance, Until: now.Add(time.Hour)})
+	err := oa.CheckObjLock(cname, now, false)
+	tassert.Errorf(t, cmn.IsErrObjLocked(err), "expecting locked, got %v", err)
+	tassert.CheckError(t, oa.CheckObjLock(cname, now, true))
+	tassert.CheckError(t, oa.CheckObjLock(cname, now.Add(2*time.Hour), false)) // expired
+
+	// compliance: no bypass
+	oa.SetRetention(&cmn.ObjRetention{Mode: cmn.ObjLockCompliance, Until: now.Add(time.Hour)})
+	tassert.Errorf(t, cmn.IsErrObjLocked(oa.CheckObjLock(cname, now, true)), "expecting locked (compliance)")
+
+	// legal hold: no bypass, no expiration
+	oa.SetRetention(nil)
+	oa.SetLegalHold(true)
+	tassert.Errorf(t, oa.IsObjLocked(now.AddDate(100, 0, 0)), "expecting locked (legal hold)")
+	tassert.Errorf(t, cmn.IsErrObjLocked(oa.CheckObjLock(cname, now, true)), "expecting locked (legal hold)")
+	oa.SetLegalHold(false)
+	tassert.Errorf(t, !oa.IsObjLocked(now), "expecting unlocked")
+}
+
+func TestObjLockRetentionUpdate(t *testing.T) {
+	var (
+		now   = time.Now()
+		cname = "ais://abc/obj"
+		until = now.Add(time.Hour)
+		oa    = &cmn.ObjAttrs{}
+	)
+	gov := &cmn.ObjRetention{Mode: cmn.ObjLockGovernance, Until: until}
+	oa.SetRetention(gov)
+	tests := []struct {
+		nr     *cmn.ObjRetention
+		bypass bool
+		ok     bool
+	}{
+		{&cmn.ObjRetention{Mode: cmn.ObjLockGovernance, Until: until.Add(time.Hour)}, false, true},     // extend
+		{&cmn.ObjRetention{Mode: cmn.ObjLockCompliance, Until: until}, false, true},                    // upgrade
+		{&cmn.ObjRetention{Mode: cmn.ObjLockGovernance, Until: until.Add(-time.Minute)}, false, false}, // shorten
+		{&cmn.ObjRetention{Mode: cmn.ObjLockGovernance, Until: until.Add(-time.Minute)}, true, true},
+		{nil, false, false}, // remove
+		{nil, true, true},
+	}
+	for i, test := range tests {
+		err := oa.CheckRetentionUpdate(cname, test.nr, now, test.bypass)
+		tassert.Errorf(t, (err == nil) == test.ok, "%d: ok=%t, err=%v", i, test.ok, err)
+	}
+
+	oa.SetRetention(&cmn.ObjRetention{Mode: cmn.ObjLockCompliance, Until: until})
+	err := oa.CheckRetentionUpdate(cname, &cmn.ObjRetention{Mode: cmn.ObjLockGovernance, Until: until.Add(time.Hour)}, now, true)
+	tassert.Errorf(t, cmn.IsErrObjLocked(err), "compliance => governance: expecting locked, got %v", err)
+	tassert.Errorf(t, cmn.IsErrObjLocked(oa.CheckRetentionUpdate(cname, nil, now, true)), "expecting locked (compliance)")
+}
@@ -0,0 +1,75 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestQuotaValidate(t *testing.T) {
+	tests := []struct {
+		conf  cmn.QuotaConf
+		valid bool
+	}{
+		{cmn.QuotaConf{}, true},
+		{cmn.QuotaConf{SizeHard: cos.GiB, SizeSoft: cos.MiB}, true},
+		{cmn.QuotaConf{SizeSoft: cos.GiB, ObjsSoft: 100}, true},
+		{cmn.QuotaConf{ObjsHard: 100, ObjsSoft: 100}, true},
+		{cmn.QuotaConf{SizeHard: cos.MiB, SizeSoft: cos.GiB}, false},
+		{cmn.QuotaConf{ObjsHard: 10, ObjsSoft: 100}, false},
+		{cmn.QuotaConf{SizeHard: -1}, false},
+		{cmn.QuotaConf{ObjsSoft: -1}, false},
+	}
+	for i, test := range tests {
+		err := test.conf.ValidateAsProps()
+		tassert.Errorf(t, (err == nil) == test.valid, "%d: %+v: valid=%t, err=%v", i, test.conf, test.valid, err)
+	}
+}
+
+func TestQuotaCheck(t *testing.T) {
+	conf := cmn.QuotaConf{SizeHard: 10 * cos.MiB, SizeSoft: 8 * cos.MiB, ObjsHard: 100, ObjsSoft: 90}
+	tassert.CheckFatal(t, conf.ValidateAsProps())
+
+	tassert.CheckError(t, conf.Check("ais://abc", 9*cos.MiB, 50, cos.MiB, 1))
+	tassert.CheckError(t, conf.Check("ais://abc", 10*cos.MiB, 50, 0, 0))
+
+	err := conf.Check("ais://abc", 9*cos.MiB, 50, cos.MiB+1, 1)
+	tassert.Fatalf(t, cmn.IsErrQuotaExceeded(err), "expecting quota exceeded (size), got %v", err)
+	tassert.Errorf(t, err.(*cmn.ErrQuotaExceeded).Status() == http.StatusInsufficientStorage, "expecting 507: %v", err)
+
+	err = conf.Check("ais://abc", 0, 100, 0, 1)
+	tassert.Fatalf(t, cmn.IsErrQuotaExceeded(err), "expecting quota exceeded (objects), got %v", err)
+	tassert.Errorf(t, err.(*cmn.ErrQuotaExceeded).Status() == http.StatusForbidden, "expecting 403: %v", err)
+
+	tassert.Errorf(t, !conf.SoftExceeded(8*cos.MiB, 90), "soft: not expecting exceeded")
+	tassert.Errorf(t, conf.SoftExceeded(8*cos.MiB+1, 0), "soft: expecting exceeded (size)")
+	tassert.Errorf(t, conf.SoftExceeded(0, 91), "soft: expecting exceeded (objects)")
+
+	// no limits
+	none := cmn.QuotaConf{}
+	tassert.Errorf(t, !none.IsActive(), "expecting inactive")
+	tassert.CheckError(t, none.Check("ais://abc", cos.TiB, 1e9, cos.TiB, 1))
+	tassert.Errorf(t, !none.SoftExceeded(cos.TiB, 1e9), "expecting no soft quota")
+}
+
+func TestQuotaSetProps(t *testing.T) {
+	var (
+		bp   = &cmn.Bprops{}
+		hard = cos.SizeIEC(cos.GiB)
+		objs = int64(1000)
+	)
+	bp.Apply(&cmn.BpropsToSet{Quota: &cmn.QuotaConfToSet{SizeHard: &hard, ObjsSoft: &objs}})
+	tassert.Fatalf(t, bp.Quota.IsActive() && bp.Quota.SizeHard == hard && bp.Quota.ObjsSoft == objs, "expecting active: %+v", bp.Quota)
+	tassert.CheckError(t, bp.Quota.ValidateAsProps())
+
+	var zero cos.SizeIEC
+	bp.Apply(&cmn.BpropsToSet{Quota: &cmn.QuotaConfToSet{SizeHard: &zero}})
+	tassert.Errorf(t, bp.Quota.IsActive() && bp.Quota.SizeHard == 0, "expecting objects-only quota: %+v", bp.Quota)
+}
@@ -0,0 +1,76 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestTagsParseEncode(t *testing.T) {
+	tags, err := cmn.ParseTags("split=train&owner=data%20team&empty=")
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(tags) == 3, "expecting 3 tags, got %v", tags)
+	tassert.Errorf(t, tags[0].Key == "empty" && tags[1].Key == "owner" && tags[2].Key == "split", "not sorted: %v", tags)
+	v, ok := tags.Get("owner")
+	tassert.Errorf(t, ok && v == "data team", "owner: %q", v)
+	tassert.CheckError(t, tags.ValidateObj())
+
+	enc := tags.Encode()
+	tassert.Errorf(t, cmn.NumTags(enc) == 3, "num tags: %d (%q)", cmn.NumTags(enc), enc)
+	tags2, err := cmn.ParseTags(enc)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, tags2.Encode() == enc, "round trip: %q vs %q", tags2.Encode(), enc)
+
+	_, err = cmn.ParseTags("k=1&k=2")
+	tassert.Errorf(t, err != nil, "expecting duplicate key error")
+	tassert.Errorf(t, cmn.NumTags("") == 0, "expecting zero")
+}
+
+func TestTagsValidate(t *testing.T) {
+	tests := []struct {
+		tags  cmn.Tags
+		valid bool
+	}{
+		{cmn.Tags{{Key: "project", Value: "x/y:z@1.0+a_b-c"}}, true},
+		{cmn.Tags{{Key: "Проект", Value: "значение"}}, true},
+		{cmn.Tags{{Key: "", Value: "v"}}, false},
+		{cmn.Tags{{Key: "aws:created", Value: "v"}}, false},
+		{cmn.Tags{{Key: "k", Value: "v"}, {Key: "k", Value: "w"}}, false},
+		{cmn.Tags{{Key: "k*", Value: "v"}}, false},
+		{cmn.Tags{{Key: "k", Value: "v?"}}, false},
+		{cmn.Tags{{Key: strings.Repeat("k", cmn.MaxTagKeyLen+1)}}, false},
+		{cmn.Tags{{Key: "k", Value: strings.Repeat("v", cmn.MaxTagValueLen+1)}}, false},
+	}
+	for _, test := range tests {
+		err := test.tags.ValidateObj()
+		tassert.Errorf(t, (err == nil) == test.valid, "%v: expecting valid=%t, got %v", test.tags, test.valid, err)
+	}
+
+	// limits: object vs bucket
+	tags := make(cmn.Tags, 0, cmn.MaxObjTags+1)
+	for i := range cmn.MaxObjTags + 1 {
+		tags = append(tags, cmn.Tag{Key: "k" + strconv.Itoa(i), Value: "v"})
+	}
+	tassert.Errorf(t, tags.ValidateObj() != nil, "expecting too many object tags")
+	tassert.CheckError(t, tags.ValidateAsProps())
+}
+
+func TestTagsMatch(t *testing.T) {
+	enc := cmn.Tags{{Key: "split", Value: "val"}, {Key: "owner", Value: "alice"}}.Encode()
+
+	tassert.Errorf(t, cmn.MatchTags(enc, []string{"split=val"}), "expecting match")
+	tassert.Errorf(t, cmn.MatchTags(enc, []string{"split=val", "owner"}), "expecting match")
+	tassert.Errorf(t, !cmn.MatchTags(enc, []string{"split=train"}), "expecting no match")
+	tassert.Errorf(t, !cmn.MatchTags(enc, []string{"split=val", "team"}), "expecting no match")
+	tassert.Errorf(t, !cmn.MatchTags("", []string{"split"}), "untagged: expecting no match")
+
+	tassert.CheckError(t, cmn.ValidateTagSelectors([]string{"split=val", "owner"}))
+	tassert.Errorf(t, cmn.ValidateTagSelectors([]string{"=val"}) != nil, "expecting invalid selector")
+}
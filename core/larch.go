@@ -0,0 +1,164 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"os"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// persistent TAR index (fs.ArchIdxCT) ------------------------------------
+// - built upon first access to an archived file (or first listing) and stored
+//   next to the object, on the same mountpath;
+// - plain (neither compressed nor encrypted), non-chunked TARs only;
+// - objects named with .tar extension only (see hasTarExt);
+// - identifies the indexed object by its (size, mtime): overwriting or appending
+//   removes the index (see RenameToMain, RemoveObj); stale index that may have
+//   survived (e.g., crash) gets rebuilt upon next access;
+// - best-effort: failure to load or persist the index is not an error - callers
+//   fall back to reading the archive sequentially.
+// -------------------------------------------------------------------------
+
+const archIdxMinSize = 64 * cos.KiB // smaller TARs are cheap to scan
+
+// (under rlock) load persisted or build (and persist) TAR index;
+// returns nil if the object is not an indexable TAR
+func (lom *LOM) ArchIdx(lh cos.LomReader, mime string) *archive.TarIndex {
+	if mime != archive.ExtTar || !lom.IsPlain() || lom.IsChunked() || !lom.hasTarExt() {
+		return nil
+	}
+	var fh *os.File
+	switch v := lh.(type) {
+	case *os.File:
+		fh = v
+	case *LomHandle:
+		fh, _ = v.LomReader.(*os.File)
+	}
+	if fh == nil {
+		return nil
+	}
+	// (size, mtime) of the open file - not the (possibly stale) metadata
+	finfo, err := fh.Stat()
+	if err != nil || finfo.Size() < archIdxMinSize {
+		return nil
+	}
+	var (
+		size  = finfo.Size()
+		mtime = finfo.ModTime().UnixNano()
+		fqn   = lom.GenFQN(fs.ArchIdxCT)
+	)
+	if tidx := lom.loadArchIdx(fqn, size, mtime); tidx != nil {
+		return tidx
+	}
+
+	tidx, err := archive.IndexTar(fh, size)
+	if err != nil {
+		if cmn.Rom.V(4, cos.ModCore) {
+			nlog.Warningln("failed to index", lom.Cname(), "err:", err)
+		}
+		return nil
+	}
+	tidx.Mtime = mtime
+	lom.persistArchIdx(tidx, fqn)
+	return tidx
+}
+
+func (lom *LOM) loadArchIdx(fqn string, size, mtime int64) *archive.TarIndex {
+	b, err := os.ReadFile(fqn)
+	if err != nil {
+		return nil // (not indexed yet)
+	}
+	tidx, err := archive.UnpackTarIndex(b)
+	if err != nil {
+		if cmn.Rom.V(4, cos.ModCore) {
+			nlog.Warningln("failed to load TAR index", lom.Cname(), "err:", err)
+		}
+		return nil
+	}
+	if tidx.Size != size || tidx.Mtime != mtime {
+		return nil // stale
+	}
+	return tidx
+}
+
+// write work file and rename (concurrent readers may race to do the same)
+func (lom *LOM) persistArchIdx(tidx *archive.TarIndex, fqn string) {
+	wfqn := lom.GenFQN(fs.WorkCT, fs.WorkfileArchIdx)
+	fh, err := cos.CreateFile(wfqn)
+	if err == nil {
+		_, err = fh.Write(tidx.Pack())
+		if errC := fh.Close(); err == nil {
+			err = errC
+		}
+		if err == nil {
+			err = cos.Rename(wfqn, fqn)
+		}
+		if err != nil {
+			cos.RemoveFile(wfqn)
+		}
+	}
+	if err != nil && cmn.Rom.V(4, cos.ModCore) {
+		nlog.Warningln("failed to persist TAR index", lom.Cname(), "err:", err)
+	}
+}
+
+// list archived files: use (or build) TAR index, if possible; otherwise, read the archive
+// (not locking - compare with archive.List - unless the object is compressed, encrypted,
+// or chunked, in which case it is read via (rlocked) LOM handle - see lsArchZ)
+func (lom *LOM) ListArch() ([]*archive.Entry, error) {
+	if !lom.IsPlain() || lom.IsChunked() {
+		return lom.lsArchZ()
+	}
+	if mime, err := archive.Mime("", lom.FQN); err == nil && mime == archive.ExtTar {
+		if fh, err := os.Open(lom.FQN); err == nil {
+			tidx := lom.ArchIdx(fh, mime)
+			cos.Close(fh)
+			if tidx != nil {
+				return tidx.List(), nil
+			}
+		}
+	}
+	return archive.List(lom.FQN)
+}
+
+func (lom *LOM) lsArchZ() ([]*archive.Entry, error) {
+	if _, err := archive.Mime("", lom.ObjName); err != nil {
+		return nil, err // (not an archive - no need to lock)
+	}
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return nil, err
+	}
+	lh, err := lom.Open()
+	if err != nil {
+		return nil, err
+	}
+	lst, err := archive.ListReader(lh, lom.ObjName, lom.Lsize())
+	cos.Close(lh)
+	return lst, err
+}
+
+// indexing only when the name says so (compare with archive.MimeFile) -
+// to keep RemoveArchIdx from adding a syscall to each and every PUT
+func (lom *LOM) hasTarExt() bool {
+	mime, err := archive.Mime("", lom.ObjName)
+	return err == nil && mime == archive.ExtTar
+}
+
+// remove TAR index, if exists
+func (lom *LOM) RemoveArchIdx() {
+	if !lom.hasTarExt() {
+		return
+	}
+	if err := cos.RemoveFile(lom.GenFQN(fs.ArchIdxCT)); err != nil && cmn.Rom.V(4, cos.ModCore) {
+		nlog.Warningln("failed to remove TAR index", lom.Cname(), "err:", err)
+	}
+}
@@ -0,0 +1,459 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/compress"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/encrypt"
+)
+
+// Transparent at-rest compression and encryption (bucket properties `compression`
+// and `encryption`, see cmn/compression.go and cmn/encryption.go)
+//
+// * monolithic objects: the compression algorithm and encryption are recorded in
+//   lmeta flags, and the physical (on-disk) size - in lmeta as well; lom.Lsize()
+//   always returns the logical size;
+// * chunked objects: each chunk is compressed and/or encrypted (or not) independently,
+//   as per Uchunk flags; the object's lmeta records the same and the total physical size;
+// * writing: NewZWriter (objects) and NewChunkZWriter (chunks) compress first, and
+//   then encrypt - all other writers produce plain content (see CreateWork and SetPlain);
+// * reading: lom.Open() and UfestReader decrypt and decompress on the fly, with ReadAt
+//   (range and archive reads) served via per-file seek tables and fixed-size
+//   encrypted segments;
+// * encryption keys: bucket-configured or, for S3 SSE-C, customer-provided and
+//   request-scoped (see SetSSEC); the key ID is stored with the content itself;
+// * moving within the cluster (rebalance, erasure coding, mirroring): monolithic
+//   objects are moved as is - see RawMove and RawMD.
+
+// Uchunk flags: compression algorithm (enum compress.Algo) and encryption
+const (
+	ucflComprMask = uint16(0x3)
+	ucflEncrypted = uint16(0x4)
+)
+
+type (
+	// compressing and/or encrypting cos.LomWriter
+	ZWriter struct {
+		w   io.Writer        // top of the stack
+		zw  *compress.Writer // nil when not compressing
+		ew  *encrypt.Writer  // nil when not encrypting
+		fh  cos.LomWriter
+		lom *LOM // nil when writing a chunk
+	}
+	// (compress.Reader, encrypt.Reader, and os.File)
+	zreader interface {
+		cos.LomReader
+		io.Seeker
+	}
+	// at-rest (work) file => backend; see NewWorkHandle
+	zhandle struct {
+		zreader
+		lom *LOM
+		fqn string
+	}
+)
+
+// interface guard
+var (
+	_ cos.LomWriter = (*ZWriter)(nil)
+	_ cos.ROCS      = (*zhandle)(nil)
+)
+
+var ErrSSECKey = errors.New("object is encrypted with a customer-provided key (SSE-C): missing or invalid key")
+
+func (lom *LOM) IsCompressed() bool { return lom.md.flags&lmflComprMask != 0 }
+func (lom *LOM) IsEncrypted() bool  { return lom.md.flags&lmflEncrypted != 0 }
+
+// on-disk content is the object's content as is (neither compressed nor encrypted)
+func (lom *LOM) IsPlain() bool { return lom.md.flags&lmflPsize == 0 }
+
+// returns compression algorithm ("" when not compressed)
+func (lom *LOM) Compression() string {
+	return compress.Algo(lom.md.flags & lmflComprMask).String()
+}
+
+// physical size
+func (lom *LOM) Psize() int64 {
+	if !lom.IsPlain() {
+		return lom.md.psize
+	}
+	return lom.md.Size
+}
+
+// to be called by writers that replace object content with plain data
+// (compare with NewZWriter)
+func (lom *LOM) SetPlain() {
+	lom.md.flags &^= lmflPsize
+	lom.md.psize = 0
+}
+
+func (lom *LOM) setAtRest(algo compress.Algo, encrypted bool, psize int64) {
+	debug.Assert(algo != compress.None || encrypted)
+	flags := uint64(algo) & lmflComprMask
+	if encrypted {
+		flags |= lmflEncrypted
+	}
+	lom.md.flags = (lom.md.flags &^ lmflPsize) | flags
+	lom.md.psize = psize
+}
+
+// compression algorithm to use given bucket props and (if known) size
+func (lom *LOM) zalgo(size int64) compress.Algo {
+	conf := &lom.Bprops().Compression
+	if !conf.IsActive() || (size >= 0 && size < int64(conf.MinSize)) {
+		return compress.None
+	}
+	algo, err := compress.ParseAlgo(conf.Algo)
+	debug.AssertNoErr(err) // validated
+	return algo
+}
+
+// S3 SSE-C: customer-provided key to encrypt (PUT) or decrypt (GET) the object
+// (request-scoped - never stored; see also cmn.SSECKeyMD5ObjMD)
+func (lom *LOM) SetSSEC(key []byte) { lom.ssec = key }
+
+// key-encryption key and key ID to encrypt new content: SSE-C (objects only) or
+// bucket-configured; empty key ID when not encrypting
+func (lom *LOM) wkey(ssec bool) (kek []byte, keyID string, err error) {
+	if ssec && lom.ssec != nil {
+		return lom.ssec, encrypt.SSECKeyID(lom.ssec), nil
+	}
+	conf := &lom.Bprops().Encryption
+	if !conf.IsActive() {
+		return nil, "", nil
+	}
+	keyID = encrypt.MakeKeyID(conf.Provider, conf.KeyID)
+	kek, err = encrypt.Key(keyID)
+	if err != nil {
+		err = fmt.Errorf("%s: %w", lom.Cname(), err)
+	}
+	return kek, keyID, err
+}
+
+// resolves the key ID stored with the content (compare with wkey)
+func (lom *LOM) rkey(keyID string) ([]byte, error) {
+	if !strings.HasPrefix(keyID, apc.EncryptSSEC+":") {
+		return encrypt.Key(keyID)
+	}
+	if lom.ssec == nil || encrypt.SSECKeyID(lom.ssec) != keyID {
+		return nil, ErrSSECKey
+	}
+	return lom.ssec, nil
+}
+
+// wraps work file handle to compress and/or encrypt object content as per bucket
+// configuration (and SSE-C, if set); returns the handle itself when neither applies
+// - size: expected object size or -1 when unknown
+// - upon successful Close (or Fini), LOM metadata records the same and physical size
+func (lom *LOM) NewZWriter(fh cos.LomWriter, size int64) (cos.LomWriter, error) {
+	lom.SetPlain()
+	zw, err := lom.newZWriter(fh, size, true /*SSE-C*/)
+	if zw == nil || err != nil {
+		return fh, err
+	}
+	zw.lom = lom
+	return zw, nil
+}
+
+// same as above, for a chunk: records compression and encryption in the chunk's flags
+func (lom *LOM) NewChunkZWriter(c *Uchunk, fh *os.File, size int64) (io.WriteCloser, error) {
+	c.flags &^= ucflComprMask | ucflEncrypted
+	zw, err := lom.newZWriter(fh, size, false)
+	if zw == nil || err != nil {
+		return fh, err
+	}
+	c.flags |= uint16(zw.algo())
+	if zw.ew != nil {
+		c.flags |= ucflEncrypted
+	}
+	return zw, nil
+}
+
+func (lom *LOM) newZWriter(fh cos.LomWriter, size int64, ssec bool) (*ZWriter, error) {
+	kek, keyID, err := lom.wkey(ssec)
+	if err != nil {
+		return nil, err
+	}
+	algo := lom.zalgo(size)
+	if algo == compress.None && keyID == "" {
+		return nil, nil
+	}
+	zw := &ZWriter{w: fh, fh: fh}
+	if keyID != "" {
+		if zw.ew, err = encrypt.NewWriter(fh, kek, keyID); err != nil {
+			return nil, fmt.Errorf("%s: %w", lom.Cname(), err)
+		}
+		zw.w = zw.ew
+	}
+	if algo != compress.None {
+		zw.zw = compress.NewWriter(zw.w, algo)
+		zw.w = zw.zw
+	}
+	return zw, nil
+}
+
+// opens (at-rest) work file for reading; otherwise, same as cos.NewFileHandle
+// (e.g., to PUT the object to remote backend)
+func (lom *LOM) NewWorkHandle(wfqn string) (cos.ReadOpenCloser, error) {
+	if lom.IsPlain() {
+		return cos.NewFileHandle(wfqn)
+	}
+	return lom.newZhandle(wfqn)
+}
+
+// compressed and/or encrypted (monolithic) object or its copy at a given location
+func (lom *LOM) openz(fqn string) (zreader, error) {
+	fh, err := os.Open(fqn)
+	if err != nil {
+		return nil, err
+	}
+	algo := compress.Algo(lom.md.flags & lmflComprMask)
+	r, err := openAtRest(fh, lom.md.psize, algo, lom.IsEncrypted(), lom.rkey)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", lom.Cname(), err)
+	}
+	return r, nil
+}
+
+// decrypt and/or decompress on the fly (closes fh on error)
+func openAtRest(fh *os.File, psize int64, algo compress.Algo, encrypted bool, kf encrypt.KeyFunc) (zreader, error) {
+	var r zreader = fh
+	if encrypted {
+		er, err := encrypt.NewReader(fh, psize, kf)
+		if err != nil {
+			cos.Close(fh)
+			return nil, err
+		}
+		r, psize = er, er.Size()
+	}
+	if algo != compress.None {
+		zr, err := compress.NewReader(r, psize)
+		if err != nil {
+			cos.Close(r)
+			return nil, err
+		}
+		r = zr
+	}
+	return r, nil
+}
+
+// content checksum of a compressed and/or encrypted object (that is being copied)
+func (lom *LOM) zcksum(cksumType string) (*cos.CksumHash, error) {
+	if cksumType == cos.ChecksumNone {
+		return nil, nil
+	}
+	if cksum := lom.Checksum(); cksum != nil && cksum.Type() == cksumType {
+		return &cos.CksumHash{Cksum: *cksum.Clone()}, nil
+	}
+	return lom.ComputeCksum(cksumType, true /*locked*/)
+}
+
+//////////////
+// ZWriter //
+//////////////
+
+func (zw *ZWriter) Write(p []byte) (int, error) { return zw.w.Write(p) }
+
+func (zw *ZWriter) algo() compress.Algo {
+	if zw.zw == nil {
+		return compress.None
+	}
+	return zw.zw.Algo()
+}
+
+// physical size: valid upon Fini()
+func (zw *ZWriter) Psize() int64 {
+	if zw.ew != nil {
+		return zw.ew.Psize()
+	}
+	return zw.zw.Psize()
+}
+
+// finalize at-rest content: flush the last compressed frame and write seek table,
+// seal the last encrypted segment
+func (zw *ZWriter) Fini() error {
+	if zw.zw != nil {
+		if err := zw.zw.Fini(); err != nil {
+			return err
+		}
+	}
+	if zw.ew != nil {
+		if err := zw.ew.Fini(); err != nil {
+			return err
+		}
+	}
+	if zw.lom != nil {
+		zw.lom.setAtRest(zw.algo(), zw.ew != nil, zw.Psize())
+	}
+	return nil
+}
+
+func (zw *ZWriter) Sync() error {
+	if err := zw.Fini(); err != nil {
+		return err
+	}
+	return zw.fh.Sync()
+}
+
+func (zw *ZWriter) Close() error {
+	err := zw.Fini()
+	if errC := zw.fh.Close(); err == nil {
+		err = errC
+	}
+	return err
+}
+
+//////////////
+// zhandle //
+//////////////
+
+func (lom *LOM) newZhandle(fqn string) (*zhandle, error) {
+	r, err := lom.openz(fqn)
+	if err != nil {
+		return nil, err
+	}
+	return &zhandle{zreader: r, lom: lom, fqn: fqn}, nil
+}
+
+func (zh *zhandle) Open() (cos.ReadOpenCloser, error) { return zh.lom.newZhandle(zh.fqn) }
+func (zh *zhandle) OpenDup() (cos.ROCS, error)        { return zh.lom.newZhandle(zh.fqn) }
+
+////////////
+// Uchunk //
+////////////
+
+func (c *Uchunk) IsCompressed() bool { return c.flags&ucflComprMask != 0 }
+func (c *Uchunk) IsEncrypted() bool  { return c.flags&ucflEncrypted != 0 }
+
+// opens chunk for reading; decrypts and/or decompresses on the fly if need be
+// (chunks are never SSE-C encrypted)
+func (c *Uchunk) Open() (cos.LomReader, error) {
+	fh, err := os.Open(c.path)
+	if err != nil {
+		return nil, err
+	}
+	if !c.IsCompressed() && !c.IsEncrypted() {
+		return fh, nil
+	}
+	finfo, err := fh.Stat()
+	if err != nil {
+		cos.Close(fh)
+		return nil, err
+	}
+	algo := compress.Algo(c.flags & ucflComprMask)
+	r, err := openAtRest(fh, finfo.Size(), algo, c.IsEncrypted(), encrypt.Key)
+	if err != nil {
+		return nil, fmt.Errorf("chunk %s: %w", c.path, err)
+	}
+	return r, nil
+}
+
+// chunked object: compressed (encrypted) iff any of its chunks is
+// (called upon completion, with total physical size computed from the chunks)
+func (lom *LOM) setChunkedAtRest(u *Ufest) error {
+	var (
+		algo      compress.Algo
+		encrypted bool
+		psize     int64
+	)
+	lom.SetPlain()
+	for i := range u.chunks {
+		c := &u.chunks[i]
+		if !c.IsCompressed() && !c.IsEncrypted() {
+			psize += c.size
+			continue
+		}
+		finfo, err := os.Stat(c.path)
+		if err != nil {
+			return err
+		}
+		if c.IsCompressed() {
+			algo = compress.Algo(c.flags & ucflComprMask)
+		}
+		encrypted = encrypted || c.IsEncrypted()
+		psize += finfo.Size()
+	}
+	if algo != compress.None || encrypted {
+		lom.setAtRest(algo, encrypted, psize)
+	}
+	return nil
+}
+
+//
+// moving at-rest content within the cluster (rebalance, erasure coding) as is
+//
+
+// monolithic compressed and/or encrypted object
+func (lom *LOM) RawMove() bool { return !lom.IsPlain() && !lom.IsChunked() }
+
+// on-disk content as is, to move it within the cluster (compare with NewDeferROC)
+// is called under rlock; unlocks on fail
+func (lom *LOM) NewDeferRawROC() (cos.ReadOpenCloser, error) {
+	debug.Assert(lom.RawMove(), lom.Cname())
+	fh, err := cos.NewFileHandle(lom.FQN)
+	if err == nil {
+		return &deferROC{fh, lom.LIF()}, nil
+	}
+	lom.Unlock(false)
+	return nil, cmn.NewErrFailedTo(T, "open", lom.Cname(), err)
+}
+
+// on-disk content as is (compare with NewHandle)
+func (lom *LOM) NewRawHandle() (*LomHandle, error) {
+	debug.Assert(lom.RawMove(), lom.Cname())
+	fh, err := os.Open(lom.FQN)
+	if err != nil {
+		return nil, err
+	}
+	return &LomHandle{LomReader: fh, lom: lom, raw: true}, nil
+}
+
+// to accompany raw content: "<at-rest flags>:<logical size>"
+func (lom *LOM) RawMD() string {
+	return strconv.FormatUint(lom.md.flags&lmflPsize, 16) + ":" + strconv.FormatInt(lom.md.Size, 10)
+}
+
+// logical size given RawMD
+func RawSize(rawMD string) (int64, error) {
+	_, size, err := parseRawMD(rawMD)
+	return size, err
+}
+
+// to be called upon receiving raw content of a given (physical) size
+func (lom *LOM) SetRawMD(rawMD string, psize int64) error {
+	flags, size, err := parseRawMD(rawMD)
+	if err != nil {
+		return fmt.Errorf("%s: %w", lom.Cname(), err)
+	}
+	lom.md.flags = (lom.md.flags &^ lmflPsize) | flags
+	lom.md.psize = psize
+	lom.md.Size = size
+	return nil
+}
+
+func parseRawMD(rawMD string) (flags uint64, size int64, err error) {
+	sflags, ssize, ok := strings.Cut(rawMD, ":")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid raw metadata %q", rawMD)
+	}
+	if flags, err = strconv.ParseUint(sflags, 16, 64); err != nil || flags == 0 || flags&^lmflPsize != 0 {
+		return 0, 0, fmt.Errorf("invalid raw metadata %q (flags)", rawMD)
+	}
+	if size, err = strconv.ParseInt(ssize, 10, 64); err != nil || size < 0 {
+		return 0, 0, fmt.Errorf("invalid raw metadata %q (size)", rawMD)
+	}
+	return flags, size, nil
+}
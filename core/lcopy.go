@@ -346,6 +346,7 @@ func (lom *LOM) _copyChunks(dst *LOM, buf []byte) error {
 			if srcChunk.cksum != nil {
 				dstChunk.SetCksum(srcChunk.cksum.Clone())
 			}
+			dstChunk.flags = srcChunk.flags // (compression, encryption)
 
 			err = dstUfest.Add(dstChunk, srcChunk.Size(), int64(srcChunk.Num()))
 			if err != nil {
@@ -412,9 +413,20 @@ func (lom *LOM) _copy2fqn(dst *LOM, buf []byte, sameBucket bool) (err, nested er
 	}
 
 	workFQN := dst.GenFQN(fs.WorkCT, fs.WorkfileCopy)
-	_, dstCksum, err = cos.CopyFile(lom.FQN, workFQN, buf, dstCksumTy)
-	if err != nil {
-		return err, nil, false
+	if lom.RawMove() {
+		// copy as is (compressed and/or encrypted), checksum the content
+		if _, _, err = cos.CopyFile(lom.FQN, workFQN, buf, cos.ChecksumNone); err != nil {
+			return err, nil, false
+		}
+		if dstCksum, err = lom.zcksum(dstCksumTy); err != nil {
+			nested = cos.RemoveFile(workFQN)
+			return err, nested, false
+		}
+	} else {
+		_, dstCksum, err = cos.CopyFile(lom.FQN, workFQN, buf, dstCksumTy)
+		if err != nil {
+			return err, nil, false
+		}
 	}
 
 	if !sameBucket {
@@ -489,6 +501,12 @@ func (lom *LOM) OpenCopy() (cos.LomReader, string) {
 	if fqn == lom.FQN {
 		return nil, ""
 	}
+	if !lom.IsPlain() {
+		if lh, err := lom.openz(fqn); err == nil {
+			return lh, fqn
+		}
+		return nil, ""
+	}
 	if lh, err := os.Open(fqn); err == nil { // (compare w/ lom.Open())
 		return lh, fqn
 	}
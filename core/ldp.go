@@ -87,7 +87,8 @@ func (lom *LOM) GetROC(latestVer, sync bool) (resp ReadResp) {
 
 	// loaded: check ver
 	if loadErr == nil {
-		if latestVer || sync {
+		// (not checking dirty objects that are yet to be written back - see xs/wback.go)
+		if (latestVer || sync) && !lom.IsDirtyWB() {
 			debug.Assert(bck.IsRemote(), bck.String()) // caller's responsibility
 			crmd := lom.CheckRemoteMD(true /* rlocked*/, sync, nil /*origReq*/)
 			if crmd.Err != nil {
@@ -213,7 +214,7 @@ func (lom *LOM) LoadLatest(latest bool) (oa *cmn.ObjAttrs, deleted bool, err err
 			return nil, false, err
 		}
 	}
-	if latest {
+	if latest && (err != nil || !lom.IsDirtyWB()) {
 		res := lom.CheckRemoteMD(true /*locked*/, false /*synchronize*/, nil /*origReq*/)
 		if res.Eq {
 			debug.AssertNoErr(res.Err)
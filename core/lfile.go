@@ -53,6 +53,7 @@ type (
 	LomHandle struct {
 		cos.LomReader
 		lom *LOM
+		raw bool // on-disk content as is (see NewRawHandle)
 	}
 )
 
@@ -75,7 +76,12 @@ func (lom *LOM) NewHandle(loaded bool) (*LomHandle, error) {
 	return &LomHandle{LomReader: fh, lom: lom}, nil
 }
 
-func (lh *LomHandle) Open() (cos.ReadOpenCloser, error) { return lh.lom.NewHandle(true) }
+func (lh *LomHandle) Open() (cos.ReadOpenCloser, error) {
+	if lh.raw {
+		return lh.lom.NewRawHandle()
+	}
+	return lh.lom.NewHandle(true)
+}
 
 //
 // LOM (open, close, remove) -------------------------------
@@ -88,9 +94,12 @@ var _ io.ReaderAt = (*os.File)(nil) // sanity (whereby UfestReader is guarded el
 // see also: lom.GetROC()
 func (lom *LOM) Open() (lh cos.LomReader, err error) {
 	debug.Assert(lom.IsLocked() > apc.LockNone, lom.Cname(), " is not locked")
-	if lom.IsChunked() {
+	switch {
+	case lom.IsChunked():
 		lh, err = lom.NewUfestReader()
-	} else {
+	case !lom.IsPlain():
+		lh, err = lom.openz(lom.FQN)
+	default:
 		lh, err = os.Open(lom.FQN)
 	}
 	switch {
@@ -112,12 +121,18 @@ func (lom *LOM) Open() (lh cos.LomReader, err error) {
 
 func (lom *LOM) Create() (cos.LomWriter, error) {
 	debug.Assert(lom.IsLocked() == apc.LockWrite, "must be wlocked: ", lom.Cname())
+	lom.SetPlain() // (compare with NewZWriter)
 	return lom._cf(lom.FQN)
 }
 
-func (lom *LOM) CreateWork(wfqn string) (cos.LomWriter, error) { return lom._cf(wfqn) } // -> lom
-func (lom *LOM) CreatePart(wfqn string) (*os.File, error)      { return lom._cf(wfqn) } // TODO -- FIXME: niy
-func (lom *LOM) CreateSlice(wfqn string) (*os.File, error)     { return lom._cf(wfqn) } // --/--
+// plain (neither compressed nor encrypted) unless wrapped via NewZWriter
+func (lom *LOM) CreateWork(wfqn string) (cos.LomWriter, error) {
+	lom.SetPlain()
+	return lom._cf(wfqn)
+}
+
+func (lom *LOM) CreatePart(wfqn string) (*os.File, error)  { return lom._cf(wfqn) } // TODO -- FIXME: niy
+func (lom *LOM) CreateSlice(wfqn string) (*os.File, error) { return lom._cf(wfqn) } // --/--
 
 func (lom *LOM) _cf(fqn string) (fh *os.File, err error) {
 	fh, err = os.OpenFile(fqn, _openFlags, cos.PermRWR)
@@ -206,6 +221,7 @@ func (lom *LOM) RemoveObj(force ...bool) (err error) {
 		return len(force) > 0 && force[0] && locked == apc.LockRead
 	})
 	err = lom.RemoveMain()
+	lom.RemoveArchIdx()
 	for copyFQN := range lom.md.copies {
 		if erc := cos.RemoveFile(copyFQN); erc != nil && !cos.IsNotExist(erc) && err == nil {
 			err = erc
@@ -234,6 +250,7 @@ func (lom *LOM) RenameMainTo(wfqn string) error {
 func (lom *LOM) RenameToMain(wfqn string) error {
 	err := cos.Rename(wfqn, lom.FQN)
 	if err == nil {
+		lom.RemoveArchIdx() // overwritten or appended
 		return nil
 	}
 	if errors.Is(err, syscall.ENOTDIR) && lom.Bck().IsRemote() {
@@ -244,6 +261,7 @@ func (lom *LOM) RenameToMain(wfqn string) error {
 			// recovered - retry just once
 			err = cos.Rename(wfqn, lom.FQN)
 			if err == nil {
+				lom.RemoveArchIdx()
 				nlog.Infoln("self heal ok")
 			} else {
 				nlog.Warningln("self heal fail:", err)
@@ -330,7 +348,7 @@ func (lom *LOM) RenameFinalize(wfqn string) error {
 	return err
 }
 
-// extract a single file from a (.tar, .tgz or .tar.gz, .zip, .tar.lz4) shard
+// extract a single file from a (.tar, .tgz or .tar.gz, .zip, .tar.lz4, .tar.zst) shard
 // uses the provided `mime` or lom.ObjName to detect formatting (empty = auto-detect)
 func (lom *LOM) NewArchpathReader(lh cos.LomReader, archpath, mime string) (csl cos.ReadCloseSizer, err error) {
 	debug.Assert(archpath != "")
@@ -341,6 +359,12 @@ func (lom *LOM) NewArchpathReader(lh cos.LomReader, archpath, mime string) (csl
 	if err != nil {
 		return nil, err
 	}
+	if tidx := lom.ArchIdx(lh, mime); tidx != nil {
+		if csl = tidx.ReadOne(lh, archpath); csl == nil {
+			return nil, cos.NewErrNotFound(T, archpath+" in "+lom.Cname())
+		}
+		return csl, nil
+	}
 
 	var ar archive.Reader
 	ar, err = archive.NewReader(mime, lh, lom.Lsize())
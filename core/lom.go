@@ -49,13 +49,15 @@ const (
 )
 
 type (
-	lmeta struct { // sizeof = 72
+	lmeta struct { // sizeof = 96
 		copies fs.MPI
 		uname  *string
 		cmn.ObjAttrs
 		atimefs uint64 // (high bit `lomDirtyMask` | int64: atime)
 		lid     lomBID // (for bitwise structure, see lombid.go)
-		flags   uint64 // reserve (storage-class, compression/encryption, write-back, etc.)
+		flags   uint64 // compression and encryption (see lcomp.go); reserve (storage-class, write-back, etc.)
+		psize   int64  // physical (on-disk) size iff compressed and/or encrypted
+		hits    uint64 // access counter (LFU and GreedyDual eviction policies only)
 	}
 	LOM struct {
 		mi      *fs.Mountpath
@@ -63,6 +65,7 @@ type (
 		ObjName string
 		FQN     string
 		md      lmeta  // on-disk metadata
+		ssec    []byte // S3 SSE-C customer-provided key (request-scoped; see SetSSEC)
 		digest  uint64 // uname digest
 	}
 )
@@ -152,6 +155,18 @@ func (lom *LOM) setHRW(v bool) {
 	}
 }
 
+// remote bucket with write-back data policy: the object is yet to be written
+// to the remote backend (persistent - callers must PersistMain under wlock)
+func (lom *LOM) IsDirtyWB() bool { return lom.md.flags&lmflDirtyWB != 0 }
+
+func (lom *LOM) SetDirtyWB(v bool) {
+	if v {
+		lom.md.flags |= lmflDirtyWB
+	} else {
+		lom.md.flags &^= lmflDirtyWB
+	}
+}
+
 // given an existing (on-disk) object, determines whether it is a _copy_
 // (compare with isMirror below)
 func (lom *LOM) IsCopy() bool {
@@ -221,6 +236,15 @@ func (lom *LOM) Atime() time.Time      { return time.Unix(0, lom.md.Atime) }
 func (lom *LOM) AtimeUnix() int64      { return lom.md.Atime }
 func (lom *LOM) SetAtimeUnix(tu int64) { lom.md.Atime = tu }
 
+// access counter: maintained only when the bucket's eviction policy
+// requires it (see apc.EvictPolicy.CountsHits); persisted lazily, along with atime
+func (lom *LOM) Hits() uint64 { return lom.md.hits }
+
+func (lom *LOM) IncHits() {
+	lom.md.hits++
+	lom.md.makeDirty()
+}
+
 // Object metadata normalization rules: Last-Modified and ETag
 // The following rules define how AIS derives HTTP-visible object metadata
 // (Last-Modified and ETag) in a consistent way across:
@@ -813,7 +837,7 @@ exist:
 
 	// fstat & atime
 	if !lom.md.lid.haslmfl(lmflChunk) {
-		if lom.md.Size != size { // corruption or tampering
+		if lom.Psize() != size { // corruption or tampering
 			return cmn.NewErrLmetaCorrupted(lom.whingeSize(size))
 		}
 	}
@@ -824,7 +848,7 @@ exist:
 }
 
 func (lom *LOM) whingeSize(size int64) error {
-	return fmt.Errorf("errsize (%d != %d)", lom.md.Size, size)
+	return fmt.Errorf("errsize (%d != %d)", lom.Psize(), size)
 }
 
 //
@@ -100,6 +100,8 @@ const (
 	packedCustom
 	packedLid
 	packedFlags
+	packedPsize
+	packedHits
 )
 
 const (
@@ -111,6 +113,8 @@ const (
 	haveCustom
 	haveLid
 	haveFlags
+	havePsize
+	haveHits
 )
 
 // packing format: separators
@@ -484,6 +488,18 @@ func (md *lmeta) unpack(buf []byte) error {
 			debug.Assert(flags&lmflHRW == 0, "unexpected persisted HRW bit")
 			md.flags = (md.flags & lmflHRW) | (flags &^ lmflHRW)
 			seen |= haveFlags
+		case packedPsize:
+			if seen&havePsize != 0 {
+				return errors.New(badLmeta + " #8")
+			}
+			md.psize = int64(binary.BigEndian.Uint64(record[cos.SizeofI16:]))
+			seen |= havePsize
+		case packedHits:
+			if seen&haveHits != 0 {
+				return errors.New(badLmeta + " #9")
+			}
+			md.hits = binary.BigEndian.Uint64(record[cos.SizeofI16:])
+			seen |= haveHits
 		default:
 			return errors.New(badLmeta + " #101")
 		}
@@ -492,6 +508,9 @@ func (md *lmeta) unpack(buf []byte) error {
 	if seen&haveSize != haveSize {
 		return errors.New(badLmeta + " #103")
 	}
+	if (md.flags&lmflPsize != 0) != (seen&havePsize != 0) {
+		return errors.New(badLmeta + " #104")
+	}
 	return md._setCksum(cksumType, cksumValue, seen&haveCksumT != 0, seen&haveCksumV != 0)
 }
 
@@ -538,6 +557,20 @@ func (md *lmeta) pack(mdSize int64) (buf []byte) {
 	binary.BigEndian.PutUint64(b8[:], flags)
 	buf = _prb(buf, b8[:], packedFlags)
 
+	// physical size (compressed and/or encrypted only)
+	if md.flags&lmflPsize != 0 {
+		binary.BigEndian.PutUint64(b8[:], uint64(md.psize))
+		buf = g.smm.AppendBytes(buf, recdupSepa[:])
+		buf = _prb(buf, b8[:], packedPsize)
+	}
+
+	// access counter (LFU and GreedyDual only)
+	if md.hits > 0 {
+		binary.BigEndian.PutUint64(b8[:], md.hits)
+		buf = g.smm.AppendBytes(buf, recdupSepa[:])
+		buf = _prb(buf, b8[:], packedHits)
+	}
+
 	// copies
 	if len(md.copies) > 0 {
 		buf = g.smm.AppendBytes(buf, recdupSepa[:])
@@ -630,6 +663,7 @@ func _pcustom(buf []byte, md cos.StrKVs) []byte {
 
 // copy atime _iff_ valid and more recent
 func (md *lmeta) cpAtime(from *lmeta) {
+	md.hits = max(md.hits, from.hits)
 	if !cos.IsValidAtime(from.Atime) {
 		return
 	}
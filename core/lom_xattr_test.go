@@ -5,8 +5,10 @@
 package core_test
 
 import (
+	"archive/tar"
 	"bytes"
 	"encoding/binary"
+	"errors"
 	"io"
 	"os"
 	"path/filepath"
@@ -14,6 +16,7 @@ import (
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
@@ -33,10 +36,12 @@ var _ = Describe("LOM Xattributes", func() {
 
 		bucketLocal  = "LOM_TEST_Local"
 		bucketCached = "LOM_TEST_Cached"
+		bucketCompr  = "LOM_TEST_Compr"
 	)
 
 	localBck := cmn.Bck{Name: bucketLocal, Provider: apc.AIS, Ns: cmn.NsGlobal}
 	cachedBck := cmn.Bck{Name: bucketCached, Provider: apc.AIS, Ns: cmn.NsGlobal}
+	comprBck := cmn.Bck{Name: bucketCompr, Provider: apc.AIS, Ns: cmn.NsGlobal}
 
 	var (
 		copyMpathInfo *fs.Mountpath
@@ -54,6 +59,14 @@ var _ = Describe("LOM Xattributes", func() {
 					BID:         202,
 				},
 			),
+			meta.NewBck(
+				bucketCompr, apc.AIS, cmn.NsGlobal,
+				&cmn.Bprops{
+					Cksum:       cmn.CksumConf{Type: cos.ChecksumOneXxh},
+					Compression: cmn.CompressionConf{Algo: apc.CompressZstd},
+					BID:         203,
+				},
+			),
 		)
 	)
 
@@ -334,6 +347,203 @@ var _ = Describe("LOM Xattributes", func() {
 			})
 		})
 
+		Describe("access counter", func() {
+			It("should persist hits and read them back", func() {
+				lom := filePut(localFQN, testFileSize)
+				lom.Lock(true)
+				defer lom.Unlock(true)
+				Expect(lom.Hits()).To(BeZero())
+				for range 3 {
+					lom.IncHits()
+				}
+				Expect(persist(lom)).NotTo(HaveOccurred())
+
+				hrwLom := &core.LOM{ObjName: testObjectName}
+				Expect(hrwLom.InitCmnBck(&localBck)).NotTo(HaveOccurred())
+				hrwLom.UncacheUnless()
+
+				newLom := newBasicLom(localFQN)
+				Expect(newLom.Load(false, true)).NotTo(HaveOccurred())
+				Expect(newLom.Hits()).To(BeEquivalentTo(3))
+				Expect(newLom.Lsize()).To(BeEquivalentTo(testFileSize))
+			})
+		})
+
+		Describe("write-back", func() {
+			It("should persist and clear not-yet-written-back flag", func() {
+				lom := filePut(localFQN, testFileSize)
+				lom.Lock(true)
+				defer lom.Unlock(true)
+				Expect(lom.IsDirtyWB()).To(BeFalse())
+				lom.SetDirtyWB(true)
+				Expect(persist(lom)).NotTo(HaveOccurred())
+
+				newLom := newBasicLom(localFQN)
+				Expect(newLom.LoadMetaFromFS()).NotTo(HaveOccurred())
+				Expect(newLom.IsDirtyWB()).To(BeTrue())
+				Expect(newLom.IsPlain()).To(BeTrue())
+
+				lom.SetDirtyWB(false)
+				Expect(persist(lom)).NotTo(HaveOccurred())
+				newLom = newBasicLom(localFQN)
+				Expect(newLom.LoadMetaFromFS()).NotTo(HaveOccurred())
+				Expect(newLom.IsDirtyWB()).To(BeFalse())
+			})
+		})
+
+		Describe("compression", func() {
+			It("should persist compressed object and read it back", func() {
+				var (
+					comprFQN = mix.MakePathFQN(&comprBck, fs.ObjCT, testObjectName)
+					data     = bytes.Repeat([]byte("compressible content "), 100_000)
+				)
+				lom := newBasicLom(comprFQN)
+				lom.Lock(true)
+				defer lom.Unlock(true)
+				fh, err := cos.CreateFile(comprFQN)
+				Expect(err).NotTo(HaveOccurred())
+				lmfh, err := lom.NewZWriter(fh, int64(len(data)))
+				Expect(err).NotTo(HaveOccurred())
+				_, err = lmfh.Write(data)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(lmfh.Close()).NotTo(HaveOccurred())
+				lom.SetSize(int64(len(data)))
+				Expect(persist(lom)).NotTo(HaveOccurred())
+
+				Expect(lom.IsCompressed()).To(BeTrue())
+				Expect(lom.Psize()).To(BeNumerically("<", len(data)))
+
+				newLom := newBasicLom(comprFQN)
+				Expect(newLom.Load(false, true)).NotTo(HaveOccurred())
+				Expect(newLom.Compression()).To(Equal(apc.CompressZstd))
+				Expect(newLom.Lsize()).To(BeEquivalentTo(len(data)))
+				Expect(newLom.Psize()).To(Equal(lom.Psize()))
+
+				lh, err := newLom.Open()
+				Expect(err).NotTo(HaveOccurred())
+				b, err := io.ReadAll(lh)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(lh.Close()).NotTo(HaveOccurred())
+				Expect(bytes.Equal(b, data)).To(BeTrue())
+
+				// range read
+				b = make([]byte, 1000)
+				lh, err = newLom.Open()
+				Expect(err).NotTo(HaveOccurred())
+				_, err = lh.ReadAt(b, 300_000)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(lh.Close()).NotTo(HaveOccurred())
+				Expect(bytes.Equal(b, data[300_000:301_000])).To(BeTrue())
+			})
+		})
+
+		Describe("archive", func() {
+			It("should list compressed archive via LOM handle", func() {
+				var (
+					archFQN = mix.MakePathFQN(&comprBck, fs.ObjCT, "arch/shard.tar")
+					buf     bytes.Buffer
+					tw      = tar.NewWriter(&buf)
+					names   = []string{"a.txt", "b/c.txt", "d.txt"}
+					content = bytes.Repeat([]byte("archived content "), 10_000)
+				)
+				for _, name := range names {
+					Expect(tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content))})).NotTo(HaveOccurred())
+					_, err := tw.Write(content)
+					Expect(err).NotTo(HaveOccurred())
+				}
+				Expect(tw.Close()).NotTo(HaveOccurred())
+
+				lom := newBasicLom(archFQN)
+				lom.Lock(true)
+				fh, err := cos.CreateFile(archFQN)
+				Expect(err).NotTo(HaveOccurred())
+				lmfh, err := lom.NewZWriter(fh, int64(buf.Len()))
+				Expect(err).NotTo(HaveOccurred())
+				_, err = lmfh.Write(buf.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(lmfh.Close()).NotTo(HaveOccurred())
+				lom.SetSize(int64(buf.Len()))
+				Expect(persist(lom)).NotTo(HaveOccurred())
+				lom.Unlock(true)
+				Expect(lom.IsCompressed()).To(BeTrue())
+
+				// on-disk bytes are not a TAR
+				_, err = archive.List(archFQN)
+				Expect(err).To(HaveOccurred())
+
+				newLom := newBasicLom(archFQN)
+				Expect(newLom.Load(false, false)).NotTo(HaveOccurred())
+				lst, err := newLom.ListArch()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(lst).To(HaveLen(len(names)))
+				for i, e := range lst {
+					Expect(e.Name).To(Equal(names[i]))
+					Expect(e.Size).To(BeEquivalentTo(len(content)))
+				}
+			})
+		})
+
+		Describe("encryption", func() {
+			It("should persist compressed and encrypted (SSE-C) object and read it back", func() {
+				var (
+					encFQN = mix.MakePathFQN(&comprBck, fs.ObjCT, testObjectName+".enc")
+					data   = bytes.Repeat([]byte("encrypted content "), 50_000)
+					ssec   = bytes.Repeat([]byte{0x5a}, 32)
+				)
+				lom := newBasicLom(encFQN)
+				lom.Lock(true)
+				defer lom.Unlock(true)
+				lom.SetSSEC(ssec)
+				fh, err := cos.CreateFile(encFQN)
+				Expect(err).NotTo(HaveOccurred())
+				lmfh, err := lom.NewZWriter(fh, int64(len(data)))
+				Expect(err).NotTo(HaveOccurred())
+				_, err = lmfh.Write(data)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(lmfh.Close()).NotTo(HaveOccurred())
+				lom.SetSize(int64(len(data)))
+				Expect(persist(lom)).NotTo(HaveOccurred())
+
+				Expect(lom.IsCompressed()).To(BeTrue())
+				Expect(lom.IsEncrypted()).To(BeTrue())
+				Expect(lom.RawMove()).To(BeTrue())
+				ondisk, err := os.ReadFile(encFQN)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(bytes.Contains(ondisk, data[:1000])).To(BeFalse())
+
+				// no key or wrong key
+				newLom := newBasicLom(encFQN)
+				Expect(newLom.Load(false, true)).NotTo(HaveOccurred())
+				Expect(newLom.IsEncrypted()).To(BeTrue())
+				Expect(newLom.Lsize()).To(BeEquivalentTo(len(data)))
+				_, err = newLom.Open()
+				Expect(errors.Is(err, core.ErrSSECKey)).To(BeTrue())
+				newLom.SetSSEC(bytes.Repeat([]byte{0x5b}, 32))
+				_, err = newLom.Open()
+				Expect(errors.Is(err, core.ErrSSECKey)).To(BeTrue())
+
+				// same key
+				newLom.SetSSEC(ssec)
+				lh, err := newLom.Open()
+				Expect(err).NotTo(HaveOccurred())
+				b, err := io.ReadAll(lh)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(lh.Close()).NotTo(HaveOccurred())
+				Expect(bytes.Equal(b, data)).To(BeTrue())
+
+				// raw metadata round trip
+				rawMD := newLom.RawMD()
+				size, err := core.RawSize(rawMD)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(size).To(BeEquivalentTo(len(data)))
+				other := newBasicLom(encFQN)
+				Expect(other.SetRawMD(rawMD, newLom.Psize())).NotTo(HaveOccurred())
+				Expect(other.IsCompressed() && other.IsEncrypted()).To(BeTrue())
+				Expect(other.Lsize()).To(BeEquivalentTo(len(data)))
+				Expect(other.SetRawMD("0:1", 1)).To(HaveOccurred())
+			})
+		})
+
 		Describe("LoadMetaFromFS", func() {
 			It("should read fresh meta from fs", func() {
 				createTestFile(localFQN, testFileSize)
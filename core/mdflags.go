@@ -68,7 +68,11 @@ func (lid lomBID) clrlmfl(fl lomFlags) lomBID {
 //
 
 const (
-	lmflHRW = uint64(1) << 63 // high bit: object is at HRW location (runtime-only)
+	lmflComprMask = uint64(0x3)                   // low bits: at-rest compression algorithm (enum compress.Algo)
+	lmflEncrypted = uint64(1) << 2                // at-rest encryption (see cmn/encrypt)
+	lmflPsize     = lmflComprMask | lmflEncrypted // physical size differs from logical (lmeta.psize)
+	lmflDirtyWB   = uint64(1) << 3                // write-back: not yet written to remote backend (see xact/xs/wback.go)
+	lmflHRW       = uint64(1) << 63               // high bit: object is at HRW location (runtime-only)
 )
 
 // runtime-only bits may need a (future) mask, e.g.:
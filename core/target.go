@@ -30,12 +30,14 @@ type (
 		Atime       time.Time
 		Xact        Xact
 		WorkTag     string // (=> work fqn)
+		RawMD       string // moving compressed and/or encrypted content as is (see LOM.RawMD)
 		Size        int64
 		ChunkSize   int64 // if set, the object will be chunked with this size regardless of the bucket's chunk properties
 		OWT         cmn.OWT
 		SkipEC      bool // don't erasure-code when finalizing
 		SkipBackend bool // don't write to backend (e.g., cold-GET caching, rechunk)
 		Locked      bool // true if the LOM is already locked by the caller
+		DirtyWB     bool // migrated content that is yet to be written back (see xs.XactWB)
 	}
 	PromoteParams struct {
 		Bck             *meta.Bck   // destination bucket
@@ -122,7 +122,7 @@ type (
 		MD5   []byte     // ditto
 		size  int64      // this chunk size
 		num   uint16     // chunk/part number
-		flags uint16     // bit flags (compression; future use)
+		flags uint16     // bit flags (compression, encryption; future use)
 	}
 	Ufest struct {
 		created         time.Time      // creation time
@@ -986,7 +986,7 @@ func (u *Ufest) ETagS3() (string, error) {
 
 // WholeChecksum returns the whole-object checksum using the best available method:
 // 1. Streaming checksum (if parts arrived in order)
-// 2. CRC32C combination (if all chunks have CRC32C)
+// 2. CRC combination (if all chunks have CRC32C or, alternatively, CRC64NVME)
 // 3. Re-read all chunks (last resort)
 func (u *Ufest) WholeChecksum() (*cos.Cksum, error) {
 	// Try streaming checksum first (if parts arrived in order and not abandoned)
@@ -994,8 +994,8 @@ func (u *Ufest) WholeChecksum() (*cos.Cksum, error) {
 		return cksum, nil
 	}
 
-	// Fall back to CRC32C combination
-	if cksum, err := u.combineCRC32C(); err == nil {
+	// Fall back to CRC combination
+	if cksum, err := u.combineCRC(); err == nil {
 		return cksum, nil
 	}
 
@@ -1007,21 +1007,33 @@ func (u *Ufest) WholeChecksum() (*cos.Cksum, error) {
 	return &cksumH.Cksum, nil
 }
 
-func (u *Ufest) combineCRC32C() (*cos.Cksum, error) {
+func (u *Ufest) combineCRC() (*cos.Cksum, error) {
 	debug.AssertNoErr(u.Check(true /*completed*/))
 	if u.count == 0 {
 		return nil, errNoChunks
 	}
-	var combined uint32
+	ty := u.chunks[0].cksum.Type()
+	if !cos.IsCRC(ty) {
+		return nil, fmt.Errorf("chunk %d missing CRC checksum", u.chunks[0].num)
+	}
+	var combined uint64
 	for i := range u.count {
 		c := &u.chunks[i]
-		if c.cksum == nil || c.cksum.Type() != cos.ChecksumCRC32C {
-			return nil, fmt.Errorf("chunk %d missing CRC32C checksum", c.num)
+		if c.cksum == nil || c.cksum.Type() != ty {
+			return nil, fmt.Errorf("chunk %d missing %s checksum", c.num, ty)
+		}
+		if ty == cos.ChecksumCRC32C {
+			crc, _ := strconv.ParseUint(c.cksum.Value(), 16, 32)
+			combined = uint64(cos.CRC32CCombine(uint32(combined), uint32(crc), c.size))
+		} else {
+			crc, _ := strconv.ParseUint(c.cksum.Value(), 16, 64)
+			combined = cos.CRC64NVMECombine(combined, crc, c.size)
 		}
-		crc, _ := strconv.ParseUint(c.cksum.Value(), 16, 32)
-		combined = cos.CRC32CCombine(combined, uint32(crc), c.size)
 	}
-	return cos.NewCksum(cos.ChecksumCRC32C, fmt.Sprintf("%08x", combined)), nil
+	if ty == cos.ChecksumCRC32C {
+		return cos.NewCksum(ty, fmt.Sprintf("%08x", combined)), nil
+	}
+	return cos.NewCksum(ty, fmt.Sprintf("%016x", combined)), nil
 }
 
 // reread all chunk payloads to compute a checksum of the given type
@@ -1041,7 +1053,7 @@ func (u *Ufest) ComputeWholeChecksum(cksumH *cos.CksumHash) error {
 	for i := range u.count {
 		c := &u.chunks[i]
 
-		fh, err := os.Open(c.path)
+		fh, err := c.Open()
 		if err != nil {
 			fs.CleanPathErr(err)
 			return fmt.Errorf("%s %s chunk %d: open: %w", tag, u._rtag(), c.num, err)
@@ -1338,6 +1350,10 @@ func (lom *LOM) CompleteUfest(u *Ufest, locked bool) (err error) {
 	}
 
 	lom.SetSize(u.size)
+	if err := lom.setChunkedAtRest(u); err != nil {
+		u.Abort(lom)
+		return err
+	}
 	if err := u.storeCompleted(lom, false /*override*/); err != nil {
 		u.Abort(lom)
 		return err
@@ -1392,7 +1408,7 @@ type (
 		// parent
 		u *Ufest
 		// chunk
-		cfh  *os.File
+		cfh  cos.LomReader
 		coff int64
 		cidx int
 		// global
@@ -1435,7 +1451,7 @@ func (r *UfestReader) Read(p []byte) (n int, err error) {
 		// open on demand
 		if r.cfh == nil {
 			debug.Assert(r.coff == 0)
-			r.cfh, err = os.Open(c.path)
+			r.cfh, err = c.Open()
 			if err != nil {
 				return n, fmt.Errorf("%s: failed to open chunk (%d/%d)", r.u._rtag(), r.cidx+1, u.count)
 			}
@@ -1530,7 +1546,7 @@ func (r *UfestReader) ReadAt(p []byte, off int64) (n int, err error) {
 		c := &u.chunks[idx]
 		debug.Assert(c.size-chunkoff > 0, c.size, " vs ", chunkoff)
 		toRead := min(int64(total-n), c.size-chunkoff)
-		fh, err := os.Open(c.path)
+		fh, err := c.Open()
 		if err != nil {
 			return n, fmt.Errorf("%s: failed to open chunk (%d/%d)", r.u._rtag(), idx+1, u.count)
 		}
@@ -1637,6 +1653,9 @@ func (u *Ufest) Relocate(hrwMi *fs.Mountpath, buf []byte) (*LOM, error) {
 
 	// persist parent LOM
 	hlom.SetSize(u.size)
+	if err := hlom.setChunkedAtRest(u); err != nil {
+		return nil, err
+	}
 	hlom.setlmfl(lmflChunk)
 	if err := hlom.PersistMain(true /*chunked*/); err != nil {
 		return nil, err
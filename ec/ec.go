@@ -150,6 +150,7 @@ type (
 		Reader     io.Reader  // CT content
 		BID        uint64     // bucket ID
 		Cksum      *cos.Cksum // object checksum
+		RawMD      string     // compressed and/or encrypted replica (Metadata.RawMD)
 		Generation int64      // EC Generation
 		Xact       core.Xact  // xaction that drives it
 	}
@@ -410,7 +411,8 @@ func RequestECMeta(bck *cmn.Bck, objName string, si *meta.Snode, client *http.Cl
 }
 
 // Saves the main replica to local drives
-func writeObject(lom *core.LOM, reader io.Reader, size int64, xctn core.Xact) error {
+func writeObject(lom *core.LOM, args *WriteArgs, size int64) error {
+	reader := args.Reader
 	if size > 0 {
 		reader = io.LimitReader(reader, size)
 	}
@@ -422,9 +424,14 @@ func writeObject(lom *core.LOM, reader io.Reader, size int64, xctn core.Xact) er
 		params.SkipEC = true
 		params.Atime = time.Now()
 		params.Size = size
-		params.Xact = xctn
+		params.Xact = args.Xact
 		params.OWT = cmn.OwtRebalance
 	}
+	if args.RawMD != "" {
+		// at-rest content as is; the object checksum arrives with it
+		params.RawMD = args.RawMD
+		params.Cksum = args.Cksum
+	}
 	err := core.T.PutObject(lom, params)
 	core.FreePutParams(params)
 	return err
@@ -496,7 +503,7 @@ func WriteReplicaAndMeta(lom *core.LOM, args *WriteArgs) error {
 	lom.Unlock(false)
 
 	// replica
-	if err := writeObject(lom, args.Reader, lom.Lsize(true), args.Xact); err != nil {
+	if err := writeObject(lom, args, lom.Lsize(true)); err != nil {
 		return err
 	}
 	if !cos.NoneC(args.Cksum) && !lom.EqCksum(args.Cksum) {
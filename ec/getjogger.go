@@ -13,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/NVIDIA/aistore/bnotif"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -142,7 +143,9 @@ func (c *getJogger) ec(req *request) {
 	}
 	if err == nil {
 		c.parent.stats.updateObjTime(time.Since(req.putTime))
-		err = ctx.lom.Persist()
+		if err = ctx.lom.Persist(); err == nil {
+			bnotif.Notify(ctx.lom, cmn.NotifECRecover)
+		}
 	}
 	c.freeCtx(ctx)
 	c.finalizeReq(req, err)
@@ -185,7 +188,11 @@ func (c *getJogger) copyMissingReplicas(ctx *restoreCtx, reader cos.ReadOpenClos
 	case *memsys.SGL:
 		srcReader = memsys.NewReader(r)
 	case *core.LomHandle:
-		srcReader, err = ctx.lom.NewHandle(true /*loaded*/)
+		if ctx.meta.RawMD != "" {
+			srcReader, err = ctx.lom.NewRawHandle()
+		} else {
+			srcReader, err = ctx.lom.NewHandle(true /*loaded*/)
+		}
 	default:
 		debug.FailTypeCast(reader)
 		err = fmt.Errorf("unsupported reader type: %T", reader)
@@ -207,7 +214,7 @@ func (c *getJogger) copyMissingReplicas(ctx *restoreCtx, reader cos.ReadOpenClos
 	}
 	src := &dataSource{
 		reader:   srcReader,
-		size:     ctx.lom.Lsize(),
+		size:     ctx.meta.Size,
 		metadata: ctx.meta,
 		reqType:  reqPut,
 	}
@@ -252,6 +259,7 @@ func (c *getJogger) restoreReplicaFromMem(ctx *restoreCtx) error {
 		Reader:     memsys.NewReader(writer),
 		MD:         ctx.meta.NewPack(),
 		Cksum:      cos.NewCksum(ctx.meta.CksumType, ctx.meta.CksumValue),
+		RawMD:      ctx.meta.RawMD,
 		Generation: ctx.meta.Generation,
 		Xact:       c.parent,
 	}
@@ -327,6 +335,11 @@ loop: //nolint:gocritic // keeping label for readability
 	if err := ctx.lom.RenameFinalize(tmpFQN); err != nil {
 		return err
 	}
+	if ctx.meta.RawMD != "" {
+		if err := ctx.lom.SetRawMD(ctx.meta.RawMD, size); err != nil {
+			return err
+		}
+	}
 	if err := ctx.lom.Persist(); err != nil {
 		return err
 	}
@@ -645,9 +658,14 @@ func (c *getJogger) restoreMainObj(ctx *restoreCtx) ([]*slice, error) {
 		Reader:     src.mr,
 		MD:         mainMeta.NewPack(),
 		Cksum:      cos.NewCksum(cksumType, ""),
+		RawMD:      mainMeta.RawMD,
 		Generation: mainMeta.Generation,
 		Xact:       c.parent,
 	}
+	if mainMeta.RawMD != "" {
+		// (restored ciphertext and/or compressed content: not checksummed locally)
+		args.Cksum = cos.NewCksum(mainMeta.CksumType, mainMeta.ObjCksum)
+	}
 	err = WriteReplicaAndMeta(ctx.lom, args)
 	src.Close()
 	closeReaders(readers)
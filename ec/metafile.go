@@ -17,11 +17,15 @@ import (
 	onexxh "github.com/OneOfOne/xxhash"
 )
 
-const MDVersionLast = 1 // current version of metadata
+const (
+	mdVersion1    = 1
+	MDVersionLast = 2 // current version of metadata (v2: RawMD)
+)
 
 // Metadata - EC information stored in metafiles for every encoded object
 type Metadata struct {
 	Size        int64            `json:"obj_size"`      // obj size (after EC'ing sum size of slices differs from the original)
+	RawMD       string           `json:"raw_md"`        // compressed and/or encrypted object: encoded as is (see core.LOM.RawMD)
 	Generation  int64            `json:"generation"`    // Timestamp when the object was EC'ed
 	ObjCksum    string           `json:"obj_cksum"`     // checksum of the original object
 	ObjVersion  string           `json:"obj_version"`   // object version
@@ -126,10 +130,14 @@ func (md *Metadata) Unpack(unpacker *cos.ByteUnpack) (err error) {
 	}
 	switch md.MDVersion {
 	case MDVersionLast:
-		err = md.unpackLastVersion(unpacker)
+		if err = md.unpackV1(unpacker); err == nil {
+			md.RawMD, err = unpacker.ReadString()
+		}
+	case mdVersion1:
+		err = md.unpackV1(unpacker)
 	default:
-		err = fmt.Errorf("unsupported metadata format version %d. Only %d supported",
-			md.MDVersion, MDVersionLast)
+		err = fmt.Errorf("unsupported metadata format version %d (expecting %d or %d)",
+			md.MDVersion, mdVersion1, MDVersionLast)
 	}
 	if err != nil {
 		return
@@ -146,7 +154,7 @@ func (md *Metadata) Unpack(unpacker *cos.ByteUnpack) (err error) {
 	return err
 }
 
-func (md *Metadata) unpackLastVersion(unpacker *cos.ByteUnpack) (err error) {
+func (md *Metadata) unpackV1(unpacker *cos.ByteUnpack) (err error) {
 	var i16 uint16
 	if md.Generation, err = unpacker.ReadInt64(); err != nil {
 		return err
@@ -202,6 +210,9 @@ func (md *Metadata) Pack(packer *cos.BytePack) {
 	packer.WriteString(md.CksumType)
 	packer.WriteString(md.CksumValue)
 	packer.WriteMapStrUint16(md.Daemons)
+	if md.MDVersion >= MDVersionLast {
+		packer.WriteString(md.RawMD)
+	}
 	h := onexxh.Checksum64S(packer.Bytes(), cos.MLCG32)
 	packer.WriteUint64(h)
 }
@@ -214,5 +225,13 @@ func (md *Metadata) PackedSize() int {
 	return cos.SizeofI32 + cos.SizeofI64*2 + cos.SizeofI16*3 + 1 /*isCopy*/ +
 		cos.PackedStrLen(md.ObjCksum) + cos.PackedStrLen(md.ObjVersion) +
 		cos.PackedStrLen(md.CksumType) + cos.PackedStrLen(md.CksumValue) +
-		cos.PackedStrLen(md.FullReplica) + daemonListSz + cos.SizeofI64 /*md cksum*/
+		cos.PackedStrLen(md.FullReplica) + daemonListSz + cos.SizeofI64 /*md cksum*/ +
+		md.rawMDLen()
+}
+
+func (md *Metadata) rawMDLen() int {
+	if md.MDVersion >= MDVersionLast {
+		return cos.PackedStrLen(md.RawMD)
+	}
+	return 0
 }
@@ -251,6 +251,11 @@ func (c *putJogger) encode(req *request, lom *core.LOM) error {
 		FullReplica: core.T.SID(),
 		Daemons:     make(cos.MapStrUint16, reqTargets),
 	}
+	// compressed and/or encrypted: encode (and later restore) on-disk content as is
+	if lom.RawMove() {
+		md.Size = lom.Psize()
+		md.RawMD = lom.RawMD()
+	}
 
 	c.parent.LomAdd(lom)
 
@@ -301,12 +306,16 @@ func (*putJogger) newCtx(lom *core.LOM, md *Metadata) (ctx *encodeCtx, err error
 	ctx.md = md
 
 	totalCnt := ctx.paritySlices + ctx.dataSlices
-	ctx.sliceSize = SliceSize(ctx.lom.Lsize(), ctx.dataSlices)
+	ctx.sliceSize = SliceSize(md.Size, ctx.dataSlices)
 	ctx.slices = make([]*slice, totalCnt)
-	ctx.padSize = ctx.sliceSize*int64(ctx.dataSlices) - ctx.lom.Lsize()
+	ctx.padSize = ctx.sliceSize*int64(ctx.dataSlices) - md.Size
 	debug.Assert(ctx.padSize >= 0)
 
-	ctx.lh, err = lom.NewHandle(false /*loaded*/)
+	if md.RawMD != "" {
+		ctx.lh, err = lom.NewRawHandle()
+	} else {
+		ctx.lh, err = lom.NewHandle(false /*loaded*/)
+	}
 	return ctx, err
 }
 
@@ -361,7 +370,7 @@ func (c *putJogger) createCopies(ctx *encodeCtx) error {
 	// broadcast the replica to the targets
 	src := &dataSource{
 		reader:   ctx.lh,
-		size:     ctx.lom.Lsize(),
+		size:     ctx.md.Size,
 		metadata: ctx.md,
 		reqType:  reqPut,
 	}
@@ -405,7 +414,7 @@ func generateSlicesToMemory(ctx *encodeCtx) error {
 func initializeSlices(ctx *encodeCtx) (err error) {
 	// readers are slices of original object(no memory allocated)
 	cksmReaders := make([]io.Reader, ctx.dataSlices)
-	sizeLeft := ctx.lom.Lsize()
+	sizeLeft := ctx.md.Size
 	for i := range ctx.dataSlices {
 		var (
 			reader     cos.ReadOpenCloser
@@ -216,6 +216,7 @@ func (r *XactRespond) dispatchResp(iReq intraReq, hdr *transport.ObjHdr, object
 					Reader:     object,
 					MD:         mdbytes,
 					Cksum:      hdr.ObjAttrs.Cksum,
+					RawMD:      md.RawMD,
 					BID:        iReq.bid,
 					Generation: md.Generation,
 					Xact:       r,
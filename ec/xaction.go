@@ -128,7 +128,20 @@ func newReplicaResponse(attrs *cmn.ObjAttrs, bck *meta.Bck, objName string) (cos
 		return nil, err
 	}
 	lom.Lock(false)
-	reader, err := lom.NewDeferROC(false /*loaded*/)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		lom.Unlock(false)
+		return nil, err
+	}
+	var (
+		reader cos.ReadOpenCloser
+		err    error
+	)
+	if lom.RawMove() {
+		// compressed and/or encrypted: as is (see Metadata.RawMD)
+		reader, err = lom.NewDeferRawROC()
+	} else {
+		reader, err = lom.NewDeferROC(true /*loaded*/)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -136,6 +149,9 @@ func newReplicaResponse(attrs *cmn.ObjAttrs, bck *meta.Bck, objName string) (cos
 		return nil, nil
 	}
 	attrs.Size = lom.Lsize()
+	if lom.RawMove() {
+		attrs.Size = lom.Psize()
+	}
 	attrs.CopyVersion(lom.ObjAttrs())
 	attrs.Atime = lom.AtimeUnix()
 	attrs.Cksum = lom.Checksum()
@@ -22,6 +22,8 @@ const (
 	MD5          = "md5"          // compare md5(name)
 	Shuffle      = "shuffle"      // random shuffle (use with the same seed to reproduce)
 	Content      = "content"      // extract (int, string, float) from a given file, and compare
+	Composite    = "composite"    // multi-level: compare the first key, then the second, etc. (see SortKey)
+	Stratified   = "stratified"   // random shuffle that keeps classes (see SortKey) balanced across output shards
 )
 
 type Algorithm struct {
@@ -42,6 +44,20 @@ type Algorithm struct {
 	// ditto: Content only
 	// `shard.contentKeyTypes` enum values: {"int", "string", "float" }
 	ContentKeyType string `json:"content_key_type"`
+
+	// usage: Composite (one or more keys, in the order of precedence)
+	// and Stratified (exactly one key that determines the record's class)
+	Keys []SortKey `json:"keys,omitempty"`
+}
+
+// SortKey is a single level of a composite key: the value is read from the record's
+// file with the given extension - either the entire content or, if `JSONPath` is specified,
+// a given field of the JSON document, e.g. "speaker.id" or "segments.0.duration"
+type SortKey struct {
+	Ext        string `json:"extension" yaml:"extension"`
+	Type       string `json:"type" yaml:"type"`                               // same enum as Algorithm.ContentKeyType
+	JSONPath   string `json:"json_path,omitempty" yaml:"json_path,omitempty"` // dot-separated; array elements by index
+	Decreasing bool   `json:"decreasing,omitempty" yaml:"decreasing,omitempty"`
 }
 
 // RequestSpec defines the user specification for requests to the endpoint /v1/sort.
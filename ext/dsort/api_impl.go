@@ -11,7 +11,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 )
 
-var algorithms = []string{algDefault, Alphanumeric, MD5, Shuffle, Content, None}
+var algorithms = []string{algDefault, Alphanumeric, MD5, Shuffle, Content, Composite, Stratified, None}
 
 type parsedInputTemplate struct {
 	Template cos.ParsedTemplate `json:"template"`
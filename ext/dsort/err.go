@@ -25,6 +25,7 @@ const (
 
 var (
 	errAlgExt            = errors.New("algorithm: invalid extension")
+	errAlgKeys           = errors.New("algorithm: invalid sort keys")
 	errNegConcLimit      = errors.New("negative concurrency limit")
 	errMissingOutputSize = errors.New("output shard size must be set (cannot be 0 and cannot be omitted)")
 	errMissingSrcBucket  = errors.New("missing source bucket")
@@ -484,6 +484,8 @@ func (m *Manager) setRW() (err error) {
 	switch m.Pars.Algorithm.Kind {
 	case Content:
 		ke, err = shard.NewContentKeyExtractor(m.Pars.Algorithm.ContentKeyType, m.Pars.Algorithm.Ext)
+	case Composite, Stratified:
+		ke, err = shard.NewCompositeKeyExtractor(m.Pars.Algorithm.keyLevels())
 	case MD5:
 		ke, err = shard.NewMD5KeyExtractor()
 	default:
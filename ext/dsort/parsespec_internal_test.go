@@ -293,6 +293,29 @@ var _ = Describe("RequestSpec", func() {
 			_, err = rs.parse()
 			Expect(err).ShouldNot(HaveOccurred())
 		})
+
+		It("should parse spec with composite keys", func() {
+			rs := RequestSpec{
+				InputBck:        cmn.Bck{Name: "test"},
+				InputExtension:  archive.ExtTar,
+				InputFormat:     newInputFormat("prefix-{0010..0111}-suffix"),
+				OutputFormat:    "prefix-{0010..0111}-suffix",
+				OutputShardSize: "10KB",
+				Algorithm: Algorithm{
+					Kind: Composite,
+					Keys: []SortKey{
+						{Ext: ".json", JSONPath: "speaker.id"},
+						{Ext: " .cls ", Type: "float", Decreasing: true},
+					},
+				},
+			}
+			pars, err := rs.parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(pars.Algorithm.Keys).To(Equal([]SortKey{
+				{Ext: ".json", Type: "string", JSONPath: "speaker.id"},
+				{Ext: ".cls", Type: "float", Decreasing: true},
+			}))
+		})
 	})
 
 	Context("request specs which shall NOT pass", func() {
@@ -492,6 +515,27 @@ var _ = Describe("RequestSpec", func() {
 			_, err := rs.parse()
 			Expect(err).Should(HaveOccurred())
 		})
+
+		DescribeTable("should fail due to invalid sort keys",
+			func(alg Algorithm) {
+				rs := RequestSpec{
+					InputBck:        cmn.Bck{Name: "test"},
+					InputExtension:  archive.ExtTar,
+					InputFormat:     newInputFormat("prefix-{0010..0111}-suffix"),
+					OutputFormat:    "prefix-{0010..0111}-suffix",
+					OutputShardSize: "10KB",
+					Algorithm:       alg,
+				}
+				_, err := rs.parse()
+				Expect(err).Should(HaveOccurred())
+			},
+			Entry("no keys", Algorithm{Kind: Composite}),
+			Entry("keys with a different algorithm", Algorithm{Kind: Shuffle, Keys: []SortKey{{Ext: ".cls"}}}),
+			Entry("invalid extension", Algorithm{Kind: Composite, Keys: []SortKey{{Ext: "cls"}}}),
+			Entry("invalid type", Algorithm{Kind: Composite, Keys: []SortKey{{Ext: ".cls", Type: "bool"}}}),
+			Entry("invalid JSON path", Algorithm{Kind: Composite, Keys: []SortKey{{Ext: ".json", JSONPath: "a..b"}}}),
+			Entry("multiple class keys", Algorithm{Kind: Stratified, Keys: []SortKey{{Ext: ".cls"}, {Ext: ".json"}}}),
+		)
 	})
 })
 
@@ -212,7 +212,8 @@ func parseAlgorithm(alg Algorithm) (*Algorithm, error) {
 			return nil, fmt.Errorf(fmtErrSeed, alg.Seed)
 		}
 	}
-	if alg.Kind == Content {
+	switch alg.Kind {
+	case Content:
 		alg.Ext = strings.TrimSpace(alg.Ext)
 		if alg.Ext == "" || alg.Ext[0] != '.' {
 			return nil, fmt.Errorf("%w %q", errAlgExt, alg.Ext)
@@ -220,13 +221,47 @@ func parseAlgorithm(alg Algorithm) (*Algorithm, error) {
 		if err := shard.ValidateContentKeyTy(alg.ContentKeyType); err != nil {
 			return nil, err
 		}
-	} else {
+	case Composite, Stratified:
+		if err := parseSortKeys(&alg); err != nil {
+			return nil, err
+		}
 		alg.ContentKeyType = shard.ContentKeyString
+	default:
+		alg.ContentKeyType = shard.ContentKeyString
+	}
+	if len(alg.Keys) > 0 && alg.Kind != Composite && alg.Kind != Stratified {
+		return nil, fmt.Errorf("%w: sort keys require %q or %q algorithm (got %q)", errAlgKeys, Composite, Stratified, alg.Kind)
 	}
 
 	return &alg, nil
 }
 
+func parseSortKeys(alg *Algorithm) error {
+	switch {
+	case len(alg.Keys) == 0:
+		return fmt.Errorf("%w: %q algorithm requires at least one sort key", errAlgKeys, alg.Kind)
+	case alg.Kind == Stratified && len(alg.Keys) > 1:
+		return fmt.Errorf("%w: %q algorithm requires exactly one (class) key, got %d", errAlgKeys, alg.Kind, len(alg.Keys))
+	}
+	for i := range alg.Keys {
+		key := &alg.Keys[i]
+		key.Ext = strings.TrimSpace(key.Ext)
+		if key.Ext == "" || key.Ext[0] != '.' {
+			return fmt.Errorf("%w %q (key #%d)", errAlgExt, key.Ext, i)
+		}
+		if key.Type == "" {
+			key.Type = shard.ContentKeyString
+		}
+		if err := shard.ValidateContentKeyTy(key.Type); err != nil {
+			return err
+		}
+		if _, err := shard.ParseJSONPath(key.JSONPath); err != nil {
+			return fmt.Errorf("%w: %v (key #%d)", errAlgKeys, err, i)
+		}
+	}
+	return nil
+}
+
 func validateEKMFileURL(ekmURL string) (empty bool, err error) {
 	if ekmURL == "" {
 		return true, nil
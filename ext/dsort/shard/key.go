@@ -3,18 +3,20 @@
 // Package shard provides Extract(shard), Create(shard), and associated methods
 // across all supported archival formats (see cmn/archive/mime.go)
 /*
- * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2018-2026, NVIDIA CORPORATION. All rights reserved.
  */
 package shard
 
 import (
 	"bytes"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/NVIDIA/aistore/cmn/cos"
 )
@@ -28,6 +30,7 @@ const (
 type (
 	SingleKeyExtractor struct {
 		name string
+		ext  string // (composite keys only)
 		buf  *bytes.Buffer
 	}
 
@@ -48,6 +51,21 @@ type (
 		ext string // file with this extension provides sorting key (of the type `ty`)
 	}
 
+	// a single level of a composite (multi-level) key
+	KeyLevel struct {
+		Ext        string // file with this extension provides the key (or JSON document, see next)
+		Type       string // one of contentKeyTypes
+		JSONPath   string // optional: dot-separated path within the JSON document, e.g. "speaker.id"
+		Decreasing bool
+	}
+	// produces []any keys, one element per KeyLevel; given record's files (extensions)
+	// contribute their respective elements, and the rest is filled-in when records
+	// get merged (see Record.mergeObjects)
+	compositeKeyExtractor struct {
+		levels []KeyLevel
+		paths  [][]string // parsed KeyLevel.JSONPath
+	}
+
 	ErrSortingKeyType struct {
 		ty string
 	}
@@ -122,8 +140,11 @@ func (ke *contentKeyExtractor) ExtractKey(ske *SingleKeyExtractor) (any, error)
 	if err != nil {
 		return nil, err
 	}
-	key := string(b)
-	switch ke.ty {
+	return parseContentKey(string(b), ke.ty)
+}
+
+func parseContentKey(key, ty string) (any, error) {
+	switch ty {
 	case ContentKeyInt:
 		return strconv.ParseInt(key, 10, 64)
 	case ContentKeyFloat:
@@ -131,7 +152,7 @@ func (ke *contentKeyExtractor) ExtractKey(ske *SingleKeyExtractor) (any, error)
 	case ContentKeyString:
 		return key, nil
 	default:
-		return nil, &ErrSortingKeyType{ke.ty}
+		return nil, &ErrSortingKeyType{ty}
 	}
 }
 
@@ -144,6 +165,148 @@ func ValidateContentKeyTy(ty string) error {
 	}
 }
 
+///////////////////////////
+// compositeKeyExtractor //
+///////////////////////////
+
+func NewCompositeKeyExtractor(levels []KeyLevel) (KeyExtractor, error) {
+	if len(levels) == 0 {
+		return nil, errors.New("composite key: no levels")
+	}
+	ke := &compositeKeyExtractor{levels: levels, paths: make([][]string, len(levels))}
+	for i := range levels {
+		if err := ValidateContentKeyTy(levels[i].Type); err != nil {
+			return nil, err
+		}
+		path, err := ParseJSONPath(levels[i].JSONPath)
+		if err != nil {
+			return nil, err
+		}
+		ke.paths[i] = path
+	}
+	return ke, nil
+}
+
+func (ke *compositeKeyExtractor) PrepareExtractor(name string, r cos.ReadSizer, ext string) (cos.ReadSizer, *SingleKeyExtractor, bool) {
+	for i := range ke.levels {
+		if ke.levels[i].Ext == ext {
+			buf := &bytes.Buffer{}
+			tee := cos.NewSizedReader(io.TeeReader(r, buf), r.Size())
+			return tee, &SingleKeyExtractor{name: name, ext: ext, buf: buf}, true
+		}
+	}
+	return r, nil, false
+}
+
+func (ke *compositeKeyExtractor) ExtractKey(ske *SingleKeyExtractor) (any, error) {
+	if ske == nil {
+		return nil, nil
+	}
+	b, err := cos.ReadAll(ske.buf)
+	ske.buf = nil
+	if err != nil {
+		return nil, err
+	}
+	var (
+		doc    any
+		parsed bool
+		key    = make([]any, len(ke.levels))
+	)
+	for i := range ke.levels {
+		level := &ke.levels[i]
+		if level.Ext != ske.ext {
+			continue
+		}
+		if ke.paths[i] == nil {
+			s := string(b)
+			if level.Type != ContentKeyString {
+				s = strings.TrimSpace(s)
+			}
+			if key[i], err = parseContentKey(s, level.Type); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if !parsed {
+			dec := json.NewDecoder(bytes.NewReader(b))
+			dec.UseNumber()
+			if err := dec.Decode(&doc); err != nil {
+				return nil, fmt.Errorf("%s%s: invalid JSON: %w", ske.name, ske.ext, err)
+			}
+			parsed = true
+		}
+		if key[i], err = jsonKey(doc, ke.paths[i], level.Type); err != nil {
+			return nil, fmt.Errorf("%s%s: %w", ske.name, ske.ext, err)
+		}
+	}
+	return key, nil
+}
+
+// merge partial composite keys contributed by different files of the same record
+func mergeKeys(dst, src any) {
+	d, ok1 := dst.([]any)
+	s, ok2 := src.([]any)
+	if !ok1 || !ok2 || len(d) != len(s) {
+		return
+	}
+	for i := range d {
+		if d[i] == nil {
+			d[i] = s[i]
+		}
+	}
+}
+
+func ParseJSONPath(path string) ([]string, error) {
+	if path == "" {
+		return nil, nil
+	}
+	parts := strings.Split(path, ".")
+	for _, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("invalid JSON path %q (empty element)", path)
+		}
+	}
+	return parts, nil
+}
+
+func jsonKey(doc any, path []string, ty string) (any, error) {
+	v := doc
+	for _, part := range path {
+		switch node := v.(type) {
+		case map[string]any:
+			var ok bool
+			if v, ok = node[part]; !ok {
+				return nil, fmt.Errorf("JSON path %q: %q not found", strings.Join(path, "."), part)
+			}
+		case []any:
+			idx, err := strconv.Atoi(part)
+			if err != nil || idx < 0 || idx >= len(node) {
+				return nil, fmt.Errorf("JSON path %q: invalid array index %q", strings.Join(path, "."), part)
+			}
+			v = node[idx]
+		default:
+			return nil, fmt.Errorf("JSON path %q: cannot descend into %q", strings.Join(path, "."), part)
+		}
+	}
+	switch val := v.(type) {
+	case json.Number:
+		if ty == ContentKeyInt {
+			if n, err := val.Int64(); err == nil {
+				return n, nil
+			}
+			f, err := val.Float64()
+			return int64(f), err
+		}
+		return parseContentKey(val.String(), ty)
+	case string:
+		return parseContentKey(val, ty)
+	case bool:
+		return parseContentKey(strconv.FormatBool(val), ty)
+	default:
+		return nil, fmt.Errorf("JSON path %q: expecting scalar value, got %T", strings.Join(path, "."), v)
+	}
+}
+
 func (e *ErrSortingKeyType) Error() string {
 	return fmt.Sprintf("invalid content sorting key %q, expecting one of: 'int', 'float', 'string'", e.ty)
 }
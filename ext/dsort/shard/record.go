@@ -8,6 +8,7 @@
 package shard
 
 import (
+	"cmp"
 	"encoding/json"
 	"sync"
 	"unsafe"
@@ -93,8 +94,11 @@ type (
 // is actually merged.
 func (r *Record) mergeObjects(other *Record) {
 	debug.Assert(r.Name == other.Name, r.Name+" vs "+other.Name)
-	if r.Key == nil && other.Key != nil {
+	switch {
+	case r.Key == nil:
 		r.Key = other.Key
+	case other.Key != nil:
+		mergeKeys(r.Key, other.Key) // composite keys
 	}
 	r.Objects = append(r.Objects, other.Objects...)
 }
@@ -264,6 +268,82 @@ func (r *Records) Less(i, j int, keyType string) (bool, error) {
 	return false, nil
 }
 
+// compares composite keys level by level (see KeyLevel)
+func (r *Records) LessComposite(i, j int, levels []KeyLevel) (bool, error) {
+	lhs, lok := r.arr[i].Key.([]any)
+	rhs, rok := r.arr[j].Key.([]any)
+	if !lok || len(lhs) != len(levels) {
+		return false, errors.Errorf("invalid or missing composite key for %q", r.arr[i].Name)
+	}
+	if !rok || len(rhs) != len(levels) {
+		return false, errors.Errorf("invalid or missing composite key for %q", r.arr[j].Name)
+	}
+	for k := range levels {
+		if lhs[k] == nil {
+			return false, errors.Errorf("key (%s) is missing for %q", levels[k].Ext, r.arr[i].Name)
+		}
+		if rhs[k] == nil {
+			return false, errors.Errorf("key (%s) is missing for %q", levels[k].Ext, r.arr[j].Name)
+		}
+		c, err := cmpKey(lhs[k], rhs[k], levels[k].Type)
+		if err != nil {
+			return false, err
+		}
+		if c != 0 {
+			if levels[k].Decreasing {
+				return c > 0, nil
+			}
+			return c < 0, nil
+		}
+	}
+	return false, nil
+}
+
+func cmpKey(lhs, rhs any, keyType string) (int, error) {
+	switch keyType {
+	case ContentKeyInt:
+		l, lok := toInt64(lhs)
+		r, rok := toInt64(rhs)
+		if lok && rok {
+			return cmp.Compare(l, r), nil
+		}
+	case ContentKeyFloat:
+		l, lok := toFloat64(lhs)
+		r, rok := toFloat64(rhs)
+		if lok && rok {
+			return cmp.Compare(l, r), nil
+		}
+	case ContentKeyString:
+		l, lok := lhs.(string)
+		r, rok := rhs.(string)
+		if lok && rok {
+			return cmp.Compare(l, r), nil
+		}
+	}
+	return 0, errors.Errorf("cannot compare %v (%T) and %v (%T) as %q", lhs, lhs, rhs, rhs, keyType)
+}
+
+// (motivation: javascript does not support int64 type; see also Less above)
+func toInt64(v any) (int64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return n, true
+	case float64:
+		return int64(n), true
+	}
+	return 0, false
+}
+
+func toFloat64(v any) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int64:
+		return float64(n), true
+	}
+	return 0, false
+}
+
 func (r *Records) TotalObjectCount() int {
 	return r.totalObjectCount
 }
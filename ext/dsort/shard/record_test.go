@@ -8,6 +8,10 @@
 package shard_test
 
 import (
+	"io"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/ext/dsort/shard"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -153,4 +157,55 @@ var _ = Describe("Records", func() {
 			Expect(records.All()[0].TotalSize()).To(BeEquivalentTo(objectSize))
 		})
 	})
+
+	Context("composite keys", func() {
+		extract := func(ke shard.KeyExtractor, ext, content string) any {
+			r := cos.NewSizedReader(strings.NewReader(content), int64(len(content)))
+			tee, ske, needRead := ke.PrepareExtractor("rec", r, ext)
+			if needRead {
+				_, err := io.Copy(io.Discard, tee)
+				Expect(err).NotTo(HaveOccurred())
+			}
+			key, err := ke.ExtractKey(ske)
+			Expect(err).NotTo(HaveOccurred())
+			return key
+		}
+
+		It("should extract and merge key levels from different files", func() {
+			ke, err := shard.NewCompositeKeyExtractor([]shard.KeyLevel{
+				{Ext: ".json", Type: shard.ContentKeyInt, JSONPath: "speaker.id"},
+				{Ext: ".cls", Type: shard.ContentKeyFloat},
+				{Ext: ".json", Type: shard.ContentKeyString, JSONPath: "segments.1.lang"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			records := shard.NewRecords(1)
+			for ext, content := range map[string]string{
+				".json": `{"speaker": {"id": 42}, "segments": [{"lang": "de"}, {"lang": "en"}]}`,
+				".cls":  "3.5\n",
+				".wav":  "RIFF",
+			} {
+				records.Insert(&shard.Record{
+					Key:     extract(ke, ext, content),
+					Name:    "rec",
+					Objects: []*shard.RecordObj{{Size: objectSize, Extension: ext}},
+				})
+			}
+			Expect(records.Len()).To(Equal(1))
+			Expect(records.All()[0].Key).To(Equal([]any{int64(42), 3.5, "en"}))
+		})
+
+		It("should fail to extract non-existing JSON field", func() {
+			ke, err := shard.NewCompositeKeyExtractor([]shard.KeyLevel{
+				{Ext: ".json", Type: shard.ContentKeyInt, JSONPath: "speaker.age"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			r := cos.NewSizedReader(strings.NewReader(`{"speaker": {"id": 42}}`), 23)
+			tee, ske, _ := ke.PrepareExtractor("rec", r, ".json")
+			_, err = io.Copy(io.Discard, tee)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = ke.ExtractKey(ske)
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
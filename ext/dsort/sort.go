@@ -2,12 +2,15 @@
 
 // Package dsort provides APIs for distributed archive file shuffling.
 /*
- * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2018-2026, NVIDIA CORPORATION. All rights reserved.
  */
 package dsort
 
 import (
+	"cmp"
+	"fmt"
 	"math/rand/v2"
+	"slices"
 	"sort"
 	"strconv"
 	"time"
@@ -21,6 +24,7 @@ type (
 		err        error
 		records    *shard.Records
 		keyType    string
+		levels     []shard.KeyLevel // composite keys only
 		decreasing bool
 	}
 )
@@ -37,7 +41,10 @@ func (s *alphaByKey) Less(i, j int) bool {
 		less bool
 	)
 	if s.decreasing {
-		less, err = s.records.Less(j, i, s.keyType)
+		i, j = j, i
+	}
+	if s.levels != nil {
+		less, err = s.records.LessComposite(i, j, s.levels)
 	} else {
 		less, err = s.records.Less(i, j, s.keyType)
 	}
@@ -53,23 +60,90 @@ func sortRecords(r *shard.Records, alg *Algorithm) (err error) {
 	case None:
 		return nil
 	case Shuffle:
-		var (
-			rnd  *rand.Rand
-			seed = time.Now().Unix()
-		)
-		if alg.Seed != "" {
-			seed, err = strconv.ParseInt(alg.Seed, 10, 64)
-			debug.AssertNoErr(err)
-		}
-		rnd = rand.New(rand.NewPCG(uint64(seed), 0))
+		rnd := alg.rnd()
 		for i := range r.Len() { // https://en.wikipedia.org/wiki/Fisher%E2%80%93Yates_shuffle
 			j := rnd.IntN(i + 1)
 			r.Swap(i, j)
 		}
+	case Stratified:
+		err = stratify(r, alg.rnd())
 	default:
 		keys := &alphaByKey{records: r, decreasing: alg.Decreasing, keyType: alg.ContentKeyType}
+		if alg.Kind == Composite {
+			keys.levels = alg.keyLevels()
+		}
 		sort.Sort(keys)
 		err = keys.err
 	}
 	return
 }
+
+// Stratified shuffle: shuffle records within each class, and then interleave the classes
+// in proportion to their respective sizes - so that any contiguous range of records
+// (and, therefore, any output shard) has approximately the same class distribution
+// as the entire dataset. The class is the record's single-level composite key.
+func stratify(r *shard.Records, rnd *rand.Rand) error {
+	type slot struct {
+		rec   *shard.Record
+		pos   float64 // relative position within the class, in [0, 1)
+		class int
+	}
+	var (
+		arr     = r.All()
+		classes = make(map[string][]*shard.Record, 16)
+	)
+	for _, rec := range arr {
+		key, ok := rec.Key.([]any)
+		if !ok || len(key) != 1 || key[0] == nil {
+			return fmt.Errorf("class key is missing for %q", rec.Name)
+		}
+		class := fmt.Sprint(key[0])
+		classes[class] = append(classes[class], rec)
+	}
+
+	// (sorted, to reproduce with the same seed)
+	names := make([]string, 0, len(classes))
+	for name := range classes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	slots := make([]slot, 0, len(arr))
+	for c, name := range names {
+		recs := classes[name]
+		rnd.Shuffle(len(recs), func(i, j int) { recs[i], recs[j] = recs[j], recs[i] })
+		n := float64(len(recs))
+		for i, rec := range recs {
+			slots = append(slots, slot{rec: rec, pos: (float64(i) + rnd.Float64()) / n, class: c})
+		}
+	}
+	slices.SortFunc(slots, func(a, b slot) int {
+		if c := cmp.Compare(a.pos, b.pos); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.class, b.class)
+	})
+	for i := range slots {
+		arr[i] = slots[i].rec // in place
+	}
+	return nil
+}
+
+func (alg *Algorithm) rnd() *rand.Rand {
+	seed := time.Now().Unix()
+	if alg.Seed != "" {
+		var err error
+		seed, err = strconv.ParseInt(alg.Seed, 10, 64)
+		debug.AssertNoErr(err)
+	}
+	return rand.New(rand.NewPCG(uint64(seed), 0))
+}
+
+func (alg *Algorithm) keyLevels() []shard.KeyLevel {
+	levels := make([]shard.KeyLevel, len(alg.Keys))
+	for i := range alg.Keys {
+		k := &alg.Keys[i]
+		levels[i] = shard.KeyLevel{Ext: k.Ext, Type: k.Type, JSONPath: k.JSONPath, Decreasing: k.Decreasing}
+	}
+	return levels
+}
@@ -11,6 +11,7 @@ package dsort
 import (
 	"fmt"
 
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/ext/dsort/shard"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -97,4 +98,77 @@ var _ = Describe("SortRecords", func() {
 		err := sortRecords(fm, &Algorithm{Decreasing: true, ContentKeyType: shard.ContentKeyString})
 		Expect(err).To(HaveOccurred())
 	})
+
+	Describe("composite keys", func() {
+		alg := &Algorithm{
+			Kind: Composite,
+			Keys: []SortKey{
+				{Ext: ".json", Type: shard.ContentKeyString},
+				{Ext: ".cls", Type: shard.ContentKeyFloat, Decreasing: true},
+			},
+		}
+
+		It("should sort by the first key, then by the second", func() {
+			fm := createRecords([]any{"b", 1.5}, []any{"a", 1.0}, []any{"b", 2.5}, []any{"a", 3.0})
+			err := sortRecords(fm, alg)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fm).To(Equal(createRecords([]any{"a", 3.0}, []any{"a", 1.0}, []any{"b", 2.5}, []any{"b", 1.5})))
+		})
+
+		It("should reverse the entire order when decreasing", func() {
+			fm := createRecords([]any{"b", 1.5}, []any{"a", 1.0}, []any{"b", 2.5}, []any{"a", 3.0})
+			dec := *alg
+			dec.Decreasing = true
+			err := sortRecords(fm, &dec)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fm).To(Equal(createRecords([]any{"b", 1.5}, []any{"b", 2.5}, []any{"a", 1.0}, []any{"a", 3.0})))
+		})
+
+		It("should return error when some key levels are missing", func() {
+			fm := createRecords([]any{"a", 1.5}, []any{"a", nil})
+			err := sortRecords(fm, alg)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("stratified shuffle", func() {
+		const (
+			numA, numB = 300, 100
+			shardSize  = 40
+		)
+		alg := &Algorithm{Kind: Stratified, Seed: "1010102", Keys: []SortKey{{Ext: ".cls", Type: shard.ContentKeyInt}}}
+
+		newRecords := func() *shard.Records {
+			records := shard.NewRecords(numA + numB)
+			for i := range numA + numB {
+				class := int64(cos.Ternary(i < numA, 0, 1))
+				records.Insert(&shard.Record{Key: []any{class}, Name: fmt.Sprintf("r-%d", i)})
+			}
+			return records
+		}
+
+		It("should keep classes balanced across shards", func() {
+			fm := newRecords()
+			Expect(sortRecords(fm, alg)).ToNot(HaveOccurred())
+			recs := fm.All()
+			for start := 0; start < len(recs); start += shardSize {
+				var cnt int
+				for _, rec := range recs[start : start+shardSize] {
+					if rec.Key.([]any)[0] == int64(1) {
+						cnt++
+					}
+				}
+				// class "1" is 1/4 of all records
+				Expect(cnt).To(BeNumerically("~", shardSize/4, 1))
+			}
+		})
+
+		It("should shuffle reproducibly when same seed specified", func() {
+			fm1, fm2 := newRecords(), newRecords()
+			Expect(sortRecords(fm1, alg)).ToNot(HaveOccurred())
+			Expect(sortRecords(fm2, alg)).ToNot(HaveOccurred())
+			Expect(fm1).To(Equal(fm2))
+			Expect(fm1).NotTo(Equal(newRecords()))
+		})
+	})
 })
@@ -44,6 +44,11 @@ const (
 	Image   = "image"
 	Command = "command"
 	Env     = "env"
+	Module  = "module"
+
+	// `RuntimeSpec.Type` enum
+	RuntimePod     = "pod"     // (default) K8s pod and service
+	RuntimeProcess = "process" // child process of the target (see process.go)
 
 	// consts for unmarshalling ETL details
 	InitMsgType = "init_msg"
@@ -90,6 +95,8 @@ const (
 	HpushStdin = "io://"
 	// WebSocket communication.
 	WebSocket = "ws://"
+	// In-process WebAssembly module executed by the target itself (see wasm_comm.go).
+	Wasm = "wasm://"
 )
 
 type (
@@ -134,9 +141,11 @@ type (
 
 	// swagger:model
 	RuntimeSpec struct {
+		Type    string          `json:"type,omitempty" yaml:"type,omitempty"` // enum { RuntimePod, RuntimeProcess }
 		Image   string          `json:"image" yaml:"image"`
 		Command []string        `json:"command,omitempty" yaml:"command,omitempty"`
 		Env     []corev1.EnvVar `json:"env,omitempty" yaml:"env,omitempty" swaggertype:"array,object"`
+		Module  []byte          `json:"module,omitempty" yaml:"module,omitempty"` // WebAssembly binary (comm-type Wasm)
 	}
 
 	WebsocketCtrlMsg struct {
@@ -204,7 +213,7 @@ type (
 	}
 )
 
-var commTypes = []string{Hpush, Hpull, HpushStdin, WebSocket} // NOTE: must contain all
+var commTypes = []string{Hpush, Hpull, HpushStdin, WebSocket, Wasm} // NOTE: must contain all
 
 ////////////////
 // InitMsg*** //
@@ -343,17 +352,81 @@ func (m *InitSpecMsg) Validate() error {
 		}
 	}
 
-	return m.InitMsgBase.Validate(m.String())
+	if err := m.InitMsgBase.Validate(m.String()); err != nil {
+		return err
+	}
+	if m.CommType() == Wasm {
+		return cmn.NewErrETLf(errCtx, "comm-type %q requires ETL spec with runtime.module (not a pod spec)", Wasm)
+	}
+	return nil
 }
 
 func (e *ETLSpecMsg) Validate() error {
 	errCtx := &cmn.ETLErrCtx{ETLName: e.Name()}
-	if e.Runtime.Image == "" {
-		return cmn.NewErrETLf(errCtx, "runtime.image must be specified")
+	if strings.TrimSuffix(e.CommTypeX, CommTypeSeparator)+CommTypeSeparator == Wasm {
+		return e.validateWasm(errCtx)
 	}
+	if len(e.Runtime.Module) > 0 {
+		return cmn.NewErrETLf(errCtx, "runtime.module requires comm-type %q", Wasm)
+	}
+	switch e.Runtime.Type {
+	case "", RuntimePod:
+		if e.Runtime.Image == "" {
+			return cmn.NewErrETLf(errCtx, "runtime.image must be specified")
+		}
+	case RuntimeProcess:
+		if len(e.Runtime.Command) == 0 {
+			return cmn.NewErrETLf(errCtx, "runtime.command must be specified for the %q runtime", RuntimeProcess)
+		}
+	default:
+		return cmn.NewErrETLf(errCtx, "invalid runtime.type %q (expecting %q or %q)", e.Runtime.Type, RuntimePod, RuntimeProcess)
+	}
+	if err := e.InitMsgBase.Validate(e.String()); err != nil {
+		return err
+	}
+	// io:// relies on the `/server` wrapper that comes with transformer images
+	if e.IsProcess() && e.CommType() == HpushStdin {
+		err := fmt.Errorf("comm-type %q is not supported by the %q runtime", HpushStdin, RuntimeProcess)
+		return cmn.NewErrUnsuppErr(err)
+	}
+	return nil
+}
+
+// WebAssembly module runs inside each target: no image, no command, and always direct put
+// (the module itself has no network access - the target delivers its output)
+func (e *ETLSpecMsg) validateWasm(errCtx *cmn.ETLErrCtx) error {
+	switch {
+	case len(e.Runtime.Module) == 0:
+		return cmn.NewErrETLf(errCtx, "runtime.module (WebAssembly binary) must be specified for comm-type %q", Wasm)
+	case e.Runtime.Type != "" || e.Runtime.Image != "" || len(e.Runtime.Command) > 0:
+		return cmn.NewErrETLf(errCtx, "comm-type %q does not support runtime.type, runtime.image, or runtime.command", Wasm)
+	}
+	if _, _, err := e.wasmLimits(); err != nil {
+		return cmn.NewErrETL(errCtx, err.Error())
+	}
+	e.SupportDirectPut = true
 	return e.InitMsgBase.Validate(e.String())
 }
 
+// IsProcess returns true if the ETL runs as a child process of each target (no K8s)
+func (e *ETLSpecMsg) IsProcess() bool { return e.Runtime.Type == RuntimeProcess }
+
+// IsWasm returns true if the ETL is a WebAssembly module executed by each target (no K8s)
+func (e *ETLSpecMsg) IsWasm() bool { return e.CommType() == Wasm }
+
+// IsProcess returns true when the message specifies ETL process runtime;
+// full pod spec (InitSpecMsg) always requires K8s
+func IsProcess(msg InitMsg) bool {
+	e, ok := msg.(*ETLSpecMsg)
+	return ok && e.IsProcess()
+}
+
+// NeedsK8s returns false for the ETLs that run without Kubernetes: process runtime and WebAssembly
+func NeedsK8s(msg InitMsg) bool {
+	e, ok := msg.(*ETLSpecMsg)
+	return !ok || (!e.IsProcess() && !e.IsWasm())
+}
+
 // ParsePodSpec parses `m.Spec` into a Kubernetes Pod object.
 func (m *InitSpecMsg) ParsePodSpec() (*corev1.Pod, error) {
 	obj, _, err := scheme.Codecs.UniversalDeserializer().Decode(m.Spec, nil, nil)
@@ -372,6 +445,12 @@ func (e *ETLSpecMsg) ParsePodSpec() (*corev1.Pod, error) {
 	if err := e.Validate(); err != nil {
 		return nil, err
 	}
+	if e.IsProcess() {
+		return nil, fmt.Errorf("%s: %q runtime has no pod spec", e.Cname(), RuntimeProcess)
+	}
+	if e.IsWasm() {
+		return nil, fmt.Errorf("%s: comm-type %q has no pod spec", e.Cname(), Wasm)
+	}
 	pod := &corev1.Pod{
 		Spec: corev1.PodSpec{
 			Containers: []corev1.Container{{
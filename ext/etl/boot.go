@@ -217,15 +217,16 @@ func (b *etlBootstrapper) waitPodReady(podCtx context.Context) error {
 	)
 }
 
-func initComm(msg InitMsg, xid, secret string, boot *etlBootstrapper) (comm Communicator, err error) {
-	if comm, _ = mgr.getByName(msg.Name()); comm != nil {
+// (common for pod and process runtimes: exactly one of `boot` and `proc` is non-nil)
+func initComm(msg InitMsg, xid, secret string, boot *etlBootstrapper, proc *etlProcess) (comm Communicator, err error) {
+	if ei := mgr.getByName(msg.Name()); ei.comm != nil {
 		return nil, cos.NewErrAlreadyExists(core.T, msg.Name())
 	}
 	if comm, err = newCommunicator(msg, secret, cmn.GCO.Get()); err != nil {
 		return nil, err
 	}
 
-	if err = mgr.add(msg.Name(), comm, boot); err != nil {
+	if err = mgr.add(msg.Name(), etlInstance{comm: comm, boot: boot, proc: proc}); err != nil {
 		return nil, err
 	}
 
@@ -19,6 +19,7 @@ type (
 	etlInstance struct {
 		comm Communicator
 		boot *etlBootstrapper // TODO: move all bootstrapper logic to proxy
+		proc *etlProcess      // process runtime (nil when running as K8s pod)
 	}
 	manager struct {
 		m   map[string]etlInstance
@@ -28,30 +29,33 @@ type (
 
 var mgr *manager
 
+// in-process WebAssembly runtime (nil otherwise)
+func (ei etlInstance) wasm() *wasmComm {
+	wc, _ := ei.comm.(*wasmComm)
+	return wc
+}
+
 func Tinit() {
 	mgr = &manager{m: make(map[string]etlInstance, 4)}
 	xreg.RegNonBckXact(&factory{})
 }
 
-func (r *manager) add(name string, c Communicator, boot *etlBootstrapper) (err error) {
+func (r *manager) add(name string, ei etlInstance) (err error) {
 	r.mtx.Lock()
 	if _, ok := r.m[name]; ok {
 		err = fmt.Errorf("etl[%s] already exists", name)
 	} else {
-		r.m[name] = etlInstance{comm: c, boot: boot}
+		r.m[name] = ei
 	}
 	r.mtx.Unlock()
 	return err
 }
 
-func (r *manager) getByName(name string) (Communicator, *etlBootstrapper) {
+func (r *manager) getByName(name string) (ei etlInstance) {
 	r.mtx.RLock()
-	defer r.mtx.RUnlock()
-
-	if ei, exists := r.m[name]; exists {
-		return ei.comm, ei.boot
-	}
-	return nil, nil
+	ei = r.m[name]
+	r.mtx.RUnlock()
+	return ei
 }
 
 func (r *manager) getByXid(xid string) Communicator {
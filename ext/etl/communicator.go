@@ -142,6 +142,10 @@ func newCommunicator(msg InitMsg, secret string, config *cmn.Config) (Communicat
 		ws.msg, ws.secret, ws.config = msg, secret, config
 		ws.commCtx, ws.commCtxCancel = context.WithCancel(context.Background())
 		return ws, nil
+	case Wasm:
+		wc := &wasmComm{}
+		wc.msg, wc.secret, wc.config = msg, secret, config
+		return wc, nil
 	}
 
 	debug.Assert(false, "unknown comm-type '"+msg.CommType()+"'")
@@ -0,0 +1,401 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/cmn/k8s"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/sys"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Process runtime (`runtime.type: process`):
+// - each target runs the transformer's `runtime.command` as its own child process
+//   listening on a (target-allocated) localhost port;
+// - the port is passed via AIS_ETL_PORT environment; `${AIS_ETL_PORT}` in the command
+//   is expanded as well (along with AIS_TARGET_URL and all other ETL env variables);
+// - the process does not inherit target's environment (cloud credentials, AIS_AUTHN_*,
+//   AIS_ENCRYPTION_KEYFILE, and such) - only procBaseEnv variables, those that
+//   AIS_ETL_PASS_ENV (target's environment) lists, and the ETL variables;
+// - the process is supervised: restarted with backoff when it exits, and the ETL
+//   gets aborted cluster-wide after too many consecutive failures;
+// - stdout and stderr are captured (most recent procMaxLogs bytes) and returned
+//   as the ETL logs; health and CPU/memory metrics are those of the process;
+// - disabled by default, with or without K8s: requires feature flag feat.AllowETLProcess
+//   (cluster config - admin only), checked by the proxy and, again, by each target.
+
+const (
+	EnvETLPort = "AIS_ETL_PORT"
+
+	// comma-separated names of (additional) target's environment variables
+	// to pass through to ETL processes, e.g. "PYTHONPATH,HTTPS_PROXY"
+	EnvETLPassEnv = "AIS_ETL_PASS_ENV"
+)
+
+var ErrProcessDisabled = errors.New("ETL process runtime is disabled (see feature flag \"Allow-ETL-Process-Runtime\")")
+
+// target's environment variables that ETL processes get by default (see procEnv)
+var procBaseEnv = []string{"PATH", "HOME", "USER", "LANG", "LC_ALL", "TZ", "TMPDIR"}
+
+const (
+	procMaxLogs      = cos.MiB
+	procMaxRestarts  = 5                // consecutive
+	procStableAfter  = time.Minute      // running that long resets the restart count
+	procBackoff      = time.Second      // times the number of consecutive restarts
+	procTermTimeout  = 10 * time.Second // SIGTERM => SIGKILL
+	procProbeTimeout = 5 * time.Second  // readiness probe request
+)
+
+type (
+	procLogs struct {
+		b  []byte
+		mu sync.Mutex
+	}
+	etlProcess struct {
+		msg    *ETLSpecMsg
+		errCtx *cmn.ETLErrCtx
+		xetl   core.Xact
+		stopCh *cos.StopCh
+		done   chan struct{} // closed upon supervisor exit
+		cmd    *exec.Cmd
+		logs   procLogs
+		argv   []string
+		env    []string
+		addr   string // localhost:port
+		status k8s.PodStatus
+		// CPU usage (see metrics)
+		cpuTotal uint64
+		cpuTime  int64
+		started  int64
+		restarts int
+		mu       sync.Mutex
+		stopping atomic.Bool
+		failed   atomic.Bool
+	}
+)
+
+//////////////
+// procLogs //
+//////////////
+
+// keeps the tail: when full, drops the older half
+func (l *procLogs) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	l.b = append(l.b, p...)
+	if len(l.b) > procMaxLogs {
+		n := copy(l.b, l.b[len(l.b)-procMaxLogs/2:])
+		l.b = l.b[:n]
+	}
+	l.mu.Unlock()
+	return len(p), nil
+}
+
+func (l *procLogs) get() []byte {
+	l.mu.Lock()
+	b := make([]byte, len(l.b))
+	copy(b, l.b)
+	l.mu.Unlock()
+	return b
+}
+
+////////////////
+// etlProcess //
+////////////////
+
+// CheckProcess fails process-runtime ETLs unless explicitly allowed
+func CheckProcess(msg InitMsg) error {
+	if IsProcess(msg) && !cmn.Rom.Features().IsSet(feat.AllowETLProcess) {
+		return ErrProcessDisabled
+	}
+	return nil
+}
+
+func newProcess(msg *ETLSpecMsg, secret string, errCtx *cmn.ETLErrCtx) (*etlProcess, error) {
+	if err := CheckProcess(msg); err != nil {
+		return nil, cmn.NewErrETL(errCtx, err.Error())
+	}
+	port, err := freePort()
+	if err != nil {
+		return nil, cmn.NewErrETLf(errCtx, "failed to allocate port: %v", err)
+	}
+	p := &etlProcess{
+		msg:    msg,
+		errCtx: errCtx,
+		stopCh: cos.NewStopCh(),
+		done:   make(chan struct{}),
+		addr:   cmn.HostPort("127.0.0.1", strconv.Itoa(port)),
+	}
+
+	// same variables (and in the same order) as in the pod's container (see _setPodEnv)
+	vars := make([]corev1.EnvVar, 0, len(msg.Runtime.Env)+len(msg.Env)+3)
+	vars = append(vars, msg.Runtime.Env...)
+	vars = append(vars,
+		corev1.EnvVar{Name: "AIS_TARGET_URL", Value: core.T.Snode().URL(cmn.NetIntraData) + apc.URLPathETLObject.Join(msg.Name(), secret)},
+		corev1.EnvVar{Name: DirectPut, Value: strconv.FormatBool(msg.IsDirectPut())},
+		corev1.EnvVar{Name: EnvETLPort, Value: strconv.Itoa(port)},
+	)
+	vars = append(vars, msg.Env...)
+
+	p.env = procEnv(vars)
+	p.argv = expandArgs(msg.Runtime.Command, p.env)
+	return p, nil
+}
+
+// minimal environment: allowed target's variables (see procBaseEnv and EnvETLPassEnv)
+// followed by the ETL ones
+func procEnv(vars []corev1.EnvVar) []string {
+	names := procBaseEnv
+	if s := os.Getenv(EnvETLPassEnv); s != "" {
+		names = append(slices.Clone(names), strings.Split(s, ",")...)
+	}
+	env := make([]string, 0, len(names)+len(vars))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if v, ok := os.LookupEnv(name); ok && name != "" {
+			env = append(env, name+"="+v)
+		}
+	}
+	for _, v := range vars {
+		env = append(env, v.Name+"="+v.Value)
+	}
+	return env
+}
+
+// expand $VAR and ${VAR} using the process's own environment (the last one wins)
+func expandArgs(command, env []string) []string {
+	lookup := func(name string) string {
+		for i := len(env) - 1; i >= 0; i-- {
+			if k, v, ok := strings.Cut(env[i], "="); ok && k == name {
+				return v
+			}
+		}
+		return ""
+	}
+	argv := make([]string, len(command))
+	for i, arg := range command {
+		argv[i] = os.Expand(arg, lookup)
+	}
+	return argv
+}
+
+func freePort() (int, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	port := l.Addr().(*net.TCPAddr).Port
+	return port, l.Close()
+}
+
+func (p *etlProcess) String() string { return p.msg.Cname() + "[" + p.argv[0] + "]" }
+
+// start the process and its supervisor
+func (p *etlProcess) start(xetl core.Xact) error {
+	p.xetl = xetl
+	if err := p.spawn(); err != nil {
+		close(p.done)
+		return err
+	}
+	go p.supervise()
+	return nil
+}
+
+func (p *etlProcess) spawn() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.stopping.Load() {
+		return errors.New(p.String() + " is stopping")
+	}
+	cmd := exec.Command(p.argv[0], p.argv[1:]...) //nolint:gosec // user-provided ETL command is the whole point
+	cmd.Env = p.env
+	cmd.Stdout, cmd.Stderr = &p.logs, &p.logs
+	cmd.SysProcAttr = procAttr()
+	cmd.WaitDelay = procTermTimeout // in case (orphaned) descendants keep stdout open
+	if err := cmd.Start(); err != nil {
+		return cmn.NewErrETLf(p.errCtx, "failed to start %v: %v", p.argv, err)
+	}
+	p.cmd = cmd
+	p.started = mono.NanoTime()
+	p.cpuTotal, p.cpuTime = 0, p.started
+	p.status = k8s.PodStatus{State: ctrRunning, CtrName: p.msg.Name(), Reason: "Started"}
+	nlog.Infoln(p.String(), "started: pid", cmd.Process.Pid, "addr", p.addr)
+	return nil
+}
+
+func (p *etlProcess) supervise() {
+	defer close(p.done)
+	for {
+		p.mu.Lock()
+		cmd := p.cmd
+		p.mu.Unlock()
+
+		err := cmd.Wait()
+		if p.stopping.Load() {
+			return
+		}
+
+		p.mu.Lock()
+		p.status = k8s.PodStatus{State: ctrTerminated, CtrName: p.msg.Name(), Reason: "Error", ExitCode: int32(cmd.ProcessState.ExitCode())}
+		if err != nil {
+			p.status.Message = err.Error()
+		}
+		if mono.Since(p.started) > procStableAfter {
+			p.restarts = 0
+		}
+		p.restarts++
+		restarts := p.restarts
+		p.mu.Unlock()
+
+		if restarts > procMaxRestarts {
+			p.fail(fmt.Errorf("%s exited (%v) %d times in a row - giving up", p, err, restarts))
+			return
+		}
+		nlog.Warningln(p.String(), "exited:", err, "- restarting [", restarts, "/", procMaxRestarts, "]")
+
+		select {
+		case <-time.After(time.Duration(restarts) * procBackoff):
+		case <-p.stopCh.Listen():
+			return
+		case <-p.xetl.ChanAbort():
+			return
+		}
+		if err := p.spawn(); err != nil {
+			if !p.stopping.Load() {
+				p.fail(err)
+			}
+			return
+		}
+	}
+}
+
+// abort the (inline) ETL xaction, which in turn stops the ETL in the cluster
+func (p *etlProcess) fail(err error) {
+	p.failed.Store(true)
+	nlog.Errorln(err)
+	errCtx := *p.errCtx
+	errCtx.PodStatus = p.getStatus()
+	p.xetl.Abort(cmn.NewErrETL(&errCtx, err.Error()))
+}
+
+// waitReady polls the transformer's health endpoint (same readiness probe as in the pod spec)
+func (p *etlProcess) waitReady() error {
+	var (
+		initTimeout, _ = p.msg.Timeouts()
+		interval       = cos.ProbingFrequency(initTimeout.D())
+		client         = &http.Client{Timeout: procProbeTimeout}
+		url            = "http://" + p.addr + "/" + apc.ETLHealth
+		deadline       = time.NewTimer(initTimeout.D())
+		ticker         = time.NewTicker(interval)
+	)
+	defer func() {
+		deadline.Stop()
+		ticker.Stop()
+	}()
+	if cmn.Rom.V(4, cos.ModETL) {
+		nlog.Infof("waiting %s ready (%s) initTimeout=%v ival=%v", p, p.msg.String(), initTimeout, interval)
+	}
+	for {
+		select {
+		case <-ticker.C:
+			resp, err := client.Get(url) //nolint:noctx // timeout via client
+			if err == nil {
+				resp.Body.Close()
+				if resp.StatusCode == http.StatusOK {
+					return nil
+				}
+			}
+		case <-deadline.C:
+			return fmt.Errorf("%s: timed out waiting for %s (%v)", p, url, initTimeout)
+		case <-p.done:
+			return fmt.Errorf("%s: failed to start", p)
+		case <-p.xetl.ChanAbort():
+			return p.xetl.AbortErr()
+		}
+	}
+}
+
+// SIGTERM the process group, wait, and SIGKILL upon timeout
+func (p *etlProcess) stop() {
+	p.mu.Lock()
+	p.stopping.Store(true)
+	cmd := p.cmd
+	p.mu.Unlock()
+	p.stopCh.Close()
+
+	if cmd != nil {
+		pgid := cmd.Process.Pid
+		if err := syscall.Kill(-pgid, syscall.SIGTERM); err != nil && !errors.Is(err, syscall.ESRCH) {
+			nlog.Warningln(p.String(), "failed to terminate:", err)
+		}
+		select {
+		case <-p.done:
+		case <-time.After(procTermTimeout):
+			nlog.Warningln(p.String(), "did not terminate in", procTermTimeout, "- killing")
+			_ = syscall.Kill(-pgid, syscall.SIGKILL)
+		}
+	}
+	<-p.done
+}
+
+func (p *etlProcess) getStatus() k8s.PodStatus {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.status
+}
+
+// (compare with k8s pod phase)
+func (p *etlProcess) health() string {
+	switch {
+	case p.failed.Load() || p.stopping.Load():
+		return string(corev1.PodFailed)
+	case p.getStatus().State == ctrRunning:
+		return string(corev1.PodRunning)
+	default:
+		return string(corev1.PodPending) // restarting
+	}
+}
+
+// returns CPU usage (in cores) since the previous call (or since start), and resident memory
+func (p *etlProcess) metrics() (float64, int64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.status.State != ctrRunning {
+		return 0, 0, cos.NewErrNotFound(core.T, "metrics for "+p.String()+" (not running)")
+	}
+	ps, err := sys.ProcessStats(p.cmd.Process.Pid)
+	if err != nil {
+		return 0, 0, err
+	}
+	var (
+		now   = mono.NanoTime()
+		cores float64
+	)
+	if elapsed := time.Duration(now - p.cpuTime).Milliseconds(); elapsed > 0 && ps.CPU.Total >= p.cpuTotal {
+		cores = float64(ps.CPU.Total-p.cpuTotal) / float64(elapsed)
+	}
+	p.cpuTotal, p.cpuTime = ps.CPU.Total, now
+	return cores, int64(ps.Mem.Resident), nil
+}
@@ -0,0 +1,161 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/core/mock"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("ETLProcessTest", func() {
+	newMsg := func(command ...string) *ETLSpecMsg {
+		return &ETLSpecMsg{
+			InitMsgBase: InitMsgBase{EtlName: "test-etl", CommTypeX: Hpush, InitTimeout: cos.Duration(5 * time.Second)},
+			Runtime:     RuntimeSpec{Type: RuntimeProcess, Command: command},
+		}
+	}
+	newProc := func(command ...string) *etlProcess {
+		msg := newMsg(command...)
+		return &etlProcess{
+			msg:    msg,
+			errCtx: &cmn.ETLErrCtx{ETLName: msg.Name()},
+			stopCh: cos.NewStopCh(),
+			done:   make(chan struct{}),
+			argv:   command,
+			env:    os.Environ(),
+		}
+	}
+
+	Context("Validate", func() {
+		It("accepts process runtime without image", func() {
+			msg := newMsg("python3", "server.py")
+			Expect(msg.Validate()).NotTo(HaveOccurred())
+			Expect(IsProcess(msg)).To(BeTrue())
+		})
+		It("requires command", func() {
+			Expect(newMsg().Validate()).To(HaveOccurred())
+		})
+		It("rejects io:// communication", func() {
+			msg := newMsg("cat")
+			msg.CommTypeX = HpushStdin
+			Expect(msg.Validate()).To(HaveOccurred())
+		})
+		It("is disabled unless explicitly allowed", func() {
+			msg := newMsg("cat")
+			Expect(CheckProcess(msg)).To(MatchError(ErrProcessDisabled))
+
+			prev := cmn.GCO.Get()
+			config := *prev
+			config.Features = config.Features.Set(feat.AllowETLProcess)
+			cmn.GCO.Put(&config)
+			defer cmn.GCO.Put(prev)
+			Expect(CheckProcess(msg)).NotTo(HaveOccurred())
+		})
+		It("rejects unknown runtime type", func() {
+			msg := newMsg("cat")
+			msg.Runtime.Type = "vm"
+			Expect(msg.Validate()).To(HaveOccurred())
+		})
+		It("still requires image for pods", func() {
+			msg := newMsg("cat")
+			msg.Runtime.Type = ""
+			Expect(msg.Validate()).To(HaveOccurred())
+			Expect(IsProcess(msg)).To(BeFalse())
+		})
+	})
+
+	It("expands ETL variables in command", func() {
+		vars := []corev1.EnvVar{{Name: EnvETLPort, Value: "1234"}, {Name: "ARG", Value: "a"}, {Name: "ARG", Value: "b"}}
+		argv := expandArgs([]string{"server", "--port=${AIS_ETL_PORT}", "$ARG"}, procEnv(vars))
+		Expect(argv).To(Equal([]string{"server", "--port=1234", "b"}))
+	})
+
+	It("does not leak target's environment", func() {
+		GinkgoT().Setenv("AIS_AUTHN_SECRET_KEY", "secret")
+		GinkgoT().Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+		GinkgoT().Setenv("PYTHONPATH", "/opt/etl")
+		GinkgoT().Setenv(EnvETLPassEnv, "")
+
+		env := procEnv([]corev1.EnvVar{{Name: EnvETLPort, Value: "1234"}})
+		Expect(env).To(ContainElement("PATH=" + os.Getenv("PATH")))
+		Expect(env).To(ContainElement(EnvETLPort + "=1234"))
+		for _, kv := range env {
+			Expect(kv).NotTo(ContainSubstring("secret"))
+			Expect(kv).NotTo(HavePrefix("PYTHONPATH="))
+		}
+		argv := expandArgs([]string{"$AIS_AUTHN_SECRET_KEY"}, env)
+		Expect(argv).To(Equal([]string{""}))
+
+		// explicitly allowed
+		GinkgoT().Setenv(EnvETLPassEnv, "PYTHONPATH, NOT_SET")
+		Expect(procEnv(nil)).To(ContainElement("PYTHONPATH=/opt/etl"))
+	})
+
+	It("keeps the tail of the logs", func() {
+		var (
+			logs procLogs
+			line = bytes.Repeat([]byte{'x'}, cos.KiB)
+		)
+		for range 2 * procMaxLogs / cos.KiB {
+			logs.Write(line)
+		}
+		logs.Write([]byte("last"))
+		b := logs.get()
+		Expect(len(b)).To(BeNumerically("<=", procMaxLogs))
+		Expect(strings.HasSuffix(string(b), "last")).To(BeTrue())
+	})
+
+	It("waits for readiness", func() {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/"+apc.ETLHealth {
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer srv.Close()
+		p := newProc("true")
+		p.xetl = mock.NewXact(apc.ActETLInline)
+		p.addr = strings.TrimPrefix(srv.URL, "http://")
+		Expect(p.waitReady()).NotTo(HaveOccurred())
+	})
+
+	It("captures output, restarts, and stops", func() {
+		p := newProc("sh", "-c", "echo started; exit 3")
+		Expect(p.start(mock.NewXact(apc.ActETLInline))).NotTo(HaveOccurred())
+
+		Eventually(func() int32 { return p.getStatus().ExitCode }).WithTimeout(5 * time.Second).Should(Equal(int32(3)))
+		Expect(p.health()).To(Equal(string(corev1.PodPending)))
+		Eventually(func() string { return string(p.logs.get()) }).WithTimeout(5 * time.Second).Should(ContainSubstring("started\nstarted"))
+
+		p.stop()
+		Expect(p.done).To(BeClosed())
+		Expect(p.health()).To(Equal(string(corev1.PodFailed)))
+	})
+
+	It("terminates running process", func() {
+		p := newProc("sh", "-c", "echo started; sleep 60")
+		Expect(p.start(mock.NewXact(apc.ActETLInline))).NotTo(HaveOccurred())
+		Eventually(func() string { return string(p.logs.get()) }).Should(ContainSubstring("started"))
+		Expect(p.health()).To(Equal(string(corev1.PodRunning)))
+
+		started := time.Now()
+		p.stop()
+		Expect(p.done).To(BeClosed())
+		Expect(time.Since(started)).To(BeNumerically("<", procTermTimeout))
+	})
+})
@@ -118,6 +118,15 @@ func CleanupEntities(errCtx *cmn.ETLErrCtx, podName, svcName string) (err error)
 // * svcName - non-empty if at least one attempt of creating service was executed
 // * err - any error occurred that should be passed on.
 func start(msg InitMsg, xid, secret string, config *cmn.Config) (podInfo PodInfo, xctn core.Xact, err error) {
+	if IsProcess(msg) {
+		return startProcess(msg.(*ETLSpecMsg), xid, secret)
+	}
+	if spec, ok := msg.(*ETLSpecMsg); ok && spec.IsWasm() {
+		return startWasm(spec, xid, secret)
+	}
+	if !k8s.IsK8s() {
+		return podInfo, nil, k8s.ErrK8sRequired
+	}
 	var (
 		comm   Communicator
 		errCtx = &cmn.ETLErrCtx{TID: core.T.SID(), ETLName: msg.Name()}
@@ -143,7 +152,7 @@ func start(msg InitMsg, xid, secret string, config *cmn.Config) (podInfo PodInfo
 	boot.createServiceSpec()
 
 	// 2. Create communicator
-	if comm, err = initComm(msg, xid, secret, boot); err != nil {
+	if comm, err = initComm(msg, xid, secret, boot, nil); err != nil {
 		return podInfo, nil, err
 	}
 
@@ -189,6 +198,40 @@ cleanup: // initialization failed
 	return podInfo, nil, cmn.NewErrETL(boot.errCtx, err.Error())
 }
 
+// process runtime: spawn the transformer, wait for its readiness, and connect
+func startProcess(msg *ETLSpecMsg, xid, secret string) (podInfo PodInfo, _ core.Xact, err error) {
+	var (
+		comm   Communicator
+		proc   *etlProcess
+		errCtx = &cmn.ETLErrCtx{TID: core.T.SID(), ETLName: msg.Name()}
+	)
+	debug.Assert(xid != "")
+	if proc, err = newProcess(msg, secret, errCtx); err != nil {
+		return podInfo, nil, err
+	}
+	if comm, err = initComm(msg, xid, secret, nil, proc); err != nil {
+		return podInfo, nil, err
+	}
+	if err = proc.start(comm.Xact()); err != nil {
+		goto cleanup
+	}
+	if err = proc.waitReady(); err != nil {
+		goto cleanup
+	}
+	if _, err = comm.setupConnection("http://", proc.addr); err != nil {
+		goto cleanup
+	}
+
+	nlog.Infof("process %s is running, %+v, %s", proc, msg, errCtx)
+	podInfo.PodName, podInfo.URI = msg.PodName(core.T.SID()), proc.addr
+	return podInfo, comm.Xact(), nil
+
+cleanup:
+	errCtx.PodStatus = proc.getStatus()
+	Stop(msg.Name(), err)
+	return podInfo, nil, cmn.NewErrETL(errCtx, err.Error())
+}
+
 func StopByXid(xid string, errCause error) error {
 	comm := mgr.getByXid(xid)
 	if comm == nil {
@@ -202,13 +245,13 @@ func StopByXid(xid string, errCause error) error {
 // 2. initialization failed
 // 3. transaction/xaction abort (StopByXid)
 func Stop(etlName string, errCause error) (err error) {
-	comm, boot := mgr.getByName(etlName)
-	if comm == nil {
+	ei := mgr.getByName(etlName)
+	if ei.comm == nil {
 		return cos.NewErrNotFound(core.T, etlName+" not found")
 	}
 
 	// Note: comm.stop() is protected by atomic bool, run only once
-	if err := comm.stop(); err != nil {
+	if err := ei.comm.stop(); err != nil {
 		return err
 	}
 
@@ -216,6 +259,16 @@ func Stop(etlName string, errCause error) (err error) {
 		nlog.Infof("Stopping ETL: %s, %v", etlName, errCause)
 	}
 
+	if ei.boot == nil { // process or wasm
+		if ei.proc != nil {
+			ei.proc.stop()
+		}
+		mgr.del(etlName)
+		xreg.AbortKind(errCause, apc.ActETLBck) // (ditto)
+		return nil
+	}
+
+	boot := ei.boot
 	boot.pw.stop(true)
 	mgr.del(etlName)
 
@@ -236,9 +289,6 @@ func Delete(etlName string) error { return Stop(etlName, cmn.ErrXactUserAbort) }
 
 // StopAll terminates all running ETLs.
 func StopAll() {
-	if !k8s.IsK8s() {
-		return
-	}
 	for _, e := range List() {
 		if err := Stop(e.Name, nil); err != nil {
 			nlog.Errorln(err)
@@ -249,24 +299,30 @@ func StopAll() {
 // GetCommunicator retrieves the Communicator from registry by etl name
 // Returns an error if not found or not in the Running stage.
 func GetCommunicator(etlName string) (Communicator, error) {
-	comm, _ := mgr.getByName(etlName)
-	if comm == nil {
+	ei := mgr.getByName(etlName)
+	if ei.comm == nil {
 		return nil, cos.NewErrNotFound(core.T, etlName)
 	}
-	return comm, nil
+	return ei.comm, nil
 }
 
 func GetPipeline(etlNames []string) (apc.ETLPipeline, error) {
 	pipeline := make(apc.ETLPipeline, 0, len(etlNames))
 	for _, name := range etlNames {
-		_, boot := mgr.getByName(name)
-		if boot == nil {
+		ei := mgr.getByName(name)
+		switch {
+		case ei.proc != nil:
+			pipeline.Join("http://" + ei.proc.addr)
+		case ei.wasm() != nil:
+			pipeline.Join(ei.wasm().podURI)
+		case ei.boot != nil:
+			pipeline.Join(ei.boot.schema + ei.boot.addr)
+		default:
 			return nil, cmn.NewErrETL(&cmn.ETLErrCtx{
 				TID:     core.T.SID(),
 				ETLName: name,
 			}, "entry not found in the target", http.StatusNotFound)
 		}
-		pipeline.Join(boot.schema + boot.addr)
 	}
 	if cmn.Rom.V(4, cos.ModETL) {
 		nlog.Infof("etlNames: %v => pipeline: %s", etlNames, pipeline.String())
@@ -306,15 +362,20 @@ func GetOfflineTransform(etlName string, xctn core.Xact) (getROC core.GetROC, xe
 func List() []Info { return mgr.list() }
 
 func PodLogs(etlName string) (logs Logs, err error) {
-	_, boot := mgr.getByName(etlName)
-	if boot == nil {
+	ei := mgr.getByName(etlName)
+	switch {
+	case ei.proc != nil:
+		return Logs{TargetID: core.T.SID(), Logs: ei.proc.logs.get()}, nil
+	case ei.wasm() != nil:
+		return Logs{TargetID: core.T.SID(), Logs: ei.wasm().logs.get()}, nil
+	case ei.boot == nil:
 		return logs, cos.NewErrNotFound(core.T, etlName)
 	}
 	client, err := k8s.GetClient()
 	if err != nil {
 		return logs, err
 	}
-	b, err := client.Logs(boot.pod.GetName())
+	b, err := client.Logs(ei.boot.pod.GetName())
 	if err != nil {
 		return logs, err
 	}
@@ -325,27 +386,42 @@ func PodLogs(etlName string) (logs Logs, err error) {
 }
 
 func PodHealth(etlName string) (string, error) {
-	_, boot := mgr.getByName(etlName)
-	if boot == nil {
+	ei := mgr.getByName(etlName)
+	switch {
+	case ei.proc != nil:
+		return ei.proc.health(), nil
+	case ei.wasm() != nil:
+		return ei.wasm().health(), nil
+	case ei.boot == nil:
 		return "", cos.NewErrNotFound(core.T, etlName)
 	}
 	client, err := k8s.GetClient()
 	if err != nil {
 		return "", err
 	}
-	return client.Health(boot.pod.GetName())
+	return client.Health(ei.boot.pod.GetName())
 }
 
 func PodMetrics(etlName string) (*CPUMemUsed, error) {
-	_, boot := mgr.getByName(etlName)
-	if boot == nil {
+	ei := mgr.getByName(etlName)
+	switch {
+	case ei.proc != nil:
+		cpuUsed, memUsed, err := ei.proc.metrics()
+		if err != nil {
+			return nil, err
+		}
+		return &CPUMemUsed{TargetID: core.T.SID(), CPU: cpuUsed, Mem: memUsed}, nil
+	case ei.wasm() != nil:
+		cpuUsed, memUsed := ei.wasm().metrics()
+		return &CPUMemUsed{TargetID: core.T.SID(), CPU: cpuUsed, Mem: memUsed}, nil
+	case ei.boot == nil:
 		return nil, cos.NewErrNotFound(core.T, etlName)
 	}
 	client, err := k8s.GetClient()
 	if err != nil {
 		return nil, err
 	}
-	cpuUsed, memUsed, err := k8s.Metrics(boot.pod.GetName())
+	cpuUsed, memUsed, err := k8s.Metrics(ei.boot.pod.GetName())
 	if err == nil {
 		return &CPUMemUsed{TargetID: core.T.SID(), CPU: cpuUsed, Mem: memUsed}, nil
 	}
@@ -0,0 +1,658 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/certloader"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/sys"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// WebAssembly communication (`communication: wasm://`):
+// - the transformer is a WebAssembly module (`runtime.module`) that each target validates and
+//   compiles once and then executes in-process, using a pool of sandboxed instances
+//   (runtime: github.com/tetratelabs/wazero - no WASI, no host access other than the imports below);
+// - limits: `resources.limits.memory` - linear memory of a single instance (default: wasmDefaultMemory),
+//   `resources.limits.cpu` - max number of instances running concurrently (default: number of CPUs),
+//   and `obj_timeout` - max time to transform a single object and to instantiate the module
+//   (which runs its start function, if any);
+// - module ABI:
+//     exports: `alloc(size i32) i32`,
+//              `transform(in_ptr, in_len, args_ptr, args_len i32) i64` returning (out_ptr << 32 | out_len),
+//              optional `dealloc(ptr, len i32)`;
+//     imports (optional): `ais.log(ptr, len i32)` - append to the ETL logs,
+//                         `ais.error(ptr, len i32)` - fail the current object with the given message;
+// - per object, the target calls `alloc` once for the input followed by transform args,
+//   then `transform`, and finally `dealloc` (if exported) for the input and the output;
+// - an instance that traps (including out of memory and timeout) is discarded and later replaced;
+// - pipelines: to be one of the (next) stages, the target serves the module via HTTP - same
+//   protocol as ETL web servers (see ext/etl/webserver) - so that WASM and container stages can be mixed.
+
+const (
+	wasmDefaultMemory = 64 * cos.MiB
+	wasmPageSize      = 64 * cos.KiB
+
+	wasmHost      = "ais" // host module (imports)
+	wasmAlloc     = "alloc"
+	wasmTransform = "transform"
+	wasmDealloc   = "dealloc"
+)
+
+type (
+	wasmComm struct {
+		baseComm
+		rt         wazero.Runtime
+		mod        wazero.CompiledModule
+		pool       chan *wasmInst // nil entries: not yet instantiated
+		srv        *http.Server
+		targetURL  string // AIS_TARGET_URL equivalent (direct put)
+		logs       procLogs
+		maxMem     int64
+		objTimeout time.Duration
+		// metrics
+		busy     atomic.Int64 // total nanoseconds spent in transforms
+		memUsed  atomic.Int64 // total linear memory of all instances
+		lastBusy int64
+		lastTime int64
+		mu       sync.Mutex
+		dealloc  bool
+	}
+	wasmInst struct {
+		mod       api.Module
+		alloc     api.Function
+		transform api.Function
+		dealloc   api.Function // optional
+		errMsg    string       // via ais.error
+		mem       int64        // memory size, as accounted in memUsed
+		broken    bool         // trapped (or timed out) - to be discarded
+	}
+
+	wasmInstKey struct{} // context key: current instance (host functions)
+)
+
+// interface guard
+var _ httpCommunicator = (*wasmComm)(nil)
+
+type wasmSig struct {
+	params, results []api.ValueType
+}
+
+var (
+	wasmPtrLen = wasmSig{params: []api.ValueType{api.ValueTypeI32, api.ValueTypeI32}}
+	wasmSigs   = map[string]wasmSig{
+		wasmAlloc: {params: []api.ValueType{api.ValueTypeI32}, results: []api.ValueType{api.ValueTypeI32}},
+		wasmTransform: {
+			params:  []api.ValueType{api.ValueTypeI32, api.ValueTypeI32, api.ValueTypeI32, api.ValueTypeI32},
+			results: []api.ValueType{api.ValueTypeI64},
+		},
+	}
+)
+
+func (s *wasmSig) equal(def api.FunctionDefinition) bool {
+	return slices.Equal(s.params, def.ParamTypes()) && slices.Equal(s.results, def.ResultTypes())
+}
+
+func (s *wasmSig) String() string { return wasmSigString(s.params, s.results) }
+
+func wasmSigString(params, results []api.ValueType) string {
+	names := func(types []api.ValueType) string {
+		s := make([]string, len(types))
+		for i, t := range types {
+			s[i] = api.ValueTypeName(t)
+		}
+		return "(" + strings.Join(s, ", ") + ")"
+	}
+	return names(params) + " -> " + names(results)
+}
+
+// returns max memory per instance and max number of instances
+func (e *ETLSpecMsg) wasmLimits() (maxMem int64, maxInst int, err error) {
+	maxMem, maxInst = wasmDefaultMemory, sys.NumCPU()
+	if q, ok := e.Resources.Limits[corev1.ResourceMemory]; ok {
+		if maxMem = q.Value(); maxMem < wasmPageSize {
+			return 0, 0, fmt.Errorf("resources.limits.memory %s is too small (minimum %s)", q.String(), cos.ToSizeIEC(wasmPageSize, 0))
+		}
+		// (32-bit linear memory)
+		maxMem = min(maxMem, 4*cos.GiB)
+	}
+	if q, ok := e.Resources.Limits[corev1.ResourceCPU]; ok {
+		if maxInst = int((q.MilliValue() + 999) / 1000); maxInst < 1 {
+			return 0, 0, fmt.Errorf("invalid resources.limits.cpu %s", q.String())
+		}
+	}
+	return maxMem, maxInst, nil
+}
+
+// compile, instantiate once (to fail early on unresolved imports and such), and start serving
+func startWasm(msg *ETLSpecMsg, xid, secret string) (podInfo PodInfo, _ core.Xact, err error) {
+	var (
+		comm   Communicator
+		errCtx = &cmn.ETLErrCtx{TID: core.T.SID(), ETLName: msg.Name()}
+	)
+	if comm, err = initComm(msg, xid, secret, nil, nil); err != nil {
+		return podInfo, nil, err
+	}
+	wc := comm.(*wasmComm)
+	if err = wc.load(); err != nil {
+		goto cleanup
+	}
+	wc.client = cmn.CloneClient(core.T.DataClient(), wc.objTimeout) // (next pipeline stages and downloads)
+	if err = wc.listen(core.T.Snode().DataNet.Hostname); err != nil {
+		goto cleanup
+	}
+
+	nlog.Infof("%s is running, %s, %s", wc, msg, errCtx)
+	podInfo.PodName, podInfo.URI = msg.PodName(core.T.SID()), wc.podURI
+	return podInfo, comm.Xact(), nil
+
+cleanup:
+	Stop(msg.Name(), err)
+	return podInfo, nil, cmn.NewErrETL(errCtx, err.Error())
+}
+
+func (c *wasmComm) String() string {
+	return fmt.Sprintf("%s[%s]-%s", c.msg.Cname(), c.xctn.ID(), Wasm)
+}
+
+// validate and compile the module (the runtime rejects malformed and invalid modules,
+// including those that declare more memory than allowed), and check exported functions
+func (c *wasmComm) load() (err error) {
+	msg := c.msg.(*ETLSpecMsg)
+	maxMem, maxInst, err := msg.wasmLimits()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	cfg := wazero.NewRuntimeConfig().
+		WithCoreFeatures(api.CoreFeaturesV2).
+		WithMemoryLimitPages(uint32(maxMem / wasmPageSize)).
+		WithCloseOnContextDone(true) // enforce deadlines (see call)
+	c.rt = wazero.NewRuntimeWithConfig(ctx, cfg)
+	defer func() {
+		if err != nil {
+			c.rt.Close(ctx)
+			c.rt = nil
+		}
+	}()
+	_, err = c.rt.NewHostModuleBuilder(wasmHost).
+		NewFunctionBuilder().WithGoModuleFunction(api.GoModuleFunc(c.hostLog), wasmPtrLen.params, nil).Export("log").
+		NewFunctionBuilder().WithGoModuleFunction(api.GoModuleFunc(hostErr), wasmPtrLen.params, nil).Export("error").
+		Instantiate(ctx)
+	if err != nil {
+		return err
+	}
+	if c.mod, err = c.rt.CompileModule(ctx, msg.Runtime.Module); err != nil {
+		return fmt.Errorf("invalid module: %w", err)
+	}
+	exports := c.mod.ExportedFunctions()
+	for name, sig := range wasmSigs {
+		def, ok := exports[name]
+		if !ok {
+			return fmt.Errorf("module must export %q function", name)
+		}
+		if !sig.equal(def) {
+			return fmt.Errorf("exported %q: invalid signature %s (expecting %s)",
+				name, wasmSigString(def.ParamTypes(), def.ResultTypes()), &sig)
+		}
+	}
+	if def, ok := exports[wasmDealloc]; ok {
+		if !wasmPtrLen.equal(def) {
+			return fmt.Errorf("exported %q: invalid signature %s (expecting %s)",
+				wasmDealloc, wasmSigString(def.ParamTypes(), def.ResultTypes()), &wasmPtrLen)
+		}
+		c.dealloc = true
+	}
+
+	c.maxMem = maxMem
+	_, objTimeout := c.msg.Timeouts()
+	c.objTimeout = objTimeout.D()
+	c.targetURL = core.T.Snode().URL(cmn.NetIntraData) + apc.URLPathETLObject.Join(c.msg.Name(), c.secret)
+	c.lastTime = mono.NanoTime()
+
+	wi, err := c.newInst()
+	if err != nil {
+		return err
+	}
+	c.pool = make(chan *wasmInst, maxInst)
+	c.pool <- wi
+	for range maxInst - 1 {
+		c.pool <- nil
+	}
+	return nil
+}
+
+func (c *wasmComm) listen(hostname string) error {
+	ln, err := net.Listen("tcp", cmn.HostPort(hostname, "0"))
+	if err != nil {
+		return err
+	}
+	var (
+		port   = ln.Addr().(*net.TCPAddr).Port
+		schema = "http://"
+	)
+	c.srv = &http.Server{Handler: c, ReadHeaderTimeout: cmn.Rom.MaxKeepalive()}
+	if c.config.Net.HTTP.UseHTTPS {
+		getCert, err := certloader.GetCert()
+		if err != nil {
+			ln.Close()
+			return err
+		}
+		c.srv.TLSConfig = &tls.Config{GetCertificate: getCert, MinVersion: tls.VersionTLS12}
+		ln = tls.NewListener(ln, c.srv.TLSConfig)
+		schema = "https://"
+	}
+	c.podAddr = cmn.HostPort(hostname, strconv.Itoa(port))
+	c.podURI = schema + c.podAddr
+	go func() {
+		if err := c.srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			nlog.Errorln(c.String(), "server failed:", err)
+		}
+	}()
+	return nil
+}
+
+func (c *wasmComm) stop() error {
+	err := c.baseComm.stop()
+	if c.srv != nil {
+		c.srv.Close()
+	}
+	if c.rt != nil {
+		c.rt.Close(context.Background()) // closes all instances
+	}
+	return err
+}
+
+//
+// instances
+//
+
+// (lazily, when acquired) - the start function, if any, runs under the same limits as transforms
+func (c *wasmComm) newInst() (*wasmInst, error) {
+	wi := &wasmInst{}
+	ctx, cancel := context.WithTimeout(context.WithValue(context.Background(), wasmInstKey{}, wi), c.objTimeout)
+	defer cancel()
+	// anonymous instance, without WASI `_start`
+	mod, err := c.rt.InstantiateModule(ctx, c.mod, wazero.NewModuleConfig().WithName("").WithStartFunctions())
+	if err != nil {
+		return nil, err
+	}
+	if mod.Memory() == nil {
+		mod.Close(ctx)
+		return nil, errors.New("module must define memory")
+	}
+	wi.mod = mod
+	wi.alloc, wi.transform = mod.ExportedFunction(wasmAlloc), mod.ExportedFunction(wasmTransform)
+	if c.dealloc {
+		wi.dealloc = mod.ExportedFunction(wasmDealloc)
+	}
+	wi.mem = int64(mod.Memory().Size())
+	c.memUsed.Add(wi.mem)
+	return wi, nil
+}
+
+func (c *wasmComm) acquire() (wi *wasmInst, err error) {
+	select {
+	case wi = <-c.pool:
+	case <-c.xctn.ChanAbort():
+		return nil, c.xctn.AbortErr()
+	}
+	if wi == nil {
+		if wi, err = c.newInst(); err != nil {
+			c.pool <- nil
+		}
+	}
+	return wi, err
+}
+
+func (c *wasmComm) release(wi *wasmInst) {
+	if wi.broken {
+		wi.mod.Close(context.Background())
+		c.memUsed.Sub(wi.mem)
+		c.pool <- nil
+		return
+	}
+	size := int64(wi.mod.Memory().Size())
+	c.memUsed.Add(size - wi.mem)
+	wi.mem, wi.errMsg = size, ""
+	c.pool <- wi
+}
+
+// host functions: a panic traps the calling instance
+
+func (c *wasmComm) hostLog(_ context.Context, mod api.Module, stack []uint64) {
+	b, ok := mod.Memory().Read(api.DecodeU32(stack[0]), api.DecodeU32(stack[1]))
+	if !ok {
+		panic(errors.New("ais.log: out of bounds"))
+	}
+	c.logs.Write(b)
+	if len(b) > 0 && b[len(b)-1] != '\n' {
+		c.logs.Write([]byte{'\n'})
+	}
+}
+
+func hostErr(ctx context.Context, mod api.Module, stack []uint64) {
+	b, ok := mod.Memory().Read(api.DecodeU32(stack[0]), api.DecodeU32(stack[1]))
+	if !ok {
+		panic(errors.New("ais.error: out of bounds"))
+	}
+	ctx.Value(wasmInstKey{}).(*wasmInst).errMsg = string(b)
+}
+
+//
+// transform
+//
+
+// transform reads the entire input into instance's memory, runs the module,
+// and returns a copy of its output
+func (c *wasmComm) transform(r io.Reader, size int64, targs string) ([]byte, int, error) {
+	if size < 0 {
+		b, err := io.ReadAll(io.LimitReader(r, c.maxMem+1))
+		if err != nil {
+			return nil, 0, err
+		}
+		r, size = bytes.NewReader(b), int64(len(b))
+	}
+	total := size + int64(len(targs))
+	if total >= c.maxMem {
+		return nil, http.StatusRequestEntityTooLarge,
+			fmt.Errorf("%s: input size %s exceeds memory limit %s", c, cos.ToSizeIEC(total, 2), cos.ToSizeIEC(c.maxMem, 2))
+	}
+
+	wi, err := c.acquire()
+	if err != nil {
+		return nil, 0, err
+	}
+	started := mono.NanoTime()
+	out, err := c.call(wi, r, uint32(size), targs)
+	c.busy.Add(mono.SinceNano(started))
+	c.release(wi)
+
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) { // (module closed, see wazero/sys.ExitError)
+			return nil, http.StatusRequestTimeout, fmt.Errorf("%s: %v (obj_timeout %v)", c, err, c.objTimeout)
+		}
+		return nil, 0, fmt.Errorf("%s: %v", c, err)
+	}
+	return out, 0, nil
+}
+
+// any error other than ais.error (e.g., trap, out of memory, deadline) breaks the instance
+func (c *wasmComm) call(wi *wasmInst, r io.Reader, size uint32, targs string) (out []byte, err error) {
+	var (
+		n           = size + uint32(len(targs))
+		ctx, cancel = context.WithTimeout(context.WithValue(context.Background(), wasmInstKey{}, wi), c.objTimeout)
+	)
+	defer func() {
+		cancel()
+		if err != nil && wi.errMsg == "" {
+			wi.broken = true
+		}
+	}()
+	res, err := wi.alloc.Call(ctx, uint64(n))
+	if err != nil {
+		return nil, err
+	}
+	ptr := api.DecodeU32(res[0])
+	mem, ok := wi.mod.Memory().Read(ptr, n)
+	if !ok {
+		return nil, fmt.Errorf("%s(%d) returned invalid address %#x", wasmAlloc, n, ptr)
+	}
+	if _, err := io.ReadFull(r, mem[:size]); err != nil {
+		return nil, err
+	}
+	copy(mem[size:], targs)
+
+	res, err = wi.transform.Call(ctx, uint64(ptr), uint64(size), uint64(ptr+size), uint64(len(targs)))
+	if err != nil {
+		return nil, err
+	}
+	if wi.errMsg != "" {
+		return nil, errors.New(wi.errMsg)
+	}
+	outPtr, outLen := uint32(res[0]>>32), uint32(res[0])
+	b, ok := wi.mod.Memory().Read(outPtr, outLen)
+	if !ok {
+		return nil, fmt.Errorf("%s returned invalid output [%#x, %d]", wasmTransform, outPtr, outLen)
+	}
+	out = bytes.Clone(b)
+
+	if wi.dealloc != nil {
+		if _, err := wi.dealloc.Call(ctx, uint64(ptr), uint64(n)); err != nil {
+			return nil, err
+		}
+		if outPtr != ptr {
+			if _, err := wi.dealloc.Call(ctx, uint64(outPtr), uint64(outLen)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return out, nil
+}
+
+func (c *wasmComm) transformLOM(lom *core.LOM, latestVer, sync bool, targs string) ([]byte, int, error) {
+	if err := c.xctn.AbortErr(); err != nil {
+		return nil, 0, err
+	}
+	if err := lom.InitBck(lom.Bck()); err != nil {
+		return nil, 0, err
+	}
+	resp := lom.GetROC(latestVer, sync)
+	if resp.Err != nil {
+		return nil, resp.Ecode, resp.Err
+	}
+	out, ecode, err := c.transform(resp.R, resp.OAH.Lsize(), targs)
+	cos.Close(resp.R)
+	return out, ecode, err
+}
+
+// forward transformed object to the next pipeline stage: ETL server or destination
+// target (direct put); same request and response semantics as in ext/etl/webserver
+func (c *wasmComm) forward(out []byte, objPath, pipeline string) core.ReadResp {
+	var (
+		next, rest, _ = strings.Cut(pipeline, apc.ETLPipelineSeparator)
+		oah           = &cos.SimpleOAH{Size: int64(len(out)), Atime: time.Now().UnixNano()}
+	)
+	direct, err := url.Parse(strings.TrimSpace(next))
+	if err != nil {
+		return core.ReadResp{Err: err}
+	}
+	host, err := url.Parse(c.targetURL)
+	if err != nil {
+		return core.ReadResp{Err: err}
+	}
+	finalURL := *host
+	finalURL.Scheme, finalURL.Host, finalURL.RawQuery = direct.Scheme, direct.Host, direct.RawQuery
+	if direct.Path != "" {
+		finalURL.Path = cos.JoinPath(host.Path, direct.Path)
+	} else {
+		finalURL.Path = objPath
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPut, finalURL.String(), bytes.NewReader(out))
+	if err != nil {
+		return core.ReadResp{Err: err}
+	}
+	req.ContentLength = int64(len(out))
+	if rest = strings.TrimSpace(rest); rest != "" {
+		req.Header.Set(apc.HdrNodeURL, rest)
+	}
+	resp, err := c.client.Do(req) //nolint:bodyclose // closed by handleRespEcode or the caller
+	if err != nil {
+		return core.ReadResp{Err: err}
+	}
+	if cmn.Rom.V(5, cos.ModETL) {
+		nlog.Infoln(Wasm, objPath, "=>", next, resp.StatusCode)
+	}
+	if resp.StatusCode == http.StatusOK && resp.ContentLength <= 0 {
+		// delivered to the destination target
+		resp.Body.Close()
+		return handleRespEcode(http.StatusNoContent, oah, nil, nil)
+	}
+	oah.Size = resp.ContentLength
+	return handleRespEcode(resp.StatusCode, oah, cos.NopOpener(resp.Body), nil)
+}
+
+//
+// httpCommunicator
+//
+
+func (c *wasmComm) doRequest(lom *core.LOM, args *core.ETLArgs, latestVer, sync bool) core.ReadResp {
+	var targs string
+	if args != nil {
+		targs = args.TransformArgs
+	}
+	out, ecode, err := c.transformLOM(lom, latestVer, sync, targs)
+	if err != nil {
+		return core.ReadResp{Err: err, Ecode: ecode}
+	}
+	if args != nil && len(args.Pipeline) > 0 {
+		return c.forward(out, "/"+lom.Bck().Name+"/"+lom.ObjName, args.Pipeline.Pack())
+	}
+	oah := &cos.SimpleOAH{Size: int64(len(out)), Atime: time.Now().UnixNano()}
+	return core.ReadResp{R: cos.NewByteReader(out), OAH: oah, Ecode: http.StatusOK}
+}
+
+func (c *wasmComm) InlineTransform(w http.ResponseWriter, _ *http.Request, lom *core.LOM, args *InlineTransArgs) (int64, int, error) {
+	resp := c.doRequest(lom, &core.ETLArgs{TransformArgs: args.TransformArgs, Pipeline: args.Pipeline}, args.LatestVer, false /*sync*/)
+	if resp.Err != nil {
+		return 0, resp.Ecode, resp.Err
+	}
+	if size := resp.OAH.Lsize(); size >= 0 {
+		w.Header().Set(cos.HdrContentLength, strconv.FormatInt(size, 10))
+	}
+	w.WriteHeader(http.StatusOK)
+	n, err := io.Copy(w, resp.R)
+	resp.R.Close()
+	return n, 0, err
+}
+
+func (c *wasmComm) OfflineTransform(lom *core.LOM, latestVer, sync bool, args *core.ETLArgs) core.ReadResp {
+	resp := c.doRequest(lom, args, latestVer, sync)
+	if cmn.Rom.V(5, cos.ModETL) {
+		nlog.Infoln(Wasm, lom.Cname(), resp.Err, resp.Ecode)
+	}
+	return resp
+}
+
+func (c *wasmComm) ProcessDownloadJob(ctx *ETLObjDownloadCtx) (cos.ReadCloseSizer, int, error) {
+	if ctx.ObjName == "" || ctx.Link == "" {
+		return nil, http.StatusBadRequest, errors.New("missing objName or link in ETL job context")
+	}
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, ctx.Link, http.NoBody)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to download %s: %v", ctx.Link, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, resp.StatusCode, fmt.Errorf("failed to download %s: status %d", ctx.Link, resp.StatusCode)
+	}
+	out, ecode, err := c.transform(resp.Body, resp.ContentLength, ctx.ETLArgs)
+	if err != nil {
+		return nil, ecode, err
+	}
+	return cos.NewByteReader(out), http.StatusOK, nil
+}
+
+//
+// pipeline stage (see listen)
+//
+
+// PUT /<bucket>/<object> (the body is the object content) and GET /health
+func (c *wasmComm) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		if r.URL.Path != "/"+apc.ETLHealth {
+			cmn.WriteErr(w, r, errors.New("invalid path "+r.URL.Path), http.StatusNotFound)
+		}
+		return
+	case http.MethodPut:
+	default:
+		cmn.WriteErr405(w, r, http.MethodGet, http.MethodPut)
+		return
+	}
+
+	out, ecode, err := c.transform(r.Body, r.ContentLength, r.URL.Query().Get(apc.QparamETLTransformArgs))
+	if err != nil {
+		cmn.WriteErr(w, r, err, ecode)
+		return
+	}
+	if pipeline := r.Header.Get(apc.HdrNodeURL); pipeline != "" {
+		resp := c.forward(out, r.URL.Path, pipeline)
+		switch {
+		case resp.Err == cmn.ErrSkip: //nolint:errorlint // sentinel
+			w.WriteHeader(http.StatusNoContent)
+		case resp.Err != nil:
+			cmn.WriteErr(w, r, resp.Err, resp.Ecode)
+		default:
+			if size := resp.OAH.Lsize(); size > 0 {
+				w.Header().Set(cos.HdrContentLength, strconv.FormatInt(size, 10))
+			}
+			w.WriteHeader(http.StatusOK)
+			io.Copy(w, resp.R)
+			resp.R.Close()
+		}
+		return
+	}
+	w.Header().Set(cos.HdrContentLength, strconv.Itoa(len(out)))
+	w.WriteHeader(http.StatusOK)
+	w.Write(out)
+}
+
+//
+// logs, health, and metrics
+//
+
+func (c *wasmComm) health() string {
+	if c.stopped.Load() {
+		return string(corev1.PodFailed)
+	}
+	return string(corev1.PodRunning)
+}
+
+// CPU (in cores) since the previous call, and total linear memory of all instances
+func (c *wasmComm) metrics() (float64, int64) {
+	var (
+		cores float64
+		now   = mono.NanoTime()
+		busy  = c.busy.Load()
+	)
+	c.mu.Lock()
+	if elapsed := now - c.lastTime; elapsed > 0 {
+		cores = float64(busy-c.lastBusy) / float64(elapsed)
+	}
+	c.lastBusy, c.lastTime = busy, now
+	c.mu.Unlock()
+	return min(cores, float64(runtime.NumCPU())), c.memUsed.Load()
+}
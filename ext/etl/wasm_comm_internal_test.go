@@ -0,0 +1,254 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/ext/etl/wasmtest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/tetratelabs/wazero/api"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ = Describe("ETLWasmTest", func() {
+	newMsg := func(module []byte) *ETLSpecMsg {
+		return &ETLSpecMsg{
+			InitMsgBase: InitMsgBase{EtlName: "test-wasm", CommTypeX: Wasm, ObjTimeout: cos.Duration(5 * time.Second)},
+			Runtime:     RuntimeSpec{Module: module},
+		}
+	}
+	newComm := func(msg *ETLSpecMsg) *wasmComm {
+		_ = mock.NewTarget(mock.NewBaseBownerMock())
+		Expect(msg.Validate()).NotTo(HaveOccurred())
+
+		comm, err := newCommunicator(msg, "secret", cmn.GCO.Get())
+		Expect(err).NotTo(HaveOccurred())
+		wc := comm.(*wasmComm)
+		wc.xctn = &XactETL{}
+		wc.xctn.InitBase(cos.GenUUID(), apc.ActETLInline, nil)
+		Expect(wc.load()).NotTo(HaveOccurred())
+		wc.client = &http.Client{}
+		return wc
+	}
+
+	Context("Validate", func() {
+		It("accepts module without image", func() {
+			msg := newMsg(wasmtest.Upper())
+			Expect(msg.Validate()).NotTo(HaveOccurred())
+			Expect(msg.IsWasm()).To(BeTrue())
+			Expect(msg.IsDirectPut()).To(BeTrue())
+			Expect(NeedsK8s(msg)).To(BeFalse())
+		})
+		It("requires module", func() {
+			Expect(newMsg(nil).Validate()).To(HaveOccurred())
+		})
+		It("rejects image", func() {
+			msg := newMsg(wasmtest.Upper())
+			msg.Runtime.Image = "aistore/transformer"
+			Expect(msg.Validate()).To(HaveOccurred())
+		})
+		It("rejects module with other communication types", func() {
+			msg := newMsg(wasmtest.Upper())
+			msg.CommTypeX = Hpush
+			Expect(msg.Validate()).To(HaveOccurred())
+		})
+		It("parses limits", func() {
+			msg := newMsg(wasmtest.Upper())
+			msg.Resources.Limits = corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("1Mi"),
+				corev1.ResourceCPU:    resource.MustParse("1500m"),
+			}
+			maxMem, maxInst, err := msg.wasmLimits()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(maxMem).To(Equal(int64(cos.MiB)))
+			Expect(maxInst).To(Equal(2))
+
+			msg.Resources.Limits[corev1.ResourceMemory] = resource.MustParse("1Ki")
+			Expect(msg.Validate()).To(HaveOccurred())
+		})
+		It("limits instantiation", func() {
+			msg := newMsg(wasmtest.SpinningStart())
+			msg.ObjTimeout = cos.Duration(100 * time.Millisecond)
+			Expect(msg.Validate()).NotTo(HaveOccurred())
+			_ = mock.NewTarget(mock.NewBaseBownerMock())
+			comm, err := newCommunicator(msg, "secret", cmn.GCO.Get())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(comm.(*wasmComm).load()).To(MatchError(context.DeadlineExceeded))
+		})
+		It("rejects malformed module", func() {
+			msg := newMsg([]byte("\x00asm\x01\x00\x00\x00\x01"))
+			Expect(msg.Validate()).NotTo(HaveOccurred())
+			_ = mock.NewTarget(mock.NewBaseBownerMock())
+			comm, err := newCommunicator(msg, "secret", cmn.GCO.Get())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(comm.(*wasmComm).load()).To(MatchError(ContainSubstring("invalid module")))
+		})
+		It("rejects module that does not validate", func() {
+			// well-formed but ill-typed: i32.add on an empty stack
+			m := &wasmtest.Module{
+				Funcs: []wasmtest.Func{
+					{Type: wasmtest.FuncType{Params: []api.ValueType{api.ValueTypeI32}, Results: []api.ValueType{api.ValueTypeI32}}, Code: wasmtest.Code(0x6a)},
+				},
+				Exports:  map[string]uint32{"alloc": 0},
+				MemPages: 1,
+			}
+			msg := newMsg(m.Bytes())
+			_ = mock.NewTarget(mock.NewBaseBownerMock())
+			comm, err := newCommunicator(msg, "secret", cmn.GCO.Get())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(comm.(*wasmComm).load()).To(MatchError(ContainSubstring("invalid module")))
+		})
+		It("rejects module that declares more memory than allowed", func() {
+			m := &wasmtest.Module{
+				Funcs:    []wasmtest.Func{{Type: wasmtest.FuncType{}}},
+				Exports:  map[string]uint32{},
+				MemPages: 2,
+			}
+			msg := newMsg(m.Bytes())
+			msg.Resources.Limits = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("64Ki")}
+			_ = mock.NewTarget(mock.NewBaseBownerMock())
+			comm, err := newCommunicator(msg, "secret", cmn.GCO.Get())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(comm.(*wasmComm).load()).To(MatchError(ContainSubstring("over limit")))
+		})
+	})
+
+	Context("transform", func() {
+		It("transforms and logs args", func() {
+			wc := newComm(newMsg(wasmtest.Upper()))
+			out, _, err := wc.transform(strings.NewReader("hello, wasm"), -1, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(out)).To(Equal("HELLO, WASM"))
+
+			out, _, err = wc.transform(strings.NewReader("abc"), 3, "some-args")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(out)).To(Equal("ABC"))
+			Expect(string(wc.logs.get())).To(Equal("some-args\n"))
+
+			_, memUsed := wc.metrics()
+			Expect(memUsed).To(BeNumerically(">=", 1<<16))
+		})
+
+		It("fails via ais.error", func() {
+			wc := newComm(newMsg(wasmtest.Failing("bad input")))
+			_, _, err := wc.transform(strings.NewReader("abc"), 3, "")
+			Expect(err).To(MatchError(ContainSubstring("bad input")))
+
+			// the instance is kept and the error does not stick
+			wi := <-wc.pool
+			Expect(wi).NotTo(BeNil())
+			Expect(wi.errMsg).To(BeEmpty())
+			wc.pool <- wi
+		})
+
+		It("enforces object timeout", func() {
+			msg := newMsg(wasmtest.Spinning())
+			msg.ObjTimeout = cos.Duration(100 * time.Millisecond)
+			wc := newComm(msg)
+
+			started := time.Now()
+			_, ecode, err := wc.transform(strings.NewReader("abc"), 3, "")
+			Expect(err).To(HaveOccurred())
+			Expect(ecode).To(Equal(http.StatusRequestTimeout))
+			Expect(time.Since(started)).To(BeNumerically("<", 5*time.Second))
+
+			// broken instance is dropped
+			_, memUsed := wc.metrics()
+			Expect(memUsed).To(BeZero())
+		})
+
+		It("enforces memory limit", func() {
+			msg := newMsg(wasmtest.Upper())
+			msg.Resources.Limits = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("128Ki")}
+			wc := newComm(msg)
+
+			_, ecode, err := wc.transform(bytes.NewReader(make([]byte, 256*cos.KiB)), -1, "")
+			Expect(err).To(HaveOccurred())
+			Expect(ecode).To(Equal(http.StatusRequestEntityTooLarge))
+
+			// fits the limit but the allocator runs out of memory on the second object
+			_, _, err = wc.transform(bytes.NewReader(make([]byte, 100*cos.KiB)), -1, "")
+			Expect(err).NotTo(HaveOccurred())
+			_, _, err = wc.transform(bytes.NewReader(make([]byte, 100*cos.KiB)), -1, "")
+			Expect(err).To(HaveOccurred())
+
+			// and gets replaced by a fresh instance
+			out, _, err := wc.transform(strings.NewReader("abc"), 3, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(out)).To(Equal("ABC"))
+		})
+	})
+
+	Context("pipeline", func() {
+		var (
+			wc       *wasmComm
+			received []byte
+			nodeURL  string
+			target   *httptest.Server
+		)
+		BeforeEach(func() {
+			received, nodeURL = nil, ""
+			target = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				received, _ = io.ReadAll(r.Body)
+				nodeURL = r.Header.Get(apc.HdrNodeURL)
+			}))
+			wc = newComm(newMsg(wasmtest.Upper()))
+			Expect(wc.listen("127.0.0.1")).NotTo(HaveOccurred())
+		})
+		AfterEach(func() {
+			wc.stop()
+			target.Close()
+		})
+
+		It("serves as pipeline stage", func() {
+			resp, err := http.Get(wc.podURI + "/" + apc.ETLHealth)
+			Expect(err).NotTo(HaveOccurred())
+			resp.Body.Close()
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+			req, err := http.NewRequest(http.MethodPut, wc.podURI+"/bck/obj", strings.NewReader("hello"))
+			Expect(err).NotTo(HaveOccurred())
+			resp, err = http.DefaultClient.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+			b, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(b)).To(Equal("HELLO"))
+		})
+
+		It("forwards to the next stage", func() {
+			req, err := http.NewRequest(http.MethodPut, wc.podURI+"/bck/obj", strings.NewReader("hello"))
+			Expect(err).NotTo(HaveOccurred())
+			req.Header.Set(apc.HdrNodeURL, target.URL+"/dst"+apc.ETLPipelineSeparator+"http://next")
+			resp, err := http.DefaultClient.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+			resp.Body.Close()
+
+			Expect(resp.StatusCode).To(Equal(http.StatusNoContent))
+			Expect(string(received)).To(Equal("HELLO"))
+			Expect(nodeURL).To(Equal("http://next"))
+		})
+
+		It("reports running and then stopped", func() {
+			Expect(wc.health()).To(Equal(string(corev1.PodRunning)))
+			wc.stop()
+			Expect(wc.health()).To(Equal(string(corev1.PodFailed)))
+		})
+	})
+})
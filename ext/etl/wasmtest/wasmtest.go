@@ -0,0 +1,332 @@
+// Package wasmtest provides a minimal WebAssembly module builder for unit tests.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package wasmtest
+
+import (
+	"encoding/binary"
+	"math"
+	"sort"
+
+	"github.com/tetratelabs/wazero/api"
+)
+
+const funcRef = 0x70 // table element type
+
+type (
+	FuncType struct {
+		Params, Results []api.ValueType
+	}
+	Import struct {
+		Module, Name string
+		Type         FuncType
+	}
+	Func struct {
+		Type   FuncType
+		Locals []api.ValueType
+		Code   []byte // without the final `end`
+	}
+	Data struct {
+		Bytes  []byte
+		Offset uint32
+	}
+
+	// Each imported and defined function gets its own type, with the type index
+	// equal to the function index (e.g., for call_indirect).
+	Module struct {
+		Exports  map[string]uint32 // function index (imports first)
+		Imports  []Import
+		Funcs    []Func
+		Data     []Data
+		Table    []uint32 // function indices at offset zero
+		MemPages uint32
+		MemMax   uint32 // zero: no maximum
+		Start    uint32 // start function index + 1 (zero: none)
+		NoMemory bool
+	}
+)
+
+// unsigned and signed LEB128
+func U32(v uint32) []byte { return binary.AppendUvarint(nil, uint64(v)) }
+
+func I32(v int32) []byte { return I64(int64(v)) }
+
+func I64(v int64) (b []byte) {
+	for {
+		c := byte(v & 0x7f)
+		v >>= 7
+		if (v == 0 && c&0x40 == 0) || (v == -1 && c&0x40 != 0) {
+			return append(b, c)
+		}
+		b = append(b, c|0x80)
+	}
+}
+
+func F64(v float64) []byte { return binary.LittleEndian.AppendUint64(nil, math.Float64bits(v)) }
+
+// Code concatenates instructions and immediates
+func Code(parts ...any) (b []byte) {
+	for _, p := range parts {
+		switch x := p.(type) {
+		case byte: // (including api.ValueType)
+			b = append(b, x)
+		case int:
+			b = append(b, byte(x))
+		case []byte:
+			b = append(b, x...)
+		default:
+			panic(p)
+		}
+	}
+	return b
+}
+
+func vec(n int, items []byte) []byte { return append(U32(uint32(n)), items...) }
+
+func section(b []byte, id byte, n int, items []byte) []byte {
+	body := vec(n, items)
+	b = append(b, id)
+	b = append(b, U32(uint32(len(body)))...)
+	return append(b, body...)
+}
+
+func funcType(ft *FuncType) []byte {
+	b := []byte{0x60}
+	b = append(b, U32(uint32(len(ft.Params)))...)
+	for _, t := range ft.Params {
+		b = append(b, byte(t))
+	}
+	b = append(b, U32(uint32(len(ft.Results)))...)
+	for _, t := range ft.Results {
+		b = append(b, byte(t))
+	}
+	return b
+}
+
+func name(s string) []byte { return append(U32(uint32(len(s))), s...) }
+
+func (m *Module) Bytes() []byte {
+	b := []byte("\x00asm\x01\x00\x00\x00")
+
+	// types
+	var items []byte
+	for i := range m.Imports {
+		items = append(items, funcType(&m.Imports[i].Type)...)
+	}
+	for i := range m.Funcs {
+		items = append(items, funcType(&m.Funcs[i].Type)...)
+	}
+	b = section(b, 1, len(m.Imports)+len(m.Funcs), items)
+
+	// imports
+	if len(m.Imports) > 0 {
+		items = items[:0]
+		for i, imp := range m.Imports {
+			items = append(items, name(imp.Module)...)
+			items = append(items, name(imp.Name)...)
+			items = append(items, 0)
+			items = append(items, U32(uint32(i))...)
+		}
+		b = section(b, 2, len(m.Imports), items)
+	}
+
+	// functions
+	items = items[:0]
+	for i := range m.Funcs {
+		items = append(items, U32(uint32(len(m.Imports)+i))...)
+	}
+	b = section(b, 3, len(m.Funcs), items)
+
+	// table
+	if len(m.Table) > 0 {
+		items = append([]byte{funcRef, 0}, U32(uint32(len(m.Table)))...)
+		b = section(b, 4, 1, items)
+	}
+
+	// memory
+	if !m.NoMemory {
+		if m.MemMax > 0 {
+			items = append(append([]byte{1}, U32(m.MemPages)...), U32(m.MemMax)...)
+		} else {
+			items = append([]byte{0}, U32(m.MemPages)...)
+		}
+		b = section(b, 5, 1, items)
+	}
+
+	// exports (sorted for deterministic output)
+	names := make([]string, 0, len(m.Exports))
+	for n := range m.Exports {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	items = items[:0]
+	for _, n := range names {
+		items = append(items, name(n)...)
+		items = append(items, 0)
+		items = append(items, U32(m.Exports[n])...)
+	}
+	b = section(b, 7, len(names), items)
+
+	// start
+	if m.Start > 0 {
+		idx := U32(m.Start - 1) // (not a vector)
+		b = append(b, 8)
+		b = append(b, U32(uint32(len(idx)))...)
+		b = append(b, idx...)
+	}
+
+	// elements
+	if len(m.Table) > 0 {
+		items = []byte{0, 0x41, 0, 0x0b}
+		items = append(items, U32(uint32(len(m.Table)))...)
+		for _, idx := range m.Table {
+			items = append(items, U32(idx)...)
+		}
+		b = section(b, 9, 1, items)
+	}
+
+	// code
+	items = items[:0]
+	for i := range m.Funcs {
+		f := &m.Funcs[i]
+		body := U32(uint32(len(f.Locals)))
+		for _, t := range f.Locals {
+			body = append(body, 1, byte(t))
+		}
+		body = append(body, f.Code...)
+		body = append(body, 0x0b)
+		items = append(items, U32(uint32(len(body)))...)
+		items = append(items, body...)
+	}
+	b = section(b, 10, len(m.Funcs), items)
+
+	// data
+	if len(m.Data) > 0 {
+		items = items[:0]
+		for _, d := range m.Data {
+			items = append(items, 0, 0x41)
+			items = append(items, I32(int32(d.Offset))...)
+			items = append(items, 0x0b)
+			items = append(items, U32(uint32(len(d.Bytes)))...)
+			items = append(items, d.Bytes...)
+		}
+		b = section(b, 11, len(m.Data), items)
+	}
+	return b
+}
+
+//
+// ready-to-use ETL modules (see ext/etl: alloc and transform exports, ais.* imports)
+//
+
+var (
+	tI32     = []api.ValueType{api.ValueTypeI32}
+	tI32x2   = []api.ValueType{api.ValueTypeI32, api.ValueTypeI32}
+	tI32x4   = []api.ValueType{api.ValueTypeI32, api.ValueTypeI32, api.ValueTypeI32, api.ValueTypeI32}
+	logType  = FuncType{Params: tI32x2}
+	xfrmType = FuncType{Params: tI32x4, Results: []api.ValueType{api.ValueTypeI64}}
+)
+
+// bump allocator: heap top is kept at address zero (initially 16); grows memory as needed
+var allocFunc = Func{
+	Type:   FuncType{Params: tI32, Results: tI32},
+	Locals: tI32, // p: local 1
+	Code: Code(
+		0x41, 0, 0x28, 2, 0, 0x21, 1, // p = i32.load(0)
+		0x02, 0x40, 0x03, 0x40, // block, loop
+		0x20, 1, 0x20, 0, 0x6a, // p + n
+		0x3f, 0, 0x41, 16, 0x74, // memory.size << 16
+		0x4d, 0x0d, 1, // le_u, br_if 1
+		0x41, 1, 0x40, 0, 0x41, 0x7f, 0x46, // memory.grow(1) == -1
+		0x04, 0x40, 0x00, 0x0b, // if: unreachable
+		0x0c, 0, // br 0
+		0x0b, 0x0b, // end loop, end block
+		0x41, 0, 0x20, 1, 0x20, 0, 0x6a, 0x36, 2, 0, // i32.store(0, p + n)
+		0x20, 1, // p
+	),
+}
+
+// Upper returns module that uppercases ASCII in place and logs the transform args (if any).
+func Upper() []byte {
+	m := &Module{
+		Imports: []Import{{Module: "ais", Name: "log", Type: logType}},
+		Funcs: []Func{
+			allocFunc,
+			{
+				Type:   xfrmType,
+				Locals: tI32x2, // i: local 4, c: local 5
+				Code: Code(
+					0x02, 0x40, 0x03, 0x40, // block, loop
+					0x20, 4, 0x20, 1, 0x4f, 0x0d, 1, // i >= len: br_if 1
+					0x20, 0, 0x20, 4, 0x6a, // addr = in + i
+					0x20, 0, 0x20, 4, 0x6a, 0x2d, 0, 0, 0x22, 5, 0x41, 32, 0x6b, // c = load8_u(addr); c - 32
+					0x20, 5, // c
+					0x20, 5, 0x41, 0xe1, 0x00, 0x6b, 0x41, 26, 0x49, // c - 'a' < 26
+					0x1b,       // select
+					0x3a, 0, 0, // i32.store8
+					0x20, 4, 0x41, 1, 0x6a, 0x21, 4, // i++
+					0x0c, 0, // br 0
+					0x0b, 0x0b,
+					0x20, 3, 0x04, 0x40, 0x20, 2, 0x20, 3, 0x10, 0, 0x0b, // if argsLen: ais.log(args, argsLen)
+					0x20, 0, 0xad, 0x42, 32, 0x86, 0x20, 1, 0xad, 0x84, // (in << 32) | len
+				),
+			},
+		},
+		Exports:  map[string]uint32{"alloc": 1, "transform": 2},
+		MemPages: 1,
+		Data:     []Data{{Offset: 0, Bytes: []byte{16, 0, 0, 0}}},
+	}
+	return m.Bytes()
+}
+
+// Failing returns module whose transform reports (via ais.error) the given message.
+func Failing(msg string) []byte {
+	m := &Module{
+		Imports: []Import{{Module: "ais", Name: "error", Type: logType}},
+		Funcs: []Func{
+			allocFunc,
+			{
+				Type: xfrmType,
+				Code: Code(
+					0x41, I32(64), 0x41, I32(int32(len(msg))), 0x10, 0, // ais.error(64, len)
+					0x42, 0, // 0
+				),
+			},
+		},
+		Exports:  map[string]uint32{"alloc": 1, "transform": 2},
+		MemPages: 1,
+		Data:     []Data{{Offset: 0, Bytes: []byte{16, 0, 0, 0}}, {Offset: 64, Bytes: []byte(msg)}},
+	}
+	return m.Bytes()
+}
+
+// SpinningStart returns module whose start function (executed upon instantiation) never returns.
+func SpinningStart() []byte {
+	m := &Module{
+		Funcs: []Func{
+			allocFunc,
+			{Type: xfrmType, Code: Code(0x42, 0)},
+			{Type: FuncType{}, Code: Code(0x03, 0x40, 0x0c, 0, 0x0b)},
+		},
+		Exports:  map[string]uint32{"alloc": 0, "transform": 1},
+		Start:    3,
+		MemPages: 1,
+		Data:     []Data{{Offset: 0, Bytes: []byte{16, 0, 0, 0}}},
+	}
+	return m.Bytes()
+}
+
+// Spinning returns module whose transform never returns.
+func Spinning() []byte {
+	m := &Module{
+		Funcs: []Func{
+			allocFunc,
+			{Type: xfrmType, Code: Code(0x03, 0x40, 0x0c, 0, 0x0b, 0x42, 0)},
+		},
+		Exports:  map[string]uint32{"alloc": 0, "transform": 1},
+		MemPages: 1,
+		Data:     []Data{{Offset: 0, Bytes: []byte{16, 0, 0, 0}}},
+	}
+	return m.Bytes()
+}
@@ -26,6 +26,7 @@ const (
 	ECMetaCT    = "mt"
 	ChunkCT     = "ch"
 	ChunkMetaCT = "ut"
+	ArchIdxCT   = "ai" // TAR index (see cmn/archive/index.go)
 
 	// ext
 	DsortFileCT = "ds"
@@ -66,6 +67,7 @@ type (
 	ecMetaCR    struct{}
 	objChunkCR  struct{}
 	chunkMetaCR struct{}
+	archIdxCR   struct{}
 	dsortCR     struct{}
 )
 
@@ -91,6 +93,7 @@ var (
 	_ contentRes = (*ecMetaCR)(nil)
 	_ contentRes = (*objChunkCR)(nil)
 	_ contentRes = (*chunkMetaCR)(nil)
+	_ contentRes = (*archIdxCR)(nil)
 )
 
 // register all content types
@@ -110,6 +113,7 @@ func (csm *contentSpecMgr) regAll() {
 	csm._reg(ECMetaCT, &ecMetaCR{})
 	csm._reg(ChunkCT, &objChunkCR{})
 	csm._reg(ChunkMetaCT, &chunkMetaCR{})
+	csm._reg(ArchIdxCT, &archIdxCR{})
 
 	csm._reg(DsortFileCT, &dsortCR{})
 	csm._reg(DsortWorkCT, &dsortCR{})
@@ -223,6 +227,9 @@ func (*workCR) parseUbase(base string) (ci ContentInfo) {
 // - ecMetaCR: erasure coding metadata (%mt/ directory)
 //   Contains EC reconstruction information, lifecycle tied to EC slices
 //
+// - archIdxCR: persistent TAR index (%ai/ directory)
+//   Derived from the object on first (archpath) access, removed with the object
+//
 // All use pass-through makeUbase/parseUbase since the content type
 // in the path provides sufficient identification for cleanup and management.
 
@@ -290,6 +297,12 @@ func (*ecMetaCR) parseUbase(base string) ContentInfo {
 	return ContentInfo{Base: base, Ok: true}
 }
 
+func (*archIdxCR) makeUbase(base string, _ ...string) string { return base }
+
+func (*archIdxCR) parseUbase(base string) ContentInfo {
+	return ContentInfo{Base: base, Ok: true}
+}
+
 func (*dsortCR) makeUbase(base string, _ ...string) string { return base }
 
 func (*dsortCR) parseUbase(base string) ContentInfo {
@@ -36,6 +36,7 @@ const (
 	WorkfileAppend       = "append"         // APPEND to object (as file)
 	WorkfileAppendToArch = "append-to-arch" // APPEND to existing archive
 	WorkfileCreateArch   = "create-arch"    // CREATE multi-object archive
+	WorkfileArchIdx      = "arch-idx"       // persist TAR index (see ArchIdxCT)
 )
 
 type ParsedFQN struct {
@@ -206,6 +207,8 @@ func CleanPathErr(err error) {
 			what = "chunk"
 		case ChunkMetaCT:
 			what = "chunk manifest"
+		case ArchIdxCT:
+			what = "archive index"
 		default:
 			what = fmt.Sprintf("content type '%s'(?)", parsed.ContentType)
 		}
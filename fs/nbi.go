@@ -18,7 +18,7 @@ import (
 )
 
 // In this source:
-//  (1) NBI xattr v1 (pre-manifest)
+//  (1) NBI xattr v1 (pre-manifest) and v2 (re-sync and retention)
 //  (2) NBI discovery
 
 //
@@ -40,6 +40,11 @@ import (
 //   - nat      : number of active targets at creation time
 //   - prefix   : lsmsg.Prefix used to generate the inventory
 //
+// v2 appends (re-sync, retention, and incremental):
+//
+// | flags | names-per-chunk | interval | after-ops | keep  | gen   | incremental | props  | series | base   |
+// |  u64  |      int64      |  int64   |   int64   | int32 | int32 |    bool     | string | string | string |
+//
 // Notes:
 //   - `prefix` is variable-length stored last.
 //   - All fixed-size fields must be appended only; do not reorder existing fields.
@@ -48,7 +53,8 @@ import (
 //
 
 const (
-	nbiMetaVersion = 1
+	nbiMetaVersionV1 = 1
+	nbiMetaVersion   = 2
 )
 
 type nbiXattr struct {
@@ -173,7 +179,9 @@ var (
 )
 
 func (x *nbiXattr) PackedSize() int {
-	return 1 + 4*cos.SizeofI64 + 2*cos.SizeofI32 + cos.PackedStrLen(x.Prefix)
+	return 1 + 4*cos.SizeofI64 + 2*cos.SizeofI32 + cos.PackedStrLen(x.Prefix) +
+		4*cos.SizeofI64 + 2*cos.SizeofI32 + 1 +
+		cos.PackedStrLen(x.Props) + cos.PackedStrLen(x.Series) + cos.PackedStrLen(x.Base)
 }
 
 func (x *nbiXattr) Pack(packer *cos.BytePack) {
@@ -185,6 +193,17 @@ func (x *nbiXattr) Pack(packer *cos.BytePack) {
 	packer.WriteInt32(x.Chunks)
 	packer.WriteInt32(x.Nat)
 	packer.WriteString(x.Prefix)
+	// v2
+	packer.WriteUint64(x.Flags)
+	packer.WriteInt64(x.NamesPerChunk)
+	packer.WriteInt64(int64(x.Schedule.Interval))
+	packer.WriteInt64(x.Schedule.AfterOps)
+	packer.WriteInt32(x.Keep)
+	packer.WriteInt32(x.Gen)
+	packer.WriteBool(x.Schedule.Incremental)
+	packer.WriteString(x.Props)
+	packer.WriteString(x.Series)
+	packer.WriteString(x.Base)
 }
 
 func (x *nbiXattr) Unpack(unpacker *cos.ByteUnpack) (err error) {
@@ -211,7 +230,41 @@ func (x *nbiXattr) Unpack(unpacker *cos.ByteUnpack) (err error) {
 		return
 	}
 
-	x.Prefix, err = unpacker.ReadString()
+	if x.Prefix, err = unpacker.ReadString(); err != nil || x.version == nbiMetaVersionV1 {
+		return
+	}
+
+	// v2
+	var ival int64
+	if x.Flags, err = unpacker.ReadUint64(); err != nil {
+		return
+	}
+	if x.NamesPerChunk, err = unpacker.ReadInt64(); err != nil {
+		return
+	}
+	if ival, err = unpacker.ReadInt64(); err != nil {
+		return
+	}
+	x.Schedule.Interval = cos.Duration(ival)
+	if x.Schedule.AfterOps, err = unpacker.ReadInt64(); err != nil {
+		return
+	}
+	if x.Keep, err = unpacker.ReadInt32(); err != nil {
+		return
+	}
+	if x.Gen, err = unpacker.ReadInt32(); err != nil {
+		return
+	}
+	if x.Schedule.Incremental, err = unpacker.ReadBool(); err != nil {
+		return
+	}
+	if x.Props, err = unpacker.ReadString(); err != nil {
+		return
+	}
+	if x.Series, err = unpacker.ReadString(); err != nil {
+		return
+	}
+	x.Base, err = unpacker.ReadString()
 	return
 }
 
@@ -224,14 +277,18 @@ func getNBI(fqn string) (x *nbiXattr, ok bool, err error) {
 		return nil, false, err
 	}
 
+	if len(b) == 0 || (b[0] != nbiMetaVersionV1 && b[0] != nbiMetaVersion) {
+		var ver byte
+		if len(b) > 0 {
+			ver = b[0]
+		}
+		return nil, false, fmt.Errorf("unsupported NBI metadata version %d", ver)
+	}
 	x = &nbiXattr{}
 	u := cos.NewUnpacker(b)
 	if err = x.Unpack(u); err != nil {
 		return nil, false, err
 	}
-	if x.version != nbiMetaVersion {
-		return nil, false, fmt.Errorf("unsupported NBI metadata version %d", x.version)
-	}
 	return x, true, nil
 }
 
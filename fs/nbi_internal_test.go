@@ -0,0 +1,61 @@
+// Package fs: internal unit test for fs package
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package fs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestNBIXattrPackUnpack(t *testing.T) {
+	x := &nbiXattr{
+		NBIMeta: apc.NBIMeta{
+			Prefix:        "images/",
+			Props:         "name,size,cached",
+			Series:        "daily",
+			Schedule:      apc.NBISchedule{Interval: cos.Duration(time.Hour), AfterOps: 1000, Incremental: true},
+			Base:          "daily-20261017-120000",
+			Started:       time.Now().UnixNano(),
+			Finished:      time.Now().UnixNano() + 1,
+			Ntotal:        12345,
+			SmapVer:       7,
+			Flags:         apc.LsNoDirs,
+			NamesPerChunk: 20000,
+			Chunks:        3,
+			Nat:           4,
+			Keep:          2,
+			Gen:           3,
+		},
+		version: nbiMetaVersion,
+	}
+	packer := cos.NewPacker(nil, x.PackedSize())
+	x.Pack(packer)
+
+	y := &nbiXattr{}
+	tassert.CheckFatal(t, y.Unpack(cos.NewUnpacker(packer.Bytes())))
+	tassert.Fatalf(t, *x == *y, "v2 mismatch:\n%+v\n%+v", x, y)
+
+	// v1 (pre-schedule) layout remains readable
+	v1 := &nbiXattr{NBIMeta: x.NBIMeta, version: nbiMetaVersionV1}
+	p1 := cos.NewPacker(nil, 1+4*cos.SizeofI64+2*cos.SizeofI32+cos.PackedStrLen(v1.Prefix))
+	p1.WriteUint8(v1.version)
+	p1.WriteInt64(v1.Started)
+	p1.WriteInt64(v1.Finished)
+	p1.WriteInt64(v1.Ntotal)
+	p1.WriteInt64(v1.SmapVer)
+	p1.WriteInt32(v1.Chunks)
+	p1.WriteInt32(v1.Nat)
+	p1.WriteString(v1.Prefix)
+
+	z := &nbiXattr{}
+	tassert.CheckFatal(t, z.Unpack(cos.NewUnpacker(p1.Bytes())))
+	tassert.Errorf(t, z.version == nbiMetaVersionV1 && z.Ntotal == x.Ntotal && z.Prefix == x.Prefix, "v1 mismatch: %+v", z)
+	tassert.Errorf(t, z.Series == "" && z.Base == "" && !z.Schedule.IsSet() && z.Keep == 0, "v1: unexpected v2 fields: %+v", z)
+}
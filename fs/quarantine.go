@@ -0,0 +1,207 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Per-mountpath quarantine:
+// - <mpath>/.$quarantine/<id>      - quarantined content (moved, not copied)
+// - <mpath>/.$quarantine/<id>.json - sidecar record (apc.QuarantineEntry)
+
+const (
+	quarantineRoot = ".$quarantine"
+	sidecarExt     = ".json"
+)
+
+func (mi *Mountpath) QuarantineRoot() string {
+	return filepath.Join(mi.Path, quarantineRoot)
+}
+
+// Quarantine moves the file into quarantine and records the reason;
+// the `fqn` must belong to this mountpath
+func (mi *Mountpath) Quarantine(fqn, reason, xid string, size int64) (*apc.QuarantineEntry, error) {
+	var (
+		root = mi.QuarantineRoot()
+		e    = &apc.QuarantineEntry{
+			ID:     cos.GenUUID(),
+			FQN:    fqn,
+			Reason: reason,
+			XID:    xid,
+			Mpath:  mi.Path,
+			Size:   size,
+			Time:   time.Now().UnixNano(),
+		}
+		dst     = filepath.Join(root, e.ID)
+		sidecar = dst + sidecarExt
+	)
+	if err := cos.CreateDir(root); err != nil {
+		return nil, err
+	}
+	// sidecar first, so that quarantined content never goes unaccounted for
+	if err := os.WriteFile(sidecar, cos.MustMarshal(e), cos.PermRWR); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(fqn, dst); err != nil {
+		if errRm := cos.RemoveFile(sidecar); errRm != nil {
+			nlog.Errorln("failed to remove", sidecar, "[", errRm, "]")
+		}
+		return nil, err
+	}
+	return e, nil
+}
+
+// returns all quarantined entries sorted by time
+func (mi *Mountpath) ListQuarantined() ([]*apc.QuarantineEntry, error) {
+	root := mi.QuarantineRoot()
+	dentries, err := os.ReadDir(root)
+	if err != nil {
+		if cos.IsNotExist(err) {
+			err = nil
+		}
+		return nil, err
+	}
+	out := make([]*apc.QuarantineEntry, 0, len(dentries)/2)
+	for _, dent := range dentries {
+		name := dent.Name()
+		if dent.IsDir() || !strings.HasSuffix(name, sidecarExt) {
+			continue
+		}
+		sidecar := filepath.Join(root, name)
+		b, err := os.ReadFile(sidecar)
+		if err != nil {
+			return nil, err
+		}
+		e := &apc.QuarantineEntry{}
+		if err := jsoniter.Unmarshal(b, e); err != nil {
+			nlog.Errorf("%s: failed to parse quarantine record %q: %v", mi, sidecar, err)
+			continue
+		}
+		if e.ID != strings.TrimSuffix(name, sidecarExt) {
+			nlog.Errorf("%s: invalid quarantine record %q (id %q)", mi, sidecar, e.ID)
+			continue
+		}
+		e.Mpath = mi.Path
+		out = append(out, e)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Time < out[j].Time })
+	return out, nil
+}
+
+// RestoreQuarantined moves matching content back to its original location
+// (never overwriting anything that's already there)
+func (mi *Mountpath) RestoreQuarantined(msg *apc.QuarantineMsg) (n int, err error) {
+	entries, err := mi.ListQuarantined()
+	if err != nil {
+		return 0, err
+	}
+	now := time.Now().UnixNano()
+	for _, e := range entries {
+		if !msg.Match(e, now) {
+			continue
+		}
+		if errR := mi.restore(e); errR != nil {
+			err = errors.Join(err, errR)
+			continue
+		}
+		n++
+	}
+	return n, err
+}
+
+func (mi *Mountpath) restore(e *apc.QuarantineEntry) error {
+	var (
+		src     = filepath.Join(mi.QuarantineRoot(), e.ID)
+		sidecar = src + sidecarExt
+	)
+	if err := cos.Stat(e.FQN); err == nil {
+		return fmt.Errorf("cannot restore quarantined %q: %q already exists", e.ID, e.FQN)
+	}
+	if err := cos.Rename(src, e.FQN); err != nil {
+		return fmt.Errorf("failed to restore quarantined %q => %q: %w", e.ID, e.FQN, err)
+	}
+	return cos.RemoveFile(sidecar)
+}
+
+// PurgeQuarantined permanently removes matching content
+func (mi *Mountpath) PurgeQuarantined(msg *apc.QuarantineMsg) (n int, size int64, err error) {
+	entries, err := mi.ListQuarantined()
+	if err != nil {
+		return 0, 0, err
+	}
+	var (
+		root = mi.QuarantineRoot()
+		now  = time.Now().UnixNano()
+	)
+	for _, e := range entries {
+		if !msg.Match(e, now) {
+			continue
+		}
+		src := filepath.Join(root, e.ID)
+		if errRm := cos.RemoveFile(src); errRm != nil {
+			err = errors.Join(err, errRm)
+			continue
+		}
+		if errRm := cos.RemoveFile(src + sidecarExt); errRm != nil {
+			err = errors.Join(err, errRm)
+		}
+		n++
+		size += e.Size
+	}
+	return n, size, err
+}
+
+//
+// all available mountpaths
+//
+
+func ListQuarantined() ([]*apc.QuarantineEntry, error) {
+	var (
+		out   []*apc.QuarantineEntry
+		avail = GetAvail()
+	)
+	for _, mi := range avail {
+		entries, err := mi.ListQuarantined()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, entries...)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Time < out[j].Time })
+	return out, nil
+}
+
+func RestoreQuarantined(msg *apc.QuarantineMsg) (n int, err error) {
+	avail := GetAvail()
+	for _, mi := range avail {
+		cnt, errR := mi.RestoreQuarantined(msg)
+		n += cnt
+		err = errors.Join(err, errR)
+	}
+	return n, err
+}
+
+func PurgeQuarantined(msg *apc.QuarantineMsg) (n int, err error) {
+	avail := GetAvail()
+	for _, mi := range avail {
+		cnt, _, errP := mi.PurgeQuarantined(msg)
+		n += cnt
+		err = errors.Join(err, errP)
+	}
+	return n, err
+}
@@ -19,6 +19,7 @@ require (
 	github.com/gorilla/websocket v1.5.4-0.20250319132907-e064f32e3674
 	github.com/json-iterator/go v1.1.12
 	github.com/karrick/godirwalk v1.17.0
+	github.com/klauspost/compress v1.18.2
 	github.com/klauspost/reedsolomon v1.13.0
 	github.com/lestrrat-go/jwx/v2 v2.1.6
 	github.com/lufia/iostat v1.2.1
@@ -30,6 +31,7 @@ require (
 	github.com/prometheus/client_golang v1.23.2
 	github.com/seiflotfy/cuckoofilter v0.0.0-20240715131351-a2f2c23f1771
 	github.com/teris-io/shortid v0.0.0-20220617161101-71ec9f2aa569
+	github.com/tetratelabs/wazero v1.11.0
 	github.com/tidwall/buntdb v1.3.2
 	github.com/tinylib/msgp v1.6.3
 	github.com/valyala/fasthttp v1.69.0
@@ -46,6 +48,7 @@ require (
 	k8s.io/apimachinery v0.34.2
 	k8s.io/client-go v0.34.2
 	k8s.io/metrics v0.34.2
+	lukechampine.com/blake3 v1.4.1
 )
 
 require (
@@ -117,7 +120,6 @@ require (
 	github.com/googleapis/enterprise-certificate-proxy v0.3.11 // indirect
 	github.com/googleapis/gax-go/v2 v2.16.0 // indirect
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.27.4 // indirect
-	github.com/klauspost/compress v1.18.2 // indirect
 	github.com/klauspost/cpuid/v2 v2.3.0 // indirect
 	github.com/lestrrat-go/blackmagic v1.0.4 // indirect
 	github.com/lestrrat-go/httpcc v1.0.1 // indirect
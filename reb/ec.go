@@ -130,7 +130,11 @@ func (reb *Reb) sendFromDisk(ct *core.CT, md *ec.Metadata, target *meta.Snode, x
 	)
 	if lom != nil {
 		defer core.FreeLOM(lom)
-		roc, errReader = lom.NewDeferROC(true /*loaded*/) // + unlock
+		if md.RawMD != "" {
+			roc, errReader = lom.NewDeferRawROC() // ditto
+		} else {
+			roc, errReader = lom.NewDeferROC(true /*loaded*/) // + unlock
+		}
 	} else {
 		roc, errReader = cos.NewFileHandle(fqn)
 	}
@@ -145,6 +149,9 @@ func (reb *Reb) sendFromDisk(ct *core.CT, md *ec.Metadata, target *meta.Snode, x
 	o.Hdr.Bck.Copy(ct.Bck().Bucket())
 	if lom != nil {
 		o.Hdr.ObjAttrs.CopyFrom(lom.ObjAttrs(), false /*skip cksum*/)
+		if md.RawMD != "" {
+			o.Hdr.ObjAttrs.Size = lom.Psize() // at-rest content as is
+		}
 	}
 	if md.SliceID != 0 {
 		o.Hdr.ObjAttrs.Size = ec.SliceSize(md.Size, md.Data)
@@ -177,7 +184,7 @@ func (*Reb) saveCTToDisk(ntfn *stageNtfn, hdr *transport.ObjHdr, data io.Reader,
 		var lom *core.LOM
 		lom, err = ec.AllocLomFromHdr(hdr)
 		if err == nil {
-			args := &ec.WriteArgs{Reader: data, MD: md, Cksum: hdr.ObjAttrs.Cksum, Xact: xreb}
+			args := &ec.WriteArgs{Reader: data, MD: md, Cksum: hdr.ObjAttrs.Cksum, RawMD: ntfn.md.RawMD, Xact: xreb}
 			err = ec.WriteReplicaAndMeta(lom, args)
 		}
 		core.FreeLOM(lom)
@@ -925,6 +925,7 @@ func getROC(lom *core.LOM) (roc cos.ReadOpenCloser, err error) {
 		lom.Unlock(false)
 		return
 	}
+	// skip copies (dirty objects that are yet to be written back do migrate - see doSend)
 	if lom.IsCopy() {
 		lom.Unlock(false)
 		err = cmn.ErrSkip
@@ -937,6 +938,9 @@ func getROC(lom *core.LOM) (roc cos.ReadOpenCloser, err error) {
 		}
 	}
 	debug.Assert(lom.Checksum() != nil, lom.String())
+	if lom.RawMove() {
+		return lom.NewDeferRawROC() // compressed and/or encrypted: as is
+	}
 	return lom.NewDeferROC(true /*loaded*/)
 }
 
@@ -969,6 +973,14 @@ func (rargs *rargs) doSend(lom *core.LOM, tsi *meta.Snode, roc cos.ReadOpenClose
 	o.Hdr.ObjName = lom.ObjName
 	o.Hdr.Opaque = opaque
 	o.Hdr.ObjAttrs.CopyFrom(lom.ObjAttrs(), false /*skip cksum*/)
+	if lom.RawMove() {
+		o.Hdr.ObjAttrs.Size = lom.Psize()
+		o.Hdr.ObjAttrs.SetCustomKey(cmn.RawObjMD, lom.RawMD())
+	}
+	if lom.IsDirtyWB() {
+		// write-back responsibility moves along with the object (see recvObjRegular and ackLomAck)
+		o.Hdr.ObjAttrs.SetCustomKey(cmn.DirtyWBObjMD, "1")
+	}
 	o.SentCB, o.CmplArg = rargs.objSentCallback, lom
 	return m.dm.Send(o, roc, tsi)
 }
@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"maps"
 	"os"
 	"strconv"
 
@@ -174,6 +175,14 @@ func (reb *Reb) recvObjRegular(hdr *transport.ObjHdr, smap *meta.Smap, unpacker
 		nlog.Errorf("%s g[%d]: early receive from %s %s (stage %s)", core.T, reb.rebID(), meta.Tname(tsid), lom, stages[stage])
 	}
 
+	// compressed and/or encrypted content is moved as is; dirty - along with its dirty flag (compare with doSend)
+	attrs, rawMD, dirtyWB, err := rawAttrs(hdr)
+	if err != nil {
+		nlog.Errorln(err)
+		cos.DrainReader(objReader)
+		return err
+	}
+
 	latestVer, sync := _latestVer(lom.VersionConf(), xreb.Args.Flags)
 
 	//
@@ -181,7 +190,15 @@ func (reb *Reb) recvObjRegular(hdr *transport.ObjHdr, smap *meta.Smap, unpacker
 	// VA (local)  <--> VB (from tsid sender) [ <--> VC (from cloud ]
 	//
 	if lom.Load(false, false) == nil {
-		if lom.CheckEq(&hdr.ObjAttrs) == nil {
+		if dirtyWB {
+			// sender's content is yet to be written back (i.e., is newer than remote),
+			// unless the destination is dirty as well (PUT that arrived here in the meantime)
+			if lom.IsDirtyWB() {
+				goto drainOk
+			}
+			goto rx
+		}
+		if lom.CheckEq(attrs) == nil {
 			// no-op: optimize-out duplicated write
 			goto drainOk
 		}
@@ -190,7 +207,7 @@ func (reb *Reb) recvObjRegular(hdr *transport.ObjHdr, smap *meta.Smap, unpacker
 			oa, ecode, err := core.T.HeadCold(lom, nil)
 			if err == nil {
 				switch {
-				case oa.CheckEq(&hdr.ObjAttrs) == nil:
+				case oa.CheckEq(attrs) == nil:
 					goto rx // receiving latest-ver from tsid (the sender)
 				case oa.CheckEq(lom.ObjAttrs()) == nil:
 					if cmn.Rom.V(5, cos.ModReb) {
@@ -267,7 +284,7 @@ func (reb *Reb) recvObjRegular(hdr *transport.ObjHdr, smap *meta.Smap, unpacker
 	}
 
 rx:
-	lom.CopyAttrs(&hdr.ObjAttrs, true /*skip-checksum*/) // see "PUT is a no-op"
+	lom.CopyAttrs(attrs, true /*skip-checksum*/) // see "PUT is a no-op"
 
 	if xreb.IsAborted() {
 		return nil
@@ -279,8 +296,10 @@ rx:
 		params.Reader = io.NopCloser(objReader)
 		params.OWT = cmn.OwtRebalance
 		params.Cksum = hdr.ObjAttrs.Cksum
+		params.RawMD = rawMD
 		params.Atime = lom.Atime()
 		params.Xact = xreb
+		params.DirtyWB = dirtyWB
 	}
 	erp := core.T.PutObject(lom, params)
 	core.FreePutParams(params)
@@ -295,6 +314,28 @@ rx:
 	return reb.regACK(smap, hdr, tsid)
 }
 
+// see also: cmn.RawObjMD, cmn.DirtyWBObjMD
+func rawAttrs(hdr *transport.ObjHdr) (*cmn.ObjAttrs, string, bool, error) {
+	rawMD, raw := hdr.ObjAttrs.GetCustomKey(cmn.RawObjMD)
+	_, dirtyWB := hdr.ObjAttrs.GetCustomKey(cmn.DirtyWBObjMD)
+	if !raw && !dirtyWB {
+		return &hdr.ObjAttrs, "", false, nil
+	}
+	// NOTE: not modifying hdr.ObjAttrs.Size - the number of bytes to receive
+	oa := hdr.ObjAttrs
+	oa.CustomMD = maps.Clone(hdr.ObjAttrs.CustomMD)
+	delete(oa.CustomMD, cmn.RawObjMD)
+	delete(oa.CustomMD, cmn.DirtyWBObjMD)
+	if raw {
+		size, err := core.RawSize(rawMD)
+		if err != nil {
+			return nil, "", false, fmt.Errorf("%s: %w", hdr.Cname(), err)
+		}
+		oa.Size = size
+	}
+	return &oa, rawMD, dirtyWB, nil
+}
+
 func _latestVer(conf cmn.VersionConf, flags uint32) (latestVer, sync bool) {
 	switch {
 	case (flags&xact.FlagSync != 0) || conf.Sync:
@@ -135,6 +135,20 @@ func (reb *Reb) addLomAck(lom *core.LOM) {
 	lomAck.mu.Unlock()
 }
 
+// clear the dirty flag of the migrated object, so that this target won't write it back
+// (with possibly outdated content) prior to deleting it
+func wbHandoff(lom *core.LOM) {
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil || !lom.IsDirtyWB() {
+		return
+	}
+	lom.SetDirtyWB(false)
+	if err := lom.PersistMain(lom.IsChunked()); err != nil {
+		nlog.Errorln("failed to hand off write-back", lom.Cname(), "err:", err)
+	}
+}
+
 // called upon failure to send
 func (reb *Reb) cleanupLomAck(lom *core.LOM) {
 	lomAck := reb.lomAcks()[lom.CacheIdx()]
@@ -171,6 +185,11 @@ func (reb *Reb) ackLomAck(lom *core.LOM, rebID int64, xreb *xs.Rebalance) {
 	// counting acknowledged migrations (as initiator)
 	xreb.ObjsAdd(1, size)
 
+	// write-back is now the destination's responsibility (see doSend)
+	if lom.Bck().IsRemote() {
+		wbHandoff(lom)
+	}
+
 	// NOTE: rm migrated object (and local copies, if any) right away
 	// TODO [feature]: mark "deleted" instead
 	if !cmn.Rom.Features().IsSet(feat.DontDeleteWhenRebalancing) {
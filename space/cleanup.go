@@ -42,7 +42,8 @@ const (
 	flagRmMisplacedLOMs
 	flagRmMisplacedEC
 	flagRmInvalid
-	flagRmAll = flagRmOldWork | flagRmMisplacedLOMs | flagRmMisplacedEC | flagRmInvalid
+	flagRmOrphanChunks
+	flagRmAll = flagRmOldWork | flagRmMisplacedLOMs | flagRmMisplacedEC | flagRmInvalid | flagRmOrphanChunks
 )
 
 const (
@@ -53,13 +54,15 @@ type (
 	XactCln struct {
 		p   *clnFactory
 		ini *IniCln
+		rep clnReport
 		xact.Base
 	}
 	IniCln struct {
-		StatsT  stats.Tracker
-		Xaction *XactCln
-		WG      *sync.WaitGroup
-		Args    *xact.ArgsMsg
+		StatsT    stats.Tracker
+		Xaction   *XactCln
+		WG        *sync.WaitGroup
+		Args      *xact.ArgsMsg
+		PutReport func(bck *cmn.Bck, objName string, b []byte) error // (when Args.Report != nil)
 	}
 )
 
@@ -97,6 +100,7 @@ type (
 		oldWork []string // EC slices and replicas without corresponding metafiles (CT FQN -> Meta FQN)
 
 		invalid []string
+		orphans []string // orphan chunks
 		nmisplc int64
 		norphan int64
 		nvisits int64
@@ -126,7 +130,11 @@ func (r *XactCln) CtlMsg() string {
 	return s + ", " + r.ini.Args.String()
 }
 
-func (r *XactCln) Snap() *core.Snap { return r.Base.NewSnap(r) }
+func (r *XactCln) Snap() *core.Snap {
+	snap := r.Base.NewSnap(r)
+	snap.Ext = r.ClnStats()
+	return snap
+}
 
 ////////////////
 // clnFactory //
@@ -170,12 +178,14 @@ func RunCleanup(ini *IniCln) fs.CapStatus {
 	}
 
 	xcln.ini = ini
+	xcln.rep.list = ini.Args.Report != nil
 
 	now := time.Now()
 	for mpath, mi := range avail {
 		j := &clnJ{
 			oldWork: make([]string, 0, 64),
 			invalid: make([]string, 0, 64),
+			orphans: make([]string, 0, 64),
 			stopCh:  make(chan struct{}, 1),
 			mi:      mi,
 			config:  config,
@@ -213,6 +223,15 @@ func RunCleanup(ini *IniCln) fs.CapStatus {
 		j.stop()
 	}
 
+	if rargs := ini.Args.Report; rargs != nil {
+		objName := rargs.ObjName(xcln.ID(), core.T.SID())
+		if err := ini.PutReport(&rargs.Bck, objName, xcln.Report(rargs.Format)); err != nil {
+			xcln.AddErr(err)
+		} else {
+			nlog.Infoln(xcln.Name(), "report:", rargs.Bck.Cname(objName))
+		}
+	}
+
 	var err, errCap error
 	parent.cs.c, err, errCap = fs.CapRefresh(config, nil /*tcdf*/)
 	if err != nil {
@@ -292,6 +311,12 @@ func (j *clnJ) _str() string {
 	if _, ok := j.keepMisplaced(); ok {
 		sb.WriteString("--k") // keep misplaced
 	}
+	if j.dryRun() {
+		sb.WriteString("--n") // dry-run
+	}
+	if j.quarantine() {
+		sb.WriteString("--q") // quarantine
+	}
 	sb.WriteUint8(']')
 	return sb.String()
 }
@@ -302,6 +327,12 @@ func (j *clnJ) dont() time.Duration { return j.config.Space.DontCleanupTime.D()
 
 func (j *clnJ) rmZeroSize() bool { return j.ini.Args.Flags&xact.FlagZeroSize != 0 }
 
+func (j *clnJ) dryRun() bool { return j.ini.Args.Flags&xact.FlagDryRun != 0 }
+
+func (j *clnJ) quarantine() bool { return j.config.Space.Quarantine }
+
+func (j *clnJ) report(rsn int, fqn string, size int64) { j.ini.Xaction.rep.add(rsn, fqn, size) }
+
 func (j *clnJ) keepMisplaced() (string, bool) {
 	if j.ini.Args.Flags&xact.FlagKeepMisplaced != 0 {
 		return "keeping", true
@@ -312,6 +343,7 @@ func (j *clnJ) keepMisplaced() (string, bool) {
 func (j *clnJ) jog(providers []string) {
 	// globally
 	j.rmDeleted()
+	j.purgeQuarantined()
 
 	// traverse
 	if len(j.ini.Args.Buckets) != 0 {
@@ -322,6 +354,7 @@ func (j *clnJ) jog(providers []string) {
 
 	j.oldWork = slices.Clip(j.oldWork)
 	j.invalid = slices.Clip(j.invalid)
+	j.orphans = slices.Clip(j.orphans)
 	j.misplaced.loms = slices.Clip(j.misplaced.loms)
 	j.misplaced.ec = slices.Clip(j.misplaced.ec)
 
@@ -366,6 +399,10 @@ func (j *clnJ) jogBcks(bcks []cmn.Bck) {
 		if err != nil {
 			if cmn.IsErrBckNotFound(err) || cmn.IsErrRemoteBckNotFound(err) {
 				const act = "delete non-existing"
+				if j.dryRun() {
+					nlog.Infof("%s: %s %s (dry-run - skipping)", j, act, bck.String())
+					continue
+				}
 				if err = fs.DestroyBucket(act, &bck, 0 /*unknown BID*/); err == nil {
 					nlog.Infof("%s: %s %s", j, act, bck.String())
 				} else {
@@ -392,7 +429,7 @@ func (j *clnJ) _jogBck() {
 	opts := &fs.WalkOpts{
 		Mi:       j.mi,
 		Bck:      j.bck,
-		CTs:      []string{fs.WorkCT, fs.ObjCT, fs.ECSliceCT, fs.ECMetaCT, fs.ChunkCT, fs.ChunkMetaCT},
+		CTs:      []string{fs.WorkCT, fs.ObjCT, fs.ECSliceCT, fs.ECMetaCT, fs.ChunkCT, fs.ChunkMetaCT, fs.ArchIdxCT},
 		Callback: j.visit,
 		Sorted:   false,
 	}
@@ -409,7 +446,9 @@ func (j *clnJ) _jogBck() {
 
 func (j *clnJ) visit(fqn string, de fs.DirEntry) error {
 	if de.IsDir() {
-		j.rmEmptyDir(fqn)
+		if !j.dryRun() {
+			j.rmEmptyDir(fqn)
+		}
 		return nil
 	}
 	if j.done() {
@@ -554,6 +593,15 @@ func (j *clnJ) visitCT(parsed *fs.ParsedFQN, fqn string) {
 			j.rmAnyBatch(flagRmOldWork)
 		}
 
+	case fs.ArchIdxCT:
+		// remove TAR index of a non-existing object (stale index gets rebuilt upon access - see core/larch.go)
+		ct := core.NewCTFromParsed(parsed, fqn)
+		if cos.Stat(ct.Clone(fs.ObjCT).FQN()) == nil {
+			return
+		}
+		j.oldWork = append(j.oldWork, fqn)
+		j.rmAnyBatch(flagRmOldWork)
+
 	default:
 		debug.Assert(false, "Unsupported content type: ", parsed.ContentType)
 	}
@@ -587,8 +635,8 @@ func (j *clnJ) visitChunk(chunkFQN string, lom *core.LOM, uploadID string) {
 			if j.norphan%sparseLogCnt == 1 || cmn.Rom.V(5, cos.ModSpace) {
 				nlog.Warningln(j.String(), "orphan chunk", chunkFQN, "vs completed: [", completedID, lom.Cname(), j.norphan, "]")
 			}
-			j.oldWork = append(j.oldWork, chunkFQN)
-			j.rmAnyBatch(flagRmOldWork)
+			j.orphans = append(j.orphans, chunkFQN)
+			j.rmAnyBatch(flagRmOrphanChunks)
 		}
 		return
 	}
@@ -604,16 +652,17 @@ func (j *clnJ) visitChunk(chunkFQN string, lom *core.LOM, uploadID string) {
 		if j.norphan%sparseLogCnt == 1 || cmn.Rom.V(5, cos.ModSpace) {
 			nlog.Warningln(j.String(), "orphan chunk", chunkFQN, "from partial: [", fqn, lom.Cname(), j.norphan, "]")
 		}
-		j.oldWork = append(j.oldWork, chunkFQN)
-		j.rmAnyBatch(flagRmOldWork)
+		j.orphans = append(j.orphans, chunkFQN)
+		j.rmAnyBatch(flagRmOrphanChunks)
+		return
 	}
 
 	// 3. no partial and no completed: the chunk appears to be orphan and old
 	if j.norphan%sparseLogCnt == 1 || cmn.Rom.V(4, cos.ModSpace) {
 		nlog.Warningln(j.String(), "orphan chunk w/ no manifests", chunkFQN, j.norphan)
 	}
-	j.oldWork = append(j.oldWork, chunkFQN)
-	j.rmAnyBatch(flagRmOldWork)
+	j.orphans = append(j.orphans, chunkFQN)
+	j.rmAnyBatch(flagRmOrphanChunks)
 }
 
 func (j *clnJ) _getCompletedID(lom *core.LOM) (id string) {
@@ -655,20 +704,16 @@ func (j *clnJ) visitObj(fqn string, lom *core.LOM) {
 	lom.Uncache()
 	// and load
 	if errLoad := lom.Load(false /*cache it*/, false /*locked*/); errLoad != nil {
-		if cmn.IsErrLmetaCorrupted(errLoad) {
-			if err := lom.RemoveMain(); err != nil {
-				e := fmt.Errorf("%s rm MD-corrupted %s: %v (nested: %v)", j, lom, errLoad, err)
-				xcln.AddErr(e, 0)
-			} else {
-				nlog.Errorf("%s: removed MD-corrupted %s: %v", j, lom, errLoad)
+		if j.dryRun() {
+			if cmn.IsErrLmetaCorrupted(errLoad) || cmn.IsErrLmetaNotFound(errLoad) {
+				j.reportFile(rsnBadMD, fqn)
 			}
+			return
+		}
+		if cmn.IsErrLmetaCorrupted(errLoad) {
+			j.rmBadMD(lom, "MD-corrupted", errLoad)
 		} else if cmn.IsErrLmetaNotFound(errLoad) {
-			if err := lom.RemoveMain(); err != nil {
-				e := fmt.Errorf("%s rm no-MD %s: %v (nested: %v)", j, lom, errLoad, err)
-				xcln.AddErr(e, 0)
-			} else {
-				nlog.Errorf("%s: removed no-MD %s: %v", j, lom, errLoad)
-			}
+			j.rmBadMD(lom, "no-MD", errLoad)
 		}
 		return
 	}
@@ -680,12 +725,21 @@ func (j *clnJ) visitObj(fqn string, lom *core.LOM) {
 		if cmn.Rom.V(5, cos.ModSpace) {
 			nlog.Infoln("too early for", lom.String(), "atime", lom.Atime().String(), "dont-cleanup", j.dont())
 		}
+	case lom.IsDirtyWB():
+		// not yet written back to remote backend (see xs/wback.go) - keeping as is
+		if cmn.Rom.V(4, cos.ModSpace) {
+			nlog.Infoln("keeping not-synced", lom.String())
+		}
 	case lom.IsHRW():
 		// cleanup extra copies; rm zero size if requested
 		if lom.HasCopies() {
 			j.rmExtraCopies(lom)
 		}
 		if lom.Lsize() == 0 && j.rmZeroSize() {
+			if j.dryRun() {
+				j.report(rsnZeroSize, fqn, 0)
+				break
+			}
 			// remove in place
 			if err := lom.RemoveMain(); err != nil {
 				e := fmt.Errorf("%s rm zero-size %s: %v", j, lom, err)
@@ -693,6 +747,7 @@ func (j *clnJ) visitObj(fqn string, lom *core.LOM) {
 			} else {
 				nlog.Warningln(j.String(), "removed zero-size", lom.Cname())
 				j.ini.StatsT.Inc(stats.CleanupStoreCount)
+				j.report(rsnZeroSize, fqn, 0)
 			}
 		}
 	case lom.IsCopy():
@@ -729,6 +784,27 @@ func (j *clnJ) visitObj(fqn string, lom *core.LOM) {
 // removals --------------------------------------------
 //
 
+// remove or quarantine object with corrupted or missing metadata
+func (j *clnJ) rmBadMD(lom *core.LOM, tag string, errLoad error) {
+	var (
+		err  error
+		fqn  = lom.FQN
+		size = max(fsize(fqn), 0)
+	)
+	if j.quarantine() {
+		if _, err = j.rmOrQuarantine(rsnBadMD, fqn, size); err == nil {
+			nlog.Errorf("%s: quarantined %s %s: %v", j, tag, lom, errLoad)
+			return
+		}
+	} else if err = lom.RemoveMain(); err == nil {
+		nlog.Errorf("%s: removed %s %s: %v", j, tag, lom, errLoad)
+		j.report(rsnBadMD, fqn, size)
+		return
+	}
+	e := fmt.Errorf("%s rm %s %s: %v (nested: %v)", j, tag, lom, errLoad, err)
+	j.ini.Xaction.AddErr(e, 0)
+}
+
 func (j *clnJ) rmAnyBatch(specifier int) {
 	batch := j.config.Space.BatchSize
 	debug.Assert(batch >= cmn.GCBatchSizeMin)
@@ -750,6 +826,10 @@ func (j *clnJ) rmAnyBatch(specifier int) {
 		if int64(len(j.invalid)) < batch {
 			return
 		}
+	case flagRmOrphanChunks:
+		if int64(len(j.orphans)) < batch {
+			return
+		}
 	default:
 		debug.Assert(false, "invalid rm-batch specifier: ", specifier)
 		return
@@ -758,10 +838,14 @@ func (j *clnJ) rmAnyBatch(specifier int) {
 }
 
 func (j *clnJ) rmDeleted() {
-	xcln := j.ini.Xaction
-	err := j.mi.RemoveDeleted(j.String())
-	if err != nil {
-		xcln.AddErr(err)
+	var (
+		err  error
+		xcln = j.ini.Xaction
+	)
+	if !j.dryRun() {
+		if err = j.mi.RemoveDeleted(j.String()); err != nil {
+			xcln.AddErr(err)
+		}
 	}
 	if cnt := j.p.jcnt.Dec(); cnt > 0 {
 		return
@@ -800,10 +884,35 @@ func (j *clnJ) rmExtraCopies(lom *core.LOM) {
 	if lom.IsCopy() {
 		return // extremely unlikely but ok
 	}
+	// stray copies: present on disk but not in metadata (compare with lom.DelExtraCopies)
+	var (
+		extra  []string
+		copies = lom.GetCopies()
+	)
+	for _, mi := range fs.GetAvail() {
+		cfqn := mi.MakePathFQN(lom.Bucket(), fs.ObjCT, lom.ObjName)
+		if _, ok := copies[cfqn]; ok {
+			continue
+		}
+		if size := fsize(cfqn); size >= 0 {
+			extra = append(extra, cfqn)
+			if j.dryRun() {
+				j.report(rsnExtraCopy, cfqn, size)
+			}
+		}
+	}
+	if j.dryRun() || len(extra) == 0 {
+		return
+	}
 	if _, err := lom.DelExtraCopies(); err != nil {
 		e := fmt.Errorf("%s: failed delete redundant copies of %s: %v", j, lom, err)
 		xcln.AddErr(e, 5, cos.ModSpace)
 	}
+	for _, cfqn := range extra {
+		if fsize(cfqn) < 0 {
+			j.report(rsnExtraCopy, cfqn, lom.Lsize())
+		}
+	}
 }
 
 func (j *clnJ) rmEmptyDir(fqn string) {
@@ -861,21 +970,27 @@ func (j *clnJ) rmLeftovers(specifier int) {
 	var (
 		nfiles, nbytes int64
 		xcln           = j.ini.Xaction
+		dry            = j.dryRun()
 	)
-	old, ml, me, inv := len(j.oldWork), len(j.misplaced.loms), len(j.misplaced.ec), len(j.invalid)
-	nlog.Infoln(j.String(), "[ old:", old, "misplaced obj:", ml, "misplaced ec:", me, "invalid:", inv, "]")
+	old, ml, me, inv, orph := len(j.oldWork), len(j.misplaced.loms), len(j.misplaced.ec), len(j.invalid), len(j.orphans)
+	nlog.Infoln(j.String(), "[ old:", old, "misplaced obj:", ml, "misplaced ec:", me, "invalid:", inv, "orphan chunks:", orph, "]")
 
 	// 1. rm older work
 	if specifier&flagRmOldWork != 0 {
 		for _, workfqn := range j.oldWork {
 			finfo, erw := os.Lstat(workfqn)
 			if erw == nil {
+				if dry {
+					j.report(rsnOldWork, workfqn, finfo.Size())
+					continue
+				}
 				if err := cos.RemoveFile(workfqn); err != nil {
 					e := fmt.Errorf("%s: rm old %q: %v", j, workfqn, err)
 					xcln.AddErr(e)
 				} else {
 					nfiles++
 					nbytes += finfo.Size()
+					j.report(rsnOldWork, workfqn, finfo.Size())
 					j._throttle(nfiles)
 					if cmn.Rom.V(5, cos.ModSpace) {
 						nlog.Infoln(j.String(), "rm old", workfqn, "size", finfo.Size())
@@ -891,11 +1006,25 @@ func (j *clnJ) rmLeftovers(specifier int) {
 	if specifier&flagRmMisplacedLOMs != 0 {
 		if len(j.misplaced.loms) > 0 && j.p.rmMisplaced() /*note: caution*/ {
 			for _, mlom := range j.misplaced.loms {
+				if dry {
+					j.report(rsnMisplaced, mlom.FQN, mlom.Lsize(true /*not loaded*/))
+					continue
+				}
 				var (
 					err     error
 					fqn     = mlom.FQN
 					removed bool
 				)
+				if j.quarantine() {
+					if _, err = j.rmOrQuarantine(rsnMisplaced, fqn, mlom.Lsize(true /*not loaded*/)); err != nil {
+						e := fmt.Errorf("%s: quarantine misplaced %q: %v", j, mlom.String(), err)
+						xcln.AddErr(e)
+					}
+					if j.done() {
+						return
+					}
+					continue
+				}
 				lom := core.AllocLOM(mlom.ObjName)
 				switch {
 				case lom.InitCmnBck(&j.bck) != nil:
@@ -917,6 +1046,7 @@ func (j *clnJ) rmLeftovers(specifier int) {
 					nfiles++
 					size := mlom.Lsize(true /*not loaded*/)
 					nbytes += size
+					j.report(rsnMisplaced, fqn, size)
 					if cmn.Rom.V(4, cos.ModSpace) {
 						nlog.Infoln(j.String(), "rm misplaced", mlom.String(), "size", size)
 					}
@@ -939,14 +1069,25 @@ func (j *clnJ) rmLeftovers(specifier int) {
 			if cos.Stat(metaFQN) == nil {
 				continue
 			}
-			if os.Remove(ct.FQN()) == nil {
+			if dry {
+				j.report(rsnMisplacedEC, ct.FQN(), ct.Lsize())
+				continue
+			}
+			removed, err := j.rmOrQuarantine(rsnMisplacedEC, ct.FQN(), ct.Lsize())
+			switch {
+			case err != nil:
+				if j.quarantine() {
+					e := fmt.Errorf("%s: quarantine misplaced EC %q: %v", j, ct.FQN(), err)
+					xcln.AddErr(e)
+				}
+				continue
+			case removed:
 				nfiles++
 				nbytes += ct.Lsize()
-
-				j._throttle(nfiles)
-				if j.done() {
-					return
-				}
+			}
+			j._throttle(nfiles)
+			if j.done() {
+				return
 			}
 		}
 		j.misplaced.ec = j.misplaced.ec[:0]
@@ -958,10 +1099,16 @@ func (j *clnJ) rmLeftovers(specifier int) {
 		for _, fqn := range j.invalid {
 			finfo, erw := os.Lstat(fqn)
 			if erw == nil {
-				if err := cos.RemoveFile(fqn); err != nil {
+				if dry {
+					j.report(rsnInvalid, fqn, finfo.Size())
+					continue
+				}
+				removed, err := j.rmOrQuarantine(rsnInvalid, fqn, finfo.Size())
+				switch {
+				case err != nil:
 					e := fmt.Errorf("%s: rm invalid %q: %v", j, fqn, err)
 					xcln.AddErr(e)
-				} else {
+				case removed:
 					nfiles++
 					nbytes += finfo.Size()
 					if cmn.Rom.V(5, cos.ModSpace) {
@@ -975,11 +1122,95 @@ func (j *clnJ) rmLeftovers(specifier int) {
 		j.now = time.Now()
 	}
 
+	// 5. rm orphan chunks
+	if specifier&flagRmOrphanChunks != 0 {
+		for _, chunkFQN := range j.orphans {
+			size := fsize(chunkFQN)
+			if size < 0 {
+				continue
+			}
+			if dry {
+				j.report(rsnOrphanChunk, chunkFQN, size)
+				continue
+			}
+			removed, err := j.rmOrQuarantine(rsnOrphanChunk, chunkFQN, size)
+			switch {
+			case err != nil:
+				e := fmt.Errorf("%s: rm orphan chunk %q: %v", j, chunkFQN, err)
+				xcln.AddErr(e)
+			case removed:
+				nfiles++
+				nbytes += size
+				j._throttle(nfiles)
+				if j.done() {
+					return
+				}
+			}
+		}
+		j.orphans = j.orphans[:0]
+		j.now = time.Now()
+	}
+
+	if dry {
+		return // (nothing removed)
+	}
 	j.ini.StatsT.Add(stats.CleanupStoreSize, nbytes)
 	j.ini.StatsT.Add(stats.CleanupStoreCount, nfiles)
 	xcln.ObjsAdd(int(nfiles), nbytes)
 }
 
+// remove or, if configured, move to quarantine (not removed; see fs/quarantine.go)
+func (j *clnJ) rmOrQuarantine(rsn int, fqn string, size int64) (removed bool, err error) {
+	xcln := j.ini.Xaction
+	if !j.quarantine() {
+		if err = cos.RemoveFile(fqn); err == nil {
+			j.report(rsn, fqn, size)
+			removed = true
+		}
+		return removed, err
+	}
+	if _, err = j.mi.Quarantine(fqn, rsnNames[rsn], xcln.ID(), size); err == nil {
+		xcln.rep.addQ(rsn, fqn, size)
+		if cmn.Rom.V(4, cos.ModSpace) {
+			nlog.Infoln(j.String(), "quarantined", rsnNames[rsn], fqn, "size", size)
+		}
+	}
+	return false, err
+}
+
+// purge quarantined content that's older than space.quarantine_time
+func (j *clnJ) purgeQuarantined() {
+	age := j.config.Space.QuarantineTime.D()
+	if j.dryRun() || age <= 0 {
+		return
+	}
+	n, size, err := j.mi.PurgeQuarantined(&apc.QuarantineMsg{OlderThan: age})
+	if err != nil {
+		j.ini.Xaction.AddErr(err)
+	}
+	if n == 0 {
+		return
+	}
+	nlog.Infoln(j.String(), "purged", n, "quarantined item(s) older than", age, "size", cos.ToSizeIEC(size, 2))
+	j.ini.Xaction.rep.addPurged(n, size)
+	j.ini.StatsT.Add(stats.CleanupStoreSize, size)
+	j.ini.StatsT.Add(stats.CleanupStoreCount, int64(n))
+}
+
+// returns -1 if the file does not exist
+func fsize(fqn string) int64 {
+	if finfo, err := os.Lstat(fqn); err == nil {
+		return finfo.Size()
+	}
+	return -1
+}
+
+func (j *clnJ) reportFile(rsn int, fqn string) {
+	if size := fsize(fqn); size >= 0 {
+		j.report(rsn, fqn, size)
+	}
+}
+
 func (j *clnJ) _throttle(n int64) {
 	if j.adv.ShouldCheck(n) {
 		j.adv.Refresh()
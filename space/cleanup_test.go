@@ -26,6 +26,7 @@ import (
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/NVIDIA/aistore/xact/xreg"
 
+	jsoniter "github.com/json-iterator/go"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -560,6 +561,239 @@ var _ = Describe("AIStore content cleanup tests", func() {
 		Expect(invalidFQN).NotTo(BeAnExistingFile())
 	})
 
+	Describe("Dry-run", func() {
+		It("should report but not remove, and export the report", func() {
+			// misplaced object
+			lom := &core.LOM{ObjName: "dry-run-misplaced.txt"}
+			Expect(lom.InitCmnBck(&bck)).NotTo(HaveOccurred())
+			misplacedFQN := findOtherMpath(lom.Mountpath()).MakePathFQN(&bck, fs.ObjCT, lom.ObjName)
+			old := now.Add(-3 * time.Hour)
+			createTestLOM(misplacedFQN, 1024, old)
+			Expect(os.Chtimes(misplacedFQN, old, old)).NotTo(HaveOccurred())
+
+			// old workfile
+			workFQN := lom.GenFQN(fs.WorkCT, "test-work-tag")
+			workFQN = workFQN[:strings.LastIndexByte(workFQN, '.')] + ".123456789"
+			createTestFile(workFQN, 256)
+			Expect(os.Chtimes(workFQN, old, old)).NotTo(HaveOccurred())
+
+			// invalid FQN
+			invalidFQN := filepath.Join(fs.GetAvail()[mpaths[0]].MakePathCT(&bck, fs.WorkCT), "invalid..filename")
+			createTestFile(invalidFQN, 512)
+			Expect(os.Chtimes(invalidFQN, old, old)).NotTo(HaveOccurred())
+
+			var (
+				reports = make(map[string][]byte, 2)
+				rbck    = cmn.Bck{Name: "reports", Provider: apc.AIS}
+			)
+			ini.Args.Flags |= xact.FlagDryRun
+			ini.Args.Report = &xact.ReportArgs{Bck: rbck, Format: xact.ReportJSON}
+			ini.PutReport = func(bck *cmn.Bck, objName string, b []byte) error {
+				Expect(bck.Equal(&rbck)).To(BeTrue())
+				reports[objName] = b
+				return nil
+			}
+
+			space.RunCleanup(ini)
+
+			Expect(misplacedFQN).To(BeAnExistingFile())
+			Expect(workFQN).To(BeAnExistingFile())
+			Expect(invalidFQN).To(BeAnExistingFile())
+
+			st := ini.Xaction.ClnStats()
+			Expect(st.DryRun).To(BeTrue())
+			Expect(st.MisplacedCnt).To(Equal(int64(1)))
+			Expect(st.MisplacedSize).To(Equal(int64(1024)))
+			Expect(st.OldWorkCnt).To(Equal(int64(1)))
+			Expect(st.OldWorkSize).To(Equal(int64(256)))
+			Expect(st.InvalidCnt).To(Equal(int64(1)))
+			Expect(st.InvalidSize).To(Equal(int64(512)))
+			Expect(ini.Xaction.Objs()).To(BeZero())
+
+			objName := ini.Args.Report.ObjName(ini.Xaction.ID(), core.T.SID())
+			Expect(reports).To(HaveKey(objName))
+			var rep space.ClnReport
+			Expect(jsoniter.Unmarshal(reports[objName], &rep)).NotTo(HaveOccurred())
+			Expect(rep.XID).To(Equal(ini.Xaction.ID()))
+			Expect(rep.Entries).To(ConsistOf(
+				space.ClnEntry{Reason: "misplaced", FQN: misplacedFQN, Size: 1024},
+				space.ClnEntry{Reason: "old-work", FQN: workFQN, Size: 256},
+				space.ClnEntry{Reason: "invalid-fqn", FQN: invalidFQN, Size: 512},
+			))
+
+			csv := string(ini.Xaction.Report(xact.ReportCSV))
+			Expect(csv).To(HavePrefix("reason,fqn,size,quarantined\n"))
+			Expect(csv).To(ContainSubstring("old-work," + workFQN + ",256,false\n"))
+
+			// and now for real
+			xcln := &space.XactCln{}
+			xcln.InitBase(cos.GenUUID(), apc.ActStoreCleanup, nil)
+			ini.Xaction, ini.Args.Report = xcln, nil
+			ini.Args.Flags &^= xact.FlagDryRun
+			space.RunCleanup(ini)
+
+			Expect(misplacedFQN).NotTo(BeAnExistingFile())
+			Expect(workFQN).NotTo(BeAnExistingFile())
+			Expect(invalidFQN).NotTo(BeAnExistingFile())
+			st = xcln.ClnStats()
+			Expect(st.DryRun).To(BeFalse())
+			Expect(st.MisplacedCnt + st.OldWorkCnt + st.InvalidCnt).To(Equal(int64(3)))
+			Expect(xcln.Objs()).To(Equal(int64(3)))
+		})
+	})
+
+	Describe("Quarantine", func() {
+		setQuarantine := func(enabled bool, age time.Duration) {
+			config := cmn.GCO.BeginUpdate()
+			config.Space.Quarantine = enabled
+			config.Space.QuarantineTime = cos.Duration(age)
+			cmn.GCO.CommitUpdate(config)
+		}
+		BeforeEach(func() {
+			setQuarantine(true, 7*24*time.Hour)
+			DeferCleanup(func() { setQuarantine(false, 0) })
+		})
+
+		It("should quarantine, restore, purge, and auto-purge", func() {
+			var (
+				old = now.Add(-3 * time.Hour)
+				mi  = fs.GetAvail()[mpaths[0]]
+			)
+			// invalid FQN
+			invalidFQN := filepath.Join(mi.MakePathCT(&bck, fs.WorkCT), "invalid..filename")
+			createTestFile(invalidFQN, 512)
+			Expect(os.Chtimes(invalidFQN, old, old)).NotTo(HaveOccurred())
+
+			// orphan chunk
+			lom := &core.LOM{ObjName: "quarantine/orphan.bin"}
+			Expect(lom.InitCmnBck(&bck)).NotTo(HaveOccurred())
+			u, err := core.NewUfest("", lom, false /*must-exist*/)
+			Expect(err).NotTo(HaveOccurred())
+			chunk, err := u.NewChunk(1, lom)
+			Expect(err).NotTo(HaveOccurred())
+			chunkFQN := chunk.Path()
+			createTestFile(chunkFQN, 1024)
+			Expect(os.Chtimes(chunkFQN, old, old)).NotTo(HaveOccurred())
+
+			// old workfile (not subject to quarantine)
+			workFQN := lom.GenFQN(fs.WorkCT, "test-work-tag")
+			workFQN = workFQN[:strings.LastIndexByte(workFQN, '.')] + ".123456789"
+			createTestFile(workFQN, 256)
+			Expect(os.Chtimes(workFQN, old, old)).NotTo(HaveOccurred())
+
+			space.RunCleanup(ini)
+
+			Expect(invalidFQN).NotTo(BeAnExistingFile())
+			Expect(chunkFQN).NotTo(BeAnExistingFile())
+			Expect(workFQN).NotTo(BeAnExistingFile())
+
+			st := ini.Xaction.ClnStats()
+			Expect(st.QuarantinedCnt).To(Equal(int64(2)))
+			Expect(st.QuarantinedSize).To(Equal(int64(512 + 1024)))
+			Expect(st.InvalidCnt).To(Equal(int64(1)))
+			Expect(st.OrphanChunkCnt).To(Equal(int64(1)))
+			Expect(ini.Xaction.Objs()).To(Equal(int64(1))) // the workfile
+
+			entries, err := fs.ListQuarantined()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(HaveLen(2))
+			byFQN := make(map[string]*apc.QuarantineEntry, 2)
+			for _, e := range entries {
+				Expect(e.XID).To(Equal(ini.Xaction.ID()))
+				byFQN[e.FQN] = e
+			}
+			Expect(byFQN).To(HaveKey(invalidFQN))
+			Expect(byFQN).To(HaveKey(chunkFQN))
+			Expect(byFQN[invalidFQN].Reason).To(Equal("invalid-fqn"))
+			Expect(byFQN[chunkFQN].Reason).To(Equal("orphan-chunk"))
+			Expect(byFQN[chunkFQN].Size).To(Equal(int64(1024)))
+
+			// restore by ID
+			n, err := fs.RestoreQuarantined(&apc.QuarantineMsg{IDs: []string{byFQN[invalidFQN].ID}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(n).To(Equal(1))
+			Expect(invalidFQN).To(BeAnExistingFile())
+
+			// won't overwrite
+			createTestFile(chunkFQN, 1)
+			_, err = fs.RestoreQuarantined(&apc.QuarantineMsg{IDs: []string{byFQN[chunkFQN].ID}})
+			Expect(err).To(HaveOccurred())
+			Expect(os.Remove(chunkFQN)).NotTo(HaveOccurred())
+
+			// nothing's that old
+			n, err = fs.PurgeQuarantined(&apc.QuarantineMsg{OlderThan: time.Hour})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(n).To(BeZero())
+
+			// auto-purge upon expiration
+			Expect(os.Remove(invalidFQN)).NotTo(HaveOccurred())
+			setQuarantine(true, time.Nanosecond)
+			xcln := &space.XactCln{}
+			xcln.InitBase(cos.GenUUID(), apc.ActStoreCleanup, nil)
+			ini.Xaction = xcln
+			space.RunCleanup(ini)
+
+			Expect(xcln.ClnStats().QPurgedCnt).To(Equal(int64(1)))
+			entries, err = fs.ListQuarantined()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(BeEmpty())
+			Expect(chunkFQN).NotTo(BeAnExistingFile())
+		})
+
+		It("should quarantine (and restore) no-MD and misplaced objects", func() {
+			old := now.Add(-3 * time.Hour)
+
+			// no-MD: object file without metadata
+			lom := &core.LOM{ObjName: "quarantine/no-md.bin"}
+			Expect(lom.InitCmnBck(&bck)).NotTo(HaveOccurred())
+			noMDFQN := lom.FQN
+			createTestFile(noMDFQN, 512)
+			Expect(os.Chtimes(noMDFQN, old, old)).NotTo(HaveOccurred())
+
+			// misplaced
+			mlom := &core.LOM{ObjName: "quarantine/misplaced.bin"}
+			Expect(mlom.InitCmnBck(&bck)).NotTo(HaveOccurred())
+			misplacedFQN := findOtherMpath(mlom.Mountpath()).MakePathFQN(&bck, fs.ObjCT, mlom.ObjName)
+			createTestLOM(misplacedFQN, 1024, old)
+			Expect(os.Chtimes(misplacedFQN, old, old)).NotTo(HaveOccurred())
+
+			space.RunCleanup(ini)
+
+			Expect(noMDFQN).NotTo(BeAnExistingFile())
+			Expect(misplacedFQN).NotTo(BeAnExistingFile())
+
+			st := ini.Xaction.ClnStats()
+			Expect(st.QuarantinedCnt).To(Equal(int64(2)))
+			Expect(st.QuarantinedSize).To(Equal(int64(512 + 1024)))
+			Expect(ini.Xaction.Objs()).To(BeZero()) // nothing removed
+
+			entries, err := fs.ListQuarantined()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(HaveLen(2))
+			byFQN := make(map[string]*apc.QuarantineEntry, 2)
+			for _, e := range entries {
+				byFQN[e.FQN] = e
+			}
+			Expect(byFQN).To(HaveKey(noMDFQN))
+			Expect(byFQN).To(HaveKey(misplacedFQN))
+			Expect(byFQN[noMDFQN].Reason).To(Equal("bad-md"))
+			Expect(byFQN[misplacedFQN].Reason).To(Equal("misplaced"))
+
+			mi := fs.GetAvail()[byFQN[noMDFQN].Mpath]
+			Expect(filepath.Join(mi.QuarantineRoot(), byFQN[noMDFQN].ID)).To(BeAnExistingFile())
+
+			// restore
+			n, err := fs.RestoreQuarantined(&apc.QuarantineMsg{IDs: []string{byFQN[noMDFQN].ID, byFQN[misplacedFQN].ID}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(n).To(Equal(2))
+			Expect(noMDFQN).To(BeAnExistingFile())
+			Expect(misplacedFQN).To(BeAnExistingFile())
+			finfo, err := os.Stat(noMDFQN)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(finfo.Size()).To(Equal(int64(512)))
+		})
+	})
+
 	Describe("Empty directory cleanup", func() {
 		It("should remove already-empty directories during walk", func() {
 			lom := &core.LOM{ObjName: "dir1/dir2/temp-for-empty-dir.txt"}
@@ -2,7 +2,7 @@
 // least recently used cache replacement). It also serves as a built-in garbage-collection
 // mechanism for orphaned workfiles.
 /*
- * Copyright (c) 2018-2025, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2018-2026, NVIDIA CORPORATION. All rights reserved.
  */
 package space
 
@@ -34,7 +34,11 @@ import (
 // config.Space.HighWM (section "space" in the cluster config).
 //
 // When and if exceeded, AIS target will start gradually evicting objects from its
-// stable storage: oldest first access-time wise.
+// stable storage: oldest first access-time wise (default), or else in accordance with
+// the bucket's configured eviction policy (see lru_policy.go).
+//
+// Buckets with higher eviction priority (lru.priority) are drained first; buckets
+// with the same priority are visited in the order of their (decreasing) sizes.
 //
 // LRU is implemented as eXtended Action (xaction, see xact/README.md) that gets
 // triggered when/if a used local capacity exceeds high watermark (config.Space.HighWM). LRU then
@@ -70,8 +74,12 @@ type (
 
 // private
 type (
-	// minHeap keeps fileInfo sorted by access time with oldest on top of the heap.
-	minHeap []*core.LOM
+	// minHeap keeps objects sorted by eviction key (see evpolicy) with the smallest on top
+	minHeap []hentry
+	hentry  struct {
+		lom *core.LOM
+		key evkey
+	}
 
 	// parent (contains mpath joggers)
 	lruP struct {
@@ -90,6 +98,7 @@ type (
 		mi     *fs.Mountpath // the mountpath
 		config *cmn.Config   // to refresh independently
 		bck    cmn.Bck
+		policy evpolicy // the current bucket's eviction policy
 
 		// throttle
 		nvisits int64
@@ -97,7 +106,7 @@ type (
 
 		// runtime state
 		capCheck    int64
-		newest      int64
+		newest      evkey // max key in the heap
 		now         int64
 		totalSize   int64 // difference between lowWM size and used size
 		allowDelObj bool
@@ -255,24 +264,23 @@ ex:
 	nlog.Errorln(j.String()+":", "exited with err:", err)
 }
 
-func (j *lruJ) jog(providers []string) (err error) {
+func (j *lruJ) jog(providers []string) error {
 	nlog.Infoln(j.String()+":", "freeing-up", cos.IEC(j.totalSize, 2))
-	for _, provider := range providers { // for each provider (NOTE: ordering is random)
-		var (
-			bcks []cmn.Bck
-			opts = fs.WalkOpts{
-				Mi:  j.mi,
-				Bck: cmn.Bck{Provider: provider, Ns: cmn.NsGlobal},
-			}
-		)
-		if bcks, err = fs.AllMpathBcks(&opts); err != nil {
-			return
+
+	// all providers, to order buckets by eviction priority across the board
+	var all []cmn.Bck
+	for _, provider := range providers {
+		opts := fs.WalkOpts{
+			Mi:  j.mi,
+			Bck: cmn.Bck{Provider: provider, Ns: cmn.NsGlobal},
 		}
-		if err = j.jogBcks(bcks, false); err != nil {
-			return
+		bcks, err := fs.AllMpathBcks(&opts)
+		if err != nil {
+			return err
 		}
+		all = append(all, bcks...)
 	}
-	return
+	return j.jogBcks(all, false)
 }
 
 func (j *lruJ) jogBcks(bcks []cmn.Bck, force bool) error {
@@ -280,9 +288,9 @@ func (j *lruJ) jogBcks(bcks []cmn.Bck, force bool) error {
 		return nil
 	}
 	if len(bcks) > 1 {
-		j.sortBsize(bcks)
+		j.sortBcks(bcks)
 	}
-	for _, bck := range bcks { // for each bucket under a given provider
+	for _, bck := range bcks { // in the order of eviction priority and size
 		j.bck = bck
 		a, err := j.allow()
 		if err != nil {
@@ -311,6 +319,7 @@ func (j *lruJ) jogBck() error {
 	h := (*j.heap)[:0]
 	j.heap = &h
 	heap.Init(j.heap)
+	j.newest = evkey{math.MinInt64, math.MinInt64}
 
 	// 2. collect
 	opts := &fs.WalkOpts{
@@ -360,16 +369,35 @@ func (j *lruJ) _visit(lom *core.LOM, parsedFQN *fs.ParsedFQN) (pushed bool) {
 	if lom.HasCopies() && lom.IsCopy() {
 		return false
 	}
+	if lom.IsDirtyWB() {
+		return false // (not yet written back)
+	}
+	if lom.Bprops().ObjectLock.Enabled && lom.ObjAttrs().IsObjLocked(time.Unix(0, j.now)) {
+		return false // (object lock)
+	}
+
+	a := evattrs{atime: lom.AtimeUnix(), size: lom.Lsize(), hits: lom.Hits()}
+	if j.policy.needsMtime() {
+		_, _, mtime, err := lom.Fstat(false /*get-atime*/)
+		if err != nil {
+			return false
+		}
+		a.mtime = mtime.UnixNano()
+	}
+	key, ok := j.policy.key(&a, j.now)
+	if !ok {
+		return false
+	}
 
 	hlen := int64(j.heap.Len())
-	if lom.AtimeUnix() > j.newest {
-		// not adding - have a full batch already and this object is newer
+	if j.newest.less(key) {
+		// not adding - have a full batch already and this object is "newer"
 		if hlen >= j.batch() {
 			return false
 		}
-		j.newest = lom.AtimeUnix()
+		j.newest = key
 	}
-	heap.Push(j.heap, lom) // note: free(this lom) upon heap.Pop
+	heap.Push(j.heap, hentry{lom: lom, key: key}) // note: free(this lom) upon heap.Pop
 
 	// evict entire oldest batch once per window; allow multiple if overshot
 	if hlen >= j.window() {
@@ -408,7 +436,7 @@ func (j *lruJ) evict(batch int64) {
 		xlru     = j.ini.Xaction
 	)
 	for h.Len() > 0 && j.totalSize > 0 && fevicted < batch {
-		lom := heap.Pop(h).(*core.LOM)
+		lom := heap.Pop(h).(hentry).lom
 		objSize := lom.Lsize()
 		ok := j.evictObj(lom)
 		core.FreeLOM(lom)
@@ -464,6 +492,11 @@ func (j *lruJ) capCheckAndThrottle(size int64) {
 // remove local copies that "belong" to different LRU joggers (space accounting may be temporarily not precise)
 func (j *lruJ) evictObj(lom *core.LOM) bool {
 	lom.Lock(true)
+	// re-check under lock: may have been overwritten with write-back
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err == nil && lom.IsDirtyWB() {
+		lom.Unlock(true)
+		return false
+	}
 	err := lom.RemoveObj()
 	lom.Unlock(true)
 	if err != nil {
@@ -510,18 +543,28 @@ func (j *lruJ) done() bool {
 	return xlru.IsDone()
 }
 
-// sort buckets by size
-func (j *lruJ) sortBsize(bcks []cmn.Bck) {
-	sized := make([]struct {
-		b cmn.Bck
-		v uint64
-	}, len(bcks))
+// sort buckets by eviction priority and, within the same priority, by size
+func (j *lruJ) sortBcks(bcks []cmn.Bck) {
+	var (
+		bowner = core.T.Bowner()
+		sized  = make([]struct {
+			b    cmn.Bck
+			v    uint64
+			prio int
+		}, len(bcks))
+	)
 	for i := range bcks {
 		path := j.mi.MakePathCT(&bcks[i], fs.ObjCT)
 		sized[i].b = bcks[i]
 		sized[i].v, _ = ios.DirSizeOnDisk(path, false /*withNonDirPrefix*/)
+		if b := meta.CloneBck(&bcks[i]); b.Init(bowner) == nil {
+			sized[i].prio = b.Props.LRU.Priority
+		}
 	}
 	sort.Slice(sized, func(i, j int) bool {
+		if sized[i].prio != sized[j].prio {
+			return sized[i].prio > sized[j].prio
+		}
 		return sized[i].v > sized[j].v
 	})
 	for i := range bcks {
@@ -538,6 +581,7 @@ func (j *lruJ) allow() (bool, error) {
 		return false, err
 	}
 	ok := b.Props.LRU.Enabled && b.Allow(apc.AceObjDELETE) == nil
+	j.policy = newEvpolicy(&b.Props.LRU)
 	return ok, nil
 }
 
@@ -546,9 +590,9 @@ func (j *lruJ) allow() (bool, error) {
 //////////////
 
 func (h minHeap) Len() int           { return len(h) }
-func (h minHeap) Less(i, j int) bool { return h[i].Atime().Before(h[j].Atime()) }
+func (h minHeap) Less(i, j int) bool { return h[i].key.less(h[j].key) }
 func (h minHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
-func (h *minHeap) Push(x any)        { *h = append(*h, x.(*core.LOM)) }
+func (h *minHeap) Push(x any)        { *h = append(*h, x.(hentry)) }
 func (h *minHeap) Pop() any {
 	old := *h
 	n := len(old)
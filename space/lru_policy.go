@@ -0,0 +1,87 @@
+// Package space provides storage cleanup and eviction functionality (the latter based on the
+// least recently used cache replacement). It also serves as a built-in garbage-collection
+// mechanism for orphaned workfiles.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package space
+
+import (
+	"math"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Eviction policies (see apc.EvictPolicy and cmn.LRUConf):
+// each policy maps a given object to a key (`evkey`), and the min-heap then evicts
+// the object with the smallest key first.
+//
+// - lru: oldest access time first
+// - lfu: fewest accesses (warm GETs) first; ties resolved by access time
+// - gds: GreedyDual-Size, whereby the access time gets "inflated" by a credit that
+//        grows with the number of accesses and shrinks with the object size;
+//        the result: large and cold objects go first, small and hot ones - last
+// - ttl: only objects not (re)written for lru.ttl or longer are eligible,
+//        the oldest (by last PUT or cold GET) first
+
+// tunables (GreedyDual-Size)
+const (
+	gdsCredit  = time.Hour   // credit per access for a 1MiB object
+	gdsMinSize = 4 * cos.KiB // objects smaller than that are credited as 4KiB
+	gdsMaxHits = 1000        // cap the credit
+)
+
+type (
+	// eviction key: lexicographic (k1, k2)
+	evkey struct {
+		k1, k2 int64
+	}
+	// per-object attributes the policies are based upon
+	evattrs struct {
+		atime int64
+		mtime int64 // ttl only
+		size  int64
+		hits  uint64
+	}
+	evpolicy struct {
+		policy apc.EvictPolicy
+		ttl    int64
+	}
+)
+
+func newEvpolicy(conf *cmn.LRUConf) evpolicy {
+	return evpolicy{policy: conf.Policy, ttl: int64(conf.TTL)}
+}
+
+func (p evpolicy) needsMtime() bool { return p.policy == apc.EvictTTL }
+
+// returns false when the object is not eligible for eviction
+func (p evpolicy) key(a *evattrs, now int64) (evkey, bool) {
+	switch p.policy {
+	case apc.EvictLFU:
+		return evkey{int64(min(a.hits, math.MaxInt64)), a.atime}, true
+	case apc.EvictGDS:
+		var (
+			size   = float64(max(a.size, gdsMinSize)) / float64(cos.MiB)
+			credit = float64(gdsCredit) * float64(min(a.hits+1, gdsMaxHits)) / size
+		)
+		return evkey{a.atime + int64(credit), a.atime}, true
+	case apc.EvictTTL:
+		if now-a.mtime < p.ttl {
+			return evkey{}, false
+		}
+		return evkey{a.mtime, a.atime}, true
+	default:
+		return evkey{a.atime, 0}, true
+	}
+}
+
+func (k evkey) less(other evkey) bool {
+	if k.k1 != other.k1 {
+		return k.k1 < other.k1
+	}
+	return k.k2 < other.k2
+}
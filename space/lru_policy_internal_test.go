@@ -0,0 +1,92 @@
+// Package space provides storage cleanup and eviction functionality (the latter based on the
+// least recently used cache replacement). It also serves as a built-in garbage-collection
+// mechanism for orphaned workfiles.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package space
+
+import (
+	"container/heap"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestEvictPolicyOrder(t *testing.T) {
+	var (
+		now  = time.Now().UnixNano()
+		hour = int64(time.Hour)
+	)
+	tests := []struct {
+		conf  cmn.LRUConf
+		attrs []evattrs // in the expected eviction order
+	}{
+		{
+			conf: cmn.LRUConf{},
+			attrs: []evattrs{
+				{atime: now - 3*hour, hits: 100},
+				{atime: now - 2*hour},
+				{atime: now - hour},
+			},
+		},
+		{
+			conf: cmn.LRUConf{Policy: apc.EvictLFU},
+			attrs: []evattrs{
+				{atime: now - 2*hour},
+				{atime: now - hour},
+				{atime: now - 3*hour, hits: 1},
+				{atime: now - 4*hour, hits: 100},
+			},
+		},
+		{
+			conf: cmn.LRUConf{Policy: apc.EvictGDS},
+			attrs: []evattrs{
+				{atime: now - hour, size: cos.GiB},
+				{atime: now - hour, size: cos.MiB},
+				{atime: now - 2*hour, size: cos.MiB, hits: 10},
+				{atime: now - hour, size: cos.KiB},
+			},
+		},
+		{
+			conf: cmn.LRUConf{Policy: apc.EvictTTL, TTL: cos.Duration(time.Hour)},
+			attrs: []evattrs{
+				{atime: now, mtime: now - 3*hour},
+				{atime: now - 3*hour, mtime: now - 2*hour},
+			},
+		},
+	}
+	for _, test := range tests {
+		var (
+			p = newEvpolicy(&test.conf)
+			h = make(minHeap, 0, len(test.attrs))
+		)
+		// push in reverse
+		for i := len(test.attrs) - 1; i >= 0; i-- {
+			key, ok := p.key(&test.attrs[i], now)
+			tassert.Fatalf(t, ok, "%q: expecting %+v to be eligible", p.policy, test.attrs[i])
+			heap.Push(&h, hentry{lom: &core.LOM{ObjName: string(rune('a' + i))}, key: key})
+		}
+		for i := range test.attrs {
+			e := heap.Pop(&h).(hentry)
+			tassert.Errorf(t, e.lom.ObjName == string(rune('a'+i)), "%q: expected %d-th to be evicted, got %q",
+				p.policy, i, e.lom.ObjName)
+		}
+	}
+}
+
+func TestEvictPolicyTTL(t *testing.T) {
+	var (
+		now = time.Now().UnixNano()
+		p   = newEvpolicy(&cmn.LRUConf{Policy: apc.EvictTTL, TTL: cos.Duration(time.Hour)})
+	)
+	_, ok := p.key(&evattrs{atime: now - 2*int64(time.Hour), mtime: now - int64(time.Minute)}, now)
+	tassert.Errorf(t, !ok, "expecting recently written object to not be eligible for eviction")
+	_, ok = p.key(&evattrs{atime: now, mtime: now - 2*int64(time.Hour)}, now)
+	tassert.Errorf(t, ok, "expecting expired object to be eligible for eviction")
+}
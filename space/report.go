@@ -0,0 +1,189 @@
+// Package space provides storage cleanup and eviction functionality (the latter based on the
+// least recently used cache replacement). It also serves as a built-in garbage-collection
+// mechanism for orphaned workfiles.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package space
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strconv"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+// space-cleanup report: removed (or, when dry-run, to-be-removed) content by reason
+
+// reasons
+const (
+	rsnOldWork = iota
+	rsnMisplaced
+	rsnMisplacedEC
+	rsnInvalid
+	rsnZeroSize
+	rsnBadMD
+	rsnExtraCopy
+	rsnOrphanChunk
+	numRsn
+)
+
+var rsnNames = [numRsn]string{
+	rsnOldWork:     "old-work",
+	rsnMisplaced:   "misplaced",
+	rsnMisplacedEC: "misplaced-ec",
+	rsnInvalid:     "invalid-fqn",
+	rsnZeroSize:    "zero-size",
+	rsnBadMD:       "bad-md",
+	rsnExtraCopy:   "extra-copy",
+	rsnOrphanChunk: "orphan-chunk",
+}
+
+// max number of listed entries per target (beyond that, counting only)
+const maxReportEntries = 1 << 20
+
+type (
+	// XactCln snap.Ext (note ".n" and ".size" suffixes - CLI formatting)
+	ExtClnStats struct {
+		DryRun          bool  `json:"dry-run"`
+		OldWorkCnt      int64 `json:"old-work.n,string"`
+		OldWorkSize     int64 `json:"old-work.size,string"`
+		MisplacedCnt    int64 `json:"misplaced.n,string"`
+		MisplacedSize   int64 `json:"misplaced.size,string"`
+		MisplacedECCnt  int64 `json:"misplaced-ec.n,string"`
+		MisplacedECSize int64 `json:"misplaced-ec.size,string"`
+		InvalidCnt      int64 `json:"invalid-fqn.n,string"`
+		InvalidSize     int64 `json:"invalid-fqn.size,string"`
+		ZeroSizeCnt     int64 `json:"zero-size.n,string"`
+		BadMDCnt        int64 `json:"bad-md.n,string"`
+		BadMDSize       int64 `json:"bad-md.size,string"`
+		ExtraCopyCnt    int64 `json:"extra-copy.n,string"`
+		ExtraCopySize   int64 `json:"extra-copy.size,string"`
+		OrphanChunkCnt  int64 `json:"orphan-chunk.n,string"`
+		OrphanChunkSize int64 `json:"orphan-chunk.size,string"`
+		// (of the above) moved to quarantine rather than removed
+		QuarantinedCnt  int64 `json:"quarantined.n,string"`
+		QuarantinedSize int64 `json:"quarantined.size,string"`
+		// quarantined earlier and purged upon expiration (space.quarantine_time)
+		QPurgedCnt  int64 `json:"quarantine-purged.n,string"`
+		QPurgedSize int64 `json:"quarantine-purged.size,string"`
+	}
+	ClnEntry struct {
+		Reason      string `json:"reason"`
+		FQN         string `json:"fqn"`
+		Size        int64  `json:"size,string"`
+		Quarantined bool   `json:"quarantined,omitempty"`
+	}
+	// (exported as xact.ReportJSON)
+	ClnReport struct {
+		Stats     *ExtClnStats `json:"stats"`
+		TID       string       `json:"tid"`
+		XID       string       `json:"xid"`
+		Entries   []ClnEntry   `json:"entries"`
+		Truncated bool         `json:"truncated,omitempty"` // exceeded maxReportEntries
+	}
+)
+
+type clnReport struct {
+	entries   []ClnEntry
+	cnt       [numRsn]struct{ n, size atomic.Int64 }
+	qcnt      struct{ n, size atomic.Int64 } // quarantined
+	pcnt      struct{ n, size atomic.Int64 } // purged from quarantine
+	mu        sync.Mutex
+	list      bool // (whether to collect entries)
+	truncated bool
+}
+
+func (r *clnReport) add(rsn int, fqn string, size int64) { r._add(rsn, fqn, size, false) }
+
+func (r *clnReport) addQ(rsn int, fqn string, size int64) {
+	r.qcnt.n.Inc()
+	r.qcnt.size.Add(size)
+	r._add(rsn, fqn, size, true)
+}
+
+func (r *clnReport) addPurged(n int, size int64) {
+	r.pcnt.n.Add(int64(n))
+	r.pcnt.size.Add(size)
+}
+
+func (r *clnReport) _add(rsn int, fqn string, size int64, quarantined bool) {
+	r.cnt[rsn].n.Inc()
+	r.cnt[rsn].size.Add(size)
+	if !r.list {
+		return
+	}
+	r.mu.Lock()
+	if len(r.entries) < maxReportEntries {
+		r.entries = append(r.entries, ClnEntry{Reason: rsnNames[rsn], FQN: fqn, Size: size, Quarantined: quarantined})
+	} else {
+		r.truncated = true
+	}
+	r.mu.Unlock()
+}
+
+func (r *clnReport) stats(dryRun bool) *ExtClnStats {
+	c := &r.cnt
+	return &ExtClnStats{
+		DryRun:          dryRun,
+		OldWorkCnt:      c[rsnOldWork].n.Load(),
+		OldWorkSize:     c[rsnOldWork].size.Load(),
+		MisplacedCnt:    c[rsnMisplaced].n.Load(),
+		MisplacedSize:   c[rsnMisplaced].size.Load(),
+		MisplacedECCnt:  c[rsnMisplacedEC].n.Load(),
+		MisplacedECSize: c[rsnMisplacedEC].size.Load(),
+		InvalidCnt:      c[rsnInvalid].n.Load(),
+		InvalidSize:     c[rsnInvalid].size.Load(),
+		ZeroSizeCnt:     c[rsnZeroSize].n.Load(),
+		BadMDCnt:        c[rsnBadMD].n.Load(),
+		BadMDSize:       c[rsnBadMD].size.Load(),
+		ExtraCopyCnt:    c[rsnExtraCopy].n.Load(),
+		ExtraCopySize:   c[rsnExtraCopy].size.Load(),
+		OrphanChunkCnt:  c[rsnOrphanChunk].n.Load(),
+		OrphanChunkSize: c[rsnOrphanChunk].size.Load(),
+		QuarantinedCnt:  r.qcnt.n.Load(),
+		QuarantinedSize: r.qcnt.size.Load(),
+		QPurgedCnt:      r.pcnt.n.Load(),
+		QPurgedSize:     r.pcnt.size.Load(),
+	}
+}
+
+/////////////
+// XactCln //
+/////////////
+
+func (r *XactCln) dryRun() bool { return r.ini != nil && r.ini.Args.Flags&xact.FlagDryRun != 0 }
+
+func (r *XactCln) ClnStats() *ExtClnStats { return r.rep.stats(r.dryRun()) }
+
+// Report returns the list of removed (or, when dry-run, to-be-removed) content
+// formatted as per xact.ReportArgs (to be called upon finishing)
+func (r *XactCln) Report(format string) []byte {
+	r.rep.mu.Lock()
+	defer r.rep.mu.Unlock()
+	if format == xact.ReportCSV {
+		var (
+			bb bytes.Buffer
+			w  = csv.NewWriter(&bb)
+		)
+		w.Write([]string{"reason", "fqn", "size", "quarantined"})
+		for _, e := range r.rep.entries {
+			w.Write([]string{e.Reason, e.FQN, strconv.FormatInt(e.Size, 10), strconv.FormatBool(e.Quarantined)})
+		}
+		w.Flush()
+		return bb.Bytes()
+	}
+	rep := &ClnReport{
+		Stats:     r.rep.stats(r.dryRun()),
+		TID:       core.T.SID(),
+		XID:       r.ID(),
+		Entries:   r.rep.entries,
+		Truncated: r.rep.truncated,
+	}
+	return cos.MustMarshal(rep)
+}
@@ -36,6 +36,12 @@ const (
 	AuthJWKSHist = "auth.jwks"
 )
 
+// Bucket quota metrics (see cmn.QuotaConf)
+const (
+	QuotaSoftCount = "quota.soft.n"
+	ErrQuotaCount  = errPrefix + "quota.n"
+)
+
 type Prunner struct {
 	runner
 }
@@ -60,6 +66,7 @@ func (r *Prunner) Init(p core.Node) *atomic.Bool {
 
 	r.regCommon(p.Snode()) // common metrics
 	r.regAuth(p.Snode())
+	r.regQuota(p.Snode())
 
 	r.core.statsTime = cmn.GCO.Get().Periodic.StatsTime.D()
 	r.ctracker = make(copyTracker, numProxyStats)
@@ -73,6 +80,21 @@ func (r *Prunner) Init(p core.Node) *atomic.Bool {
 	return &r.runner.startedUp
 }
 
+func (r *Prunner) regQuota(snode *meta.Snode) {
+	r.reg(snode, QuotaSoftCount, KindCounter,
+		&Extra{
+			Help:    "number of times bucket usage crossed its soft quota",
+			VarLabs: BckVlabs,
+		},
+	)
+	r.reg(snode, ErrQuotaCount, KindCounter,
+		&Extra{
+			Help:    "number of write requests rejected due to bucket's hard quota",
+			VarLabs: BckVlabs,
+		},
+	)
+}
+
 func (r *Prunner) regAuth(snode *meta.Snode) {
 	r.reg(snode, AuthTotalCount, KindCounter,
 		&Extra{
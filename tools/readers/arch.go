@@ -32,7 +32,7 @@ const (
 )
 
 type Arch struct {
-	Mime    string // archive.ExtTar|ExtTgz|ExtTarGz|ExtZip|ExtTarLz4
+	Mime    string // archive.ExtTar|ExtTgz|ExtTarGz|ExtZip|ExtTarLz4|ExtTarZst
 	Prefix  string // optional prefix inside archive (e.g., "trunk-", "a/b/c/trunk-")
 	MinSize int64  // min file size
 	MaxSize int64  // max file size
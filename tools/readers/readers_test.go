@@ -378,6 +378,16 @@ func TestArchReader(t *testing.T) {
 				Seed:    111,
 			},
 		},
+		{
+			name: "tar_zst",
+			arch: &readers.Arch{
+				Mime:    archive.ExtTarZst,
+				Num:     4,
+				MinSize: cos.KiB,
+				MaxSize: 4 * cos.KiB,
+				Seed:    112,
+			},
+		},
 		{
 			name: "random_names",
 			arch: &readers.Arch{
@@ -88,6 +88,11 @@ var Table = map[string]Descriptor{
 	apc.ActECRespond: {Scope: ScopeB, Startable: false, Idles: true},
 	apc.ActPutCopies: {Scope: ScopeB, Startable: false, RefreshCap: true, Idles: true},
 
+	// write-back (non-startable, triggered by PUT => remote bucket with write_policy.data = "delayed")
+	// and its (startable) flush
+	apc.ActWriteBack: {Scope: ScopeB, Startable: false, Idles: true},
+	apc.ActFlushWB:   {Scope: ScopeB, Access: apc.AccessRW, Startable: true},
+
 	//
 	// on-demand multi-object (consider setting ConflictRebRes = true)
 	//
@@ -155,6 +160,13 @@ var Table = map[string]Descriptor{
 		Startable:   false,
 		RefreshCap:  true,
 	},
+	apc.ActLifecycle: {
+		DisplayName: "lifecycle",
+		Scope:       ScopeB,
+		Access:      apc.AceObjDELETE,
+		Startable:   true,
+		RefreshCap:  true,
+	},
 	apc.ActPrefetchObjects: {
 		DisplayName: "prefetch-objects",
 		Scope:       ScopeB,
@@ -5,6 +5,7 @@
 package xact
 
 import (
+	"fmt"
 	"strconv"
 	"time"
 
@@ -39,6 +40,13 @@ const (
 	FlagLatestVer
 	FlagSync
 	FlagKeepMisplaced // usage: x-cleanup to _not_ remove (ie, keep) misplaced objects
+	FlagDryRun        // usage: x-cleanup to report (but not remove) what would otherwise be removed
+)
+
+// ReportArgs.Format
+const (
+	ReportJSON = "json"
+	ReportCSV  = "csv"
 )
 
 type (
@@ -54,9 +62,33 @@ type (
 		Flags       uint32        `json:"flags,omitempty"` // enum (FlagZeroSize, ...) bitwise
 		Force       bool          // force
 		OnlyRunning bool          // only for running xactions
+		Report      *ReportArgs   `json:"report,omitempty"` // x-cleanup: store per-target report in a bucket
+	}
+	// usage: x-cleanup (apc.ActStoreCleanup) to export the list of removed (or, with FlagDryRun,
+	// to-be-removed) content as one object per target: <ReportPrefix>/<xaction ID>/<target ID>.<format>
+	ReportArgs struct {
+		Bck    cmn.Bck `json:"bck"`
+		Format string  `json:"format,omitempty"` // ReportJSON (default) or ReportCSV
 	}
 )
 
+const ReportPrefix = "space-cleanup"
+
+func (ra *ReportArgs) Validate() error {
+	switch ra.Format {
+	case "":
+		ra.Format = ReportJSON
+	case ReportJSON, ReportCSV:
+	default:
+		return fmt.Errorf("invalid report format %q (expecting %q or %q)", ra.Format, ReportJSON, ReportCSV)
+	}
+	return ra.Bck.Validate()
+}
+
+func (ra *ReportArgs) ObjName(xid, tid string) string {
+	return ReportPrefix + "/" + xid + "/" + tid + "." + ra.Format
+}
+
 func (args *ArgsMsg) String() string {
 	var sb cos.SB
 	sb.Init(128)
@@ -81,6 +81,10 @@ func RenewBckLoadLomCache(uuid string, bck *meta.Bck) RenewRes {
 	return RenewBucketXact(apc.ActLoadLomCache, bck, Args{UUID: uuid})
 }
 
+func RenewLifecycle(bck *meta.Bck, uuid string, custom *LcyArgs) RenewRes {
+	return RenewBucketXact(apc.ActLifecycle, bck, Args{Custom: custom, UUID: uuid})
+}
+
 func RenewBckRechunks(bck *meta.Bck, uuid string, msg *apc.RechunkMsg) RenewRes {
 	return RenewBucketXact(apc.ActRechunk, bck, Args{Custom: msg, UUID: uuid})
 }
@@ -89,6 +93,14 @@ func RenewPutMirror(lom *core.LOM) RenewRes {
 	return RenewBucketXact(apc.ActPutCopies, lom.Bck(), Args{Custom: lom})
 }
 
+func RenewWriteBack(bck *meta.Bck) RenewRes {
+	return RenewBucketXact(apc.ActWriteBack, bck, Args{})
+}
+
+func RenewFlushWB(uuid string, bck *meta.Bck) RenewRes {
+	return RenewBucketXact(apc.ActFlushWB, bck, Args{UUID: uuid})
+}
+
 func RenewTCB(uuid, kind string, custom *TCBArgs) RenewRes {
 	return RenewBucketXact(
 		kind,
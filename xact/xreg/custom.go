@@ -6,6 +6,7 @@ package xreg
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -49,6 +50,11 @@ type (
 		Config *cmn.Config
 		Smap   *meta.Smap
 	}
+	LcyArgs struct {
+		// (target-provided) abort incomplete multipart uploads per bucket lifecycle rules;
+		// returns the number of aborted uploads
+		AbortMpt func(bck *meta.Bck, now time.Time) int
+	}
 	RebArgs struct {
 		Bck    *meta.Bck // (limited-scope)
 		Prefix string    // (ditto)
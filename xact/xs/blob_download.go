@@ -738,10 +738,16 @@ func (w *worker) do(tsk *chunkTask, buf []byte) (int, error) {
 
 	// Create chunk file
 	chunkPath := chunk.Path()
-	chunkFh, chunkFhErr := lom.CreatePart(chunkPath)
+	fh, chunkFhErr := lom.CreatePart(chunkPath)
 	if chunkFhErr != nil {
 		return 0, chunkFhErr
 	}
+	chunkFh, errZ := lom.NewChunkZWriter(chunk, fh, chunkSize) // (compression and/or encryption iff configured)
+	if errZ != nil {
+		cos.Close(fh)
+		cos.Close(res.R)
+		return 0, errZ
+	}
 
 	// Setup writers: chunk file + SGL (for RespWriter if needed)
 	writers := make([]io.Writer, 0, 3)
@@ -758,7 +764,9 @@ func (w *worker) do(tsk *chunkTask, buf []byte) (int, error) {
 
 	chwritten, cksum, copyErr := cos.CopyAndChecksum(multiWriter, res.R, buf, lom.CksumConf().Type)
 	cos.Close(res.R)
-	cos.Close(chunkFh)
+	if errC := chunkFh.Close(); copyErr == nil {
+		copyErr = errC
+	}
 	if copyErr != nil {
 		if nerr := cos.RemoveFile(chunkPath); nerr != nil {
 			nlog.Errorln("nested error removing chunk:", nerr)
@@ -36,7 +36,7 @@ import (
 // - designated-target + filterAddLmeta()
 // - stats: internal (-> CtlMsg) and Prometheus
 // - single backend.ListObjects(0 in the cluster; filterKeepMine
-// - multiple inventories: a) periodic re-sync, b) cleanup/GC old
+// - incremental: persistent change log (to survive restarts)
 // - disallow user PUT or copy => system buckets (not even admin)
 // ==============================================================
 
@@ -63,6 +63,7 @@ type (
 	}
 	XactNBI struct {
 		msg    *apc.CreateNBIMsg
+		base   *apc.NBIInfo // incremental only
 		lom    *core.LOM
 		ufest  *core.Ufest
 		slab   *memsys.Slab
@@ -174,6 +175,9 @@ func (r *XactNBI) CtlMsg() string {
 	sb := &cos.SB{}
 	sb.Init(80)
 	r.msg.Str(r.Bck().Cname(""), sb)
+	if r.msg.Incremental {
+		sb.WriteString(", incremental")
+	}
 	r.ctlmsg = sb.String()
 	return r.ctlmsg
 }
@@ -195,9 +199,39 @@ func (r *XactNBI) Run(wg *sync.WaitGroup) {
 	}
 	lsmsg.ContinuationToken = ""
 
+	// start recording changes anew (note: changes that happen while listing may or may not be
+	// included - they'll be re-applied by the next incremental);
+	// the previous log is what's needed to merge into the base
+	var (
+		ntotal int64
+		err    error
+		smap   = core.T.Sowner().Get()
+		clog   = nbiSwapClog(bck, r.StartTime().UnixNano())
+	)
+	if base := r.incrBase(clog, smap); base != nil {
+		ntotal, err = r.merge(base, clog)
+	} else {
+		ntotal, err = r.list(smap)
+	}
+	if err != nil {
+		r.Abort(err)
+		return
+	}
+
+	if err := r.fini(smap, ntotal); err != nil {
+		r.Abort(err)
+		return
+	}
+	if r.msg.Retain() {
+		r.gc()
+	}
+}
+
+// full listing
+func (r *XactNBI) list(smap *meta.Smap) (ntotal int64, _ error) {
 	var (
-		ntotal    int64
-		smap      = core.T.Sowner().Get()
+		bck       = r.Bck()
+		lsmsg     = &r.msg.LsoMsg
 		bp        = core.T.Backend(bck)
 		ubuf      = bck.MakeUname("", true)
 		lastToken = "__dummy__"
@@ -218,12 +252,10 @@ func (r *XactNBI) Run(wg *sync.WaitGroup) {
 			lst.Entries = dst
 
 			if _, err := bp.ListObjects(bck, lsmsg, lst); err != nil {
-				r.Abort(err)
-				return
+				return 0, err
 			}
 			if err := r.filterKeepMine(lst, ubuf, smap); err != nil {
-				r.Abort(err)
-				return
+				return 0, err
 			}
 
 			// make an exception for apc.LsNoRecursion;
@@ -270,8 +302,7 @@ func (r *XactNBI) Run(wg *sync.WaitGroup) {
 		r.ObjsAdd(idx, 0) // like x-lso: count names, zero bytes
 
 		if err := r.writeChunk(num, all); err != nil {
-			r.Abort(err)
-			return
+			return 0, err
 		}
 		if cmn.Rom.V(5, cos.ModXs) {
 			if idx == 1 {
@@ -281,10 +312,7 @@ func (r *XactNBI) Run(wg *sync.WaitGroup) {
 			}
 		}
 	}
-
-	if err := r.fini(smap, ntotal); err != nil {
-		r.Abort(err)
-	}
+	return ntotal, nil
 }
 
 func (r *XactNBI) fini(smap *meta.Smap, ntotal int64) error {
@@ -297,7 +325,7 @@ func (r *XactNBI) fini(smap *meta.Smap, ntotal int64) error {
 
 	errLoad := lom.Load(false, true)
 	if errLoad == nil {
-		// unlikely (and currently impossible given single-inventory-per-bucket)
+		// unlikely (inventory names are unique per bucket)
 		return fmt.Errorf("%s: inventory %q already exists (%s)", r.Name(), r.msg.Name, lom.Cname())
 	}
 	if !cos.IsNotExist(errLoad) {
@@ -330,13 +358,23 @@ func (r *XactNBI) fini(smap *meta.Smap, ntotal int64) error {
 
 	// write NBI's own meta
 	meta := &apc.NBIMeta{
-		Prefix:   r.msg.Prefix,
-		Started:  r.StartTime().UnixNano(),
-		Finished: now.UnixNano(),
-		Ntotal:   ntotal,
-		SmapVer:  smap.Version,
-		Chunks:   int32(r.ufest.Count()),
-		Nat:      int32(smap.CountActiveTs()),
+		Prefix:        r.msg.Prefix,
+		Props:         r.msg.Props,
+		Series:        r.msg.Series,
+		Schedule:      r.msg.Schedule,
+		Started:       r.StartTime().UnixNano(),
+		Finished:      now.UnixNano(),
+		Ntotal:        ntotal,
+		SmapVer:       smap.Version,
+		Flags:         r.msg.Flags,
+		NamesPerChunk: r.msg.NamesPerChunk,
+		Chunks:        int32(r.ufest.Count()),
+		Nat:           int32(smap.CountActiveTs()),
+		Keep:          int32(r.msg.Keep),
+	}
+	if r.base != nil {
+		meta.Base = r.base.Name
+		meta.Gen = r.base.Gen + 1
 	}
 	if err := fs.SetNBI(lom.FQN, meta, r.buf); err != nil {
 		nlog.Errorf("%s: ex-post-facto failure to store metadata: [%q, %q, %v]", r.Name(), r.msg.Name, lom.Cname(), err)
@@ -354,6 +392,48 @@ func (r *XactNBI) fini(smap *meta.Smap, ntotal int64) error {
 	return nil
 }
 
+// retention: remove older inventories of the same series beyond msg.Keep
+// (best-effort; inventories that are currently being listed are skipped)
+func (r *XactNBI) gc() {
+	infos, err := fs.CollectNBI(r.Bck().Bucket())
+	if err != nil {
+		nlog.Warningln(r.Name(), "gc:", err)
+		return
+	}
+	var (
+		n      int
+		series = make(apc.NBIInfoMap, len(infos))
+	)
+	for k, info := range infos {
+		if info.Series == r.msg.Series {
+			series[k] = info
+		}
+	}
+	for _, info := range series.Sorted() {
+		if n++; n <= r.msg.KeepN() || info.Name == r.msg.Name {
+			continue
+		}
+		lom := &core.LOM{ObjName: info.ObjName}
+		if err := lom.InitBck(meta.SysBckNBI()); err != nil {
+			nlog.Warningln(r.Name(), "gc:", err)
+			continue
+		}
+		if !lom.TryLock(true) {
+			nlog.Infoln(r.Name(), "gc: skipping busy", info.Name)
+			continue
+		}
+		if err := lom.Load(false, true); err == nil {
+			err = lom.RemoveObj()
+		}
+		lom.Unlock(true)
+		if err != nil && !cos.IsNotExist(err) {
+			nlog.Warningln(r.Name(), "gc: failed to remove", info.Name, "err:", err)
+			continue
+		}
+		nlog.Infoln(r.Name(), "gc: removed", info.Name)
+	}
+}
+
 // TODO: ref
 func (*XactNBI) filterKeepMine(lst *cmn.LsoRes, ubuf []byte, smap *meta.Smap) error {
 	j := 0
@@ -58,6 +58,10 @@ func Tinit(coi COI) {
 	xreg.RegBckXact(&blobFactory{})
 
 	xreg.RegBckXact(&rechunkFactory{kind: apc.ActRechunk})
+	xreg.RegBckXact(&lcyFactory{})
+
+	xreg.RegBckXact(&wbFactory{})
+	xreg.RegBckXact(&wbfFactory{})
 
 	// assign COI singleton
 	gcoi = coi
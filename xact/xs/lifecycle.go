@@ -0,0 +1,176 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Lifecycle evaluates bucket lifecycle rules (cmn.LifecycleConf) against all
+// locally stored objects:
+// - expired objects get deleted (including remote backend, if any);
+// - cold remote objects get evicted;
+// - stale multipart uploads get aborted (by the target - see xreg.LcyArgs).
+// Started periodically by each target (for every bucket with active lifecycle)
+// and on demand via api.StartXaction.
+
+type (
+	lcyFactory struct {
+		xreg.RenewBase
+		xctn *XactLifecycle
+	}
+	XactLifecycle struct {
+		args    *xreg.LcyArgs
+		rules   []cmn.LifecycleRule
+		now     time.Time
+		expired atomic.Int64
+		evicted atomic.Int64
+		aborted atomic.Int64
+		xact.BckJog
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactLifecycle)(nil)
+	_ xreg.Renewable = (*lcyFactory)(nil)
+)
+
+////////////////
+// lcyFactory //
+////////////////
+
+func (*lcyFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &lcyFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *lcyFactory) Start() error {
+	args, _ := p.Args.Custom.(*xreg.LcyArgs)
+	if args == nil {
+		args = &xreg.LcyArgs{}
+	}
+	p.xctn = newXactLifecycle(p.UUID(), p.Bck, args)
+	return nil
+}
+
+func (*lcyFactory) Kind() string     { return apc.ActLifecycle }
+func (p *lcyFactory) Get() core.Xact { return p.xctn }
+
+func (*lcyFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprUse, cmn.NewErrXactUsePrev(prevEntry.Get().String())
+}
+
+///////////////////
+// XactLifecycle //
+///////////////////
+
+func newXactLifecycle(uuid string, bck *meta.Bck, args *xreg.LcyArgs) (r *XactLifecycle) {
+	r = &XactLifecycle{args: args, now: time.Now()}
+
+	// (a snapshot of the rules at the start time)
+	r.rules = append(r.rules, bck.Props.Lifecycle.Rules...)
+
+	mpopts := &mpather.JgroupOpts{
+		Parent:   r,
+		CTs:      []string{fs.ObjCT},
+		VisitObj: r.visit,
+		DoLoad:   mpather.Load,
+		RW:       true,
+	}
+	mpopts.Bck.Copy(bck.Bucket())
+	r.BckJog.Init(uuid, apc.ActLifecycle, bck, mpopts, cmn.GCO.Get())
+	return r
+}
+
+func (r *XactLifecycle) Run(wg *sync.WaitGroup) {
+	if wg != nil {
+		wg.Done()
+	}
+	nlog.Infoln(r.Name(), "rules:", len(r.rules))
+
+	if r.args.AbortMpt != nil {
+		if n := r.args.AbortMpt(r.Bck(), r.now); n > 0 {
+			r.aborted.Add(int64(n))
+		}
+	}
+	r.BckJog.Run()
+	if err := r.BckJog.Wait(); err != nil && !r.IsAborted() {
+		r.AddErr(err)
+	}
+	nlog.Infoln(r.Name(), "finished:", r.CtlMsg())
+	r.Finish()
+}
+
+func (r *XactLifecycle) visit(lom *core.LOM, _ []byte) error {
+	var expire, evict bool
+	for i := range r.rules {
+		rule := &r.rules[i]
+		if !rule.Match(lom.ObjName) {
+			continue
+		}
+		if rule.Expiration > 0 && !expire {
+			if mtime, err := lom.LastModified(); err == nil && r.now.Sub(mtime) > rule.Expiration.D() {
+				expire = true
+				break // (takes precedence)
+			}
+		}
+		if rule.Evict > 0 && !evict && lom.Bck().IsRemote() {
+			if r.now.Sub(lom.Atime()) > rule.Evict.D() {
+				evict = true
+			}
+		}
+	}
+	if !expire && !evict {
+		return nil
+	}
+
+	size := lom.Lsize()
+	ecode, err := core.T.DeleteObject(lom, !expire /*evict*/)
+	switch {
+	case err == nil:
+		r.ObjsAdd(1, size)
+		if expire {
+			r.expired.Inc()
+		} else {
+			r.evicted.Inc()
+		}
+	case cos.IsNotExist(err, ecode) || cmn.IsErrObjNought(err):
+		// (benign race)
+	case cmn.IsErrObjLocked(err):
+		// (retention in effect and/or legal hold)
+	default:
+		r.AddErr(err, 4, cos.ModXs)
+	}
+	return nil
+}
+
+func (r *XactLifecycle) CtlMsg() string {
+	var sb cos.SB
+	sb.Init(64)
+	sb.WriteString("expired:")
+	sb.WriteString(strconv.FormatInt(r.expired.Load(), 10))
+	sb.WriteString(", evicted:")
+	sb.WriteString(strconv.FormatInt(r.evicted.Load(), 10))
+	sb.WriteString(", aborted-mpt:")
+	sb.WriteString(strconv.FormatInt(r.aborted.Load(), 10))
+	return sb.String()
+}
+
+func (r *XactLifecycle) Snap() *core.Snap { return r.Base.NewSnap(r) }
@@ -26,6 +26,9 @@ import (
 //   1. bash-extension style: `file-{0..100}`
 //   2. at-style: `file-@100`
 //   3. if none of the above, fall back to just prefix matching
+//
+// Optionally, selected objects are further filtered by tags (apc.ListRange.Tags):
+// tags are in-cluster metadata, and so selection by tags never lists remote backends.
 
 // TODO:
 // - user-assigned (configurable) num-workers
@@ -87,6 +90,9 @@ func (r *lrit) init(xctn cos.Stopper, msg *apc.ListRange, bck *meta.Bck, lsflags
 	r.bck = bck
 	r.lsflags = lsflags
 
+	if err := cmn.ValidateTagSelectors(msg.Tags); err != nil {
+		return err
+	}
 	if msg.IsList() {
 		debug.Assert(lsflags == 0, "not expecting 'lsflags' with list iterator: ", lsflags)
 		r.lrp = lrpList
@@ -262,7 +268,7 @@ func (r *lrit) _prefix(wi lrwi, smap *meta.Smap) error {
 		lst     *cmn.LsoRes
 		lsmsg   = &apc.LsoMsg{Prefix: r.prefix, Props: apc.GetPropsStatus, Flags: r.lsflags | apc.LsNoDirs}
 		npg     = newNpgCtx(r.bck, lsmsg, noopCb, nil, nil /*bp: see below*/)
-		bremote = r.bck.IsRemote()
+		bremote = r.bck.IsRemote() && !r.msg.HasTags() // (see "filtered by tags" above)
 	)
 	if err := r.bck.Init(core.T.Bowner()); err != nil {
 		return err
@@ -344,6 +350,9 @@ func (r *lrit) do(lom *core.LOM, wi lrwi, smap *meta.Smap) (bool /*this lom done
 			return true, nil
 		}
 	}
+	if r.msg.HasTags() && !r.matchTags(lom) {
+		return true, nil
+	}
 
 	if r.nwp.workers == nil {
 		wi.do(lom, r, r.buf)
@@ -360,6 +369,14 @@ func (r *lrit) do(lom *core.LOM, wi lrwi, smap *meta.Smap) (bool /*this lom done
 	return false, nil
 }
 
+func (r *lrit) matchTags(lom *core.LOM) bool {
+	if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
+		return false
+	}
+	tags, _ := lom.GetCustomKey(cmn.TagsObjMD)
+	return cmn.MatchTags(tags, r.msg.Tags)
+}
+
 //////////////
 // lrworker //
 //////////////
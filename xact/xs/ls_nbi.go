@@ -53,6 +53,7 @@ type nbiCtx struct {
 	cksum     *cos.CksumHash // chunk header checksum (protection)
 	slab      *memsys.Slab   // see (reusable buffer)
 	bck       *meta.Bck      // source bucket
+	flt       *lsoFilter     // optional list-objects filter
 	prevToken string         // responded with prev. nextPage() call
 	hdr       nbiChunkHdr    // current chunk header
 	entries   cmn.LsoEntries // decoded from the current chunk
@@ -373,12 +374,16 @@ func (nbi *nbiCtx) nextPage(msg *apc.LsoMsg, lst *cmn.LsoRes) error {
 				continue
 			}
 			nbi.nidx++
-			lst.Entries = append(lst.Entries, out)
+			if nbi.flt == nil || nbi.flt.matchEntry(out) {
+				lst.Entries = append(lst.Entries, out)
+			}
 			continue
 		}
 		out := nbi.entries[nbi.nidx]
 		nbi.nidx++ // next
-		lst.Entries = append(lst.Entries, out)
+		if nbi.flt == nil || nbi.flt.matchEntry(out) {
+			lst.Entries = append(lst.Entries, out)
+		}
 	}
 
 	if l := len(lst.Entries); l > 0 {
@@ -52,6 +52,7 @@ type (
 	LsoXact struct {
 		s3ctx *core.LsoS3InvCtx // Deprecated: remove by April-May 2026 (use NBI instead)
 		nbi   *nbiCtx           // native bucket inventory
+		flt   *lsoFilter        // optional server-side filter (apc.LsoFilter)
 
 		msg       *apc.LsoMsg      // first message
 		msgCh     chan *apc.LsoMsg // next messages
@@ -132,6 +133,12 @@ func (p *lsoFactory) Start() error {
 		respCh:     make(chan *LsoRsp),     // ditto: one caller-requested page at a time
 	}
 
+	flt, err := newLsoFilter(p.msg)
+	if err != nil {
+		return err
+	}
+	r.flt = flt
+
 	r.stopCh.Init()
 
 	// idle timeout vs delayed next-page request
@@ -181,7 +188,7 @@ func (p *lsoFactory) Start() error {
 		if r.msg.IsFlagSet(apc.LsNBI) {
 			invName := p.hdr.Get(apc.HdrInvName)
 			debug.Assert(invName != "") // checked (or set) by target
-			r.nbi = &nbiCtx{bck: bck}
+			r.nbi = &nbiCtx{bck: bck, flt: flt}
 			if err := r.nbi.init(invName); err != nil {
 				return err
 			}
@@ -565,6 +572,9 @@ ex:
 		r.resetIdle()
 	}
 	r.page = page.Entries
+	if r.flt != nil {
+		r.page = r.flt.page(r.page)
+	}
 	r.nextToken = page.ContinuationToken
 
 	return err
@@ -737,7 +747,7 @@ func (r *LsoXact) shiftLastPage(token string) {
 
 // (compare w/ nextPageA() via lrit)
 func (r *LsoXact) doWalk(msg *apc.LsoMsg) {
-	r.walk.wi = newWalkInfo(msg, r.LomAdd)
+	r.walk.wi = newWalkInfo(msg, r.LomAdd, r.flt)
 	opts := &fs.WalkBckOpts{
 		ValidateCb: r.validateCb,
 		WalkOpts: fs.WalkOpts{
@@ -834,7 +844,7 @@ func (r *LsoXact) cb(fqn string, de fs.DirEntry) error {
 
 	// ls arch
 	// looking only at the file extension - not reading ("detecting") file magic (TODO: add lsmsg flag)
-	archList, err := archive.List(fqn)
+	archList, err := _lsarch(fqn)
 	if err != nil {
 		if archive.IsErrUnknownFileExt(err) {
 			// skip and keep going
@@ -912,3 +922,13 @@ func (r *LsoXact) _recv(hdr *transport.ObjHdr, objReader io.Reader, buf []byte)
 	}
 	return
 }
+
+// list archived files using persistent TAR index, when available (see core/larch.go)
+func _lsarch(fqn string) ([]*archive.Entry, error) {
+	lom := core.AllocLOM("")
+	defer core.FreeLOM(lom)
+	if lom.PreInit(fqn) != nil || lom.PostInit() != nil || lom.Load(false /*cache it*/, false /*locked*/) != nil {
+		return archive.List(fqn)
+	}
+	return lom.ListArch()
+}
@@ -0,0 +1,157 @@
+// Package xs contains most of the supported eXtended actions (xactions) with some
+// exceptions that include certain storage services (mirror, EC) and extensions (downloader, lru).
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"path"
+	"regexp"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+)
+
+// list-objects: evaluate (compiled) apc.LsoFilter
+// - against in-cluster objects (walk), and
+// - against listed entries (remote pages and bucket inventory)
+
+type lsoFilter struct {
+	f      *apc.LsoFilter
+	re     *regexp.Regexp
+	layout string // to parse LsoEnt.Atime
+	md     bool   // requires object metadata (beyond name)
+}
+
+func newLsoFilter(msg *apc.LsoMsg) (*lsoFilter, error) {
+	f := msg.Filter
+	if f == nil || f.IsEmpty() {
+		return nil, nil
+	}
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+	flt := &lsoFilter{f: f, md: f.NeedsMD(), layout: msg.TimeFormat}
+	if flt.layout == "" {
+		flt.layout = time.RFC822
+	}
+	if f.NameRegex != "" {
+		flt.re = regexp.MustCompile(f.NameRegex) // validated above
+	}
+	return flt, nil
+}
+
+func (flt *lsoFilter) matchName(name string) bool {
+	if flt.f.NameGlob != "" {
+		if ok, _ := path.Match(flt.f.NameGlob, name); !ok {
+			return false
+		}
+	}
+	return flt.re == nil || flt.re.MatchString(name)
+}
+
+func (flt *lsoFilter) matchSize(size int64) bool {
+	f := flt.f
+	return size >= f.MinSize && (f.MaxSize == 0 || size <= f.MaxSize)
+}
+
+func inRange(t, after, before int64) bool {
+	return (after == 0 || t > after) && (before == 0 || t < before)
+}
+
+// (name is checked separately - see walkInfo.match)
+func (flt *lsoFilter) matchLOM(lom *core.LOM) bool {
+	f := flt.f
+	if !flt.matchSize(lom.Lsize()) {
+		return false
+	}
+	if f.HasCksum != nil && *f.HasCksum == cos.NoneC(lom.Checksum()) {
+		return false
+	}
+	if f.AtimeAfter != 0 || f.AtimeBefore != 0 {
+		if !inRange(lom.AtimeUnix(), f.AtimeAfter, f.AtimeBefore) {
+			return false
+		}
+	}
+	if f.MtimeAfter != 0 || f.MtimeBefore != 0 {
+		mtime, err := lom.LastModified()
+		if err != nil || !inRange(mtime.UnixNano(), f.MtimeAfter, f.MtimeBefore) {
+			return false
+		}
+	}
+	for k, v := range f.Custom {
+		val, ok := lom.GetCustomKey(k)
+		if !ok || (v != "" && val != v) {
+			return false
+		}
+	}
+	return true
+}
+
+func (flt *lsoFilter) matchEntry(en *cmn.LsoEnt) bool {
+	if en.IsAnyFlagSet(apc.EntryIsDir) {
+		return true
+	}
+	if !flt.matchName(en.Name) {
+		return false
+	}
+	if !flt.md {
+		return true
+	}
+	f := flt.f
+	if !flt.matchSize(en.Size) {
+		return false
+	}
+	if f.HasCksum != nil && *f.HasCksum == (en.Checksum == "") {
+		return false
+	}
+	if f.AtimeAfter != 0 || f.AtimeBefore != 0 || f.MtimeAfter != 0 || f.MtimeBefore != 0 {
+		t, ok := flt.entryTime(en.Atime)
+		if !ok || !inRange(t, f.AtimeAfter, f.AtimeBefore) || !inRange(t, f.MtimeAfter, f.MtimeBefore) {
+			return false
+		}
+	}
+	if len(f.Custom) > 0 {
+		if en.Custom == "" {
+			return false
+		}
+		md := make(cos.StrKVs, 4)
+		cmn.S2CustomMD(md, en.Custom, en.Version)
+		for k, v := range f.Custom {
+			val, ok := md[k]
+			if !ok || (v != "" && val != v) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (flt *lsoFilter) entryTime(s string) (int64, bool) {
+	if s == "" {
+		return 0, false
+	}
+	for _, layout := range []string{flt.layout, time.RFC3339, time.RFC822} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.UnixNano(), true
+		}
+	}
+	return 0, false
+}
+
+// filter page entries in place
+func (flt *lsoFilter) page(entries cmn.LsoEntries) cmn.LsoEntries {
+	var j int
+	for _, en := range entries {
+		if flt.matchEntry(en) {
+			entries[j] = en
+			j++
+		}
+	}
+	clear(entries[j:])
+	return entries[:j]
+}
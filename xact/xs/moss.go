@@ -156,6 +156,7 @@ type (
 			next int
 			mtx  sync.Mutex
 		}
+		shards  map[string]*mossShard // ColocTwo: DT's open shards (see moss_shards.go)
 		stats   moss
 		started int64       // (mono)
 		soft    int         // number of soft errors must be <= maxSoftErrs; see definition above
@@ -177,7 +178,8 @@ type (
 		gmm     *memsys.MMSA
 		smm     *memsys.MMSA
 		bewarm  *work.Pool
-		pending sync.Map // [wid => *basewi]
+		pending sync.Map   // [wid => *basewi]
+		shards  shardCache // ColocTwo: hot shards' indexes (see moss_shards.go)
 		xact.DemandBase
 		activeWG     sync.WaitGroup // when pending
 		lastLog      atomic.Int64   // last log timestamp (sparse)
@@ -332,6 +334,7 @@ func (r *XactMoss) Abort(err error) bool {
 	r.pending.Range(r.cleanup)
 	r.pending.Clear()
 	r.pendingCnt.Store(0)
+	r.shards.clear()
 
 	r.DemandBase.Stop()
 
@@ -394,6 +397,7 @@ func (r *XactMoss) fini(now int64) (d time.Duration) {
 		r.pending.Range(r.cleanup)
 		r.pending.Clear()
 		r.pendingCnt.Store(0)
+		r.shards.clear()
 
 		r.bewarmStop()
 		r.Finish()
@@ -442,6 +446,9 @@ func (r *XactMoss) PrepRx(req *apc.MossReq, config *cmn.Config, smap *meta.Smap,
 
 		// DT's admission control
 		tstats := core.T.StatsUpdater()
+		if adv.MemLoad() >= load.High {
+			r.shards.clear() // (ColocTwo)
+		}
 		if adv.MemLoad() == load.Critical {
 			tstats.Inc(stats.ErrGetBatchCount)
 			err := fmt.Errorf("%s: work item %q rejected due to resource pressure (%s)", r.Name(), wid, adv.String())
@@ -623,7 +630,7 @@ func (r *XactMoss) Send(req *apc.MossReq, smap *meta.Smap, dt *meta.Snode /*DT*/
 		if in.ArchPath == "" {
 			err = r._sendreg(dt, lom, wid, nameInArch, i)
 		} else {
-			err = r._sendarch(dt, lom, wid, nameInArch, in.ArchPath, i)
+			err = r._sendarch(dt, lom, wid, nameInArch, in.ArchPath, i, req.Colocation)
 		}
 		if err != nil {
 			return err
@@ -679,7 +686,7 @@ func (r *XactMoss) regSent(hdr *transport.ObjHdr, _ io.ReadCloser, arg any, err
 	}
 }
 
-func (r *XactMoss) _sendarch(tsi *meta.Snode, lom *core.LOM, wid, nameInArch, archpath string, index int) error {
+func (r *XactMoss) _sendarch(tsi *meta.Snode, lom *core.LOM, wid, nameInArch, archpath string, index int, coloc apc.ColocLevel) error {
 	var (
 		roc     cos.ReadOpenCloser
 		oah     cos.SimpleOAH
@@ -697,7 +704,7 @@ func (r *XactMoss) _sendarch(tsi *meta.Snode, lom *core.LOM, wid, nameInArch, ar
 		mopaque.emsg = err.Error()
 		nameInArch = apc.MossMissingDir + cos.PathSeparator + nameInArch
 	} else {
-		csl, err := lom.NewArchpathReader(lh, archpath, "" /*mime*/)
+		csl, err := r.archReader(lom, lh, archpath, coloc)
 		if err != nil {
 			nameInArch = apc.MossMissingDir + cos.PathSeparator + nameInArch
 			mopaque.missing = true
@@ -946,6 +953,10 @@ func (r *XactMoss) CtlMsg() string {
 	if r.bewarm != nil {
 		sb.WriteString(" bewarm: on")
 	}
+	if n := r.shards.len(); n > 0 {
+		sb.WriteString(" shards: ")
+		sb.WriteString(strconv.Itoa(n))
+	}
 	if wait := tstats.Get(stats.GetBatchRxWaitTotal); wait > 0 {
 		avg := time.Duration(wait / nreq)
 		sb.WriteString(" avg-wait:")
@@ -1451,6 +1462,13 @@ func (wi *basewi) _write(lom *core.LOM, in *apc.MossIn, out *apc.MossOut, nameIn
 		return 0, err
 	}
 
+	// ColocTwo: reuse open shard and its index
+	if in.ArchPath != "" && wi.shards != nil {
+		if sh := wi.shard(lom); sh != nil {
+			return wi._writeShard(sh, lom, in, out, nameInArch)
+		}
+	}
+
 	lmfh, err := lom.Open()
 	if err != nil {
 		if cos.IsNotExist(err) && wi.req.ContinueOnErr {
@@ -1531,6 +1549,10 @@ func (wi *basewi) asm() error {
 		adv = newAdvice(wi.config)
 		l   = len(wi.req.In)
 	)
+	if wi.req.Colocation >= apc.ColocTwo {
+		wi.initShards()
+		defer wi.closeShards()
+	}
 	for i := 0; i < l; {
 		if r.IsAborted() || r.IsDone() {
 			return nil
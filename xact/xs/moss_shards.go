@@ -0,0 +1,281 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+)
+
+/* ---------------------------------------------------------------------------------------------------------
+   apc.ColocTwo (shard-aware GetBatch)
+
+   Typical ML request: thousands of archived files (`archpath`s) from a handful of large TAR shards.
+   Without colocation, each entry re-opens its shard and scans it header by header.
+
+   With ColocTwo:
+   - x-moss keeps an in-memory index (archived filename => data offset and size) of hot shards,
+     shared across work items and bounded by the total number of indexed files (LRU);
+   - DT: requested archpaths are grouped by (local) shard at the start of each work item;
+     the shard gets opened once and the handle is reused across all the work item's archpaths
+     (and closed right after the last one);
+   - senders: same index, one handle per archpath (the latter is sent asynchronously).
+
+   Only plain (neither compressed nor encrypted), non-chunked TARs get indexed; the index is
+   validated against the shard's (mtime, size); all other formats fall back to sequential scan.
+   Shards named *.tar additionally use (or build) the persistent on-disk index - see core/larch.go.
+------------------------------------------------------------------------------------------------------------ */
+
+const (
+	mossShardsMaxFiles = 512 * 1024 // max total number of indexed archived files (all shards)
+)
+
+type (
+	// cached shard index
+	shardIdx struct {
+		tidx  *archive.TarIndex // nil: not a TAR (or cannot be indexed)
+		fqn   string
+		mtime int64
+		size  int64
+	}
+	// LRU-bounded cache of shard indexes (one per x-moss)
+	shardCache struct {
+		m    map[string]*list.Element // [FQN => shardIdx]
+		lru  list.List                // front: most recently used
+		nfil int                      // total indexed files
+		mu   sync.Mutex
+	}
+	// work item's open shard
+	mossShard struct {
+		lh    cos.LomReader
+		tidx  *archive.TarIndex
+		mtime int64
+		size  int64
+		refs  int // remaining (not yet read) archpaths
+	}
+)
+
+////////////////
+// shardCache //
+////////////////
+
+func (c *shardCache) get(fqn string, mtime, size int64) (*shardIdx, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.m[fqn]
+	if !ok {
+		return nil, false
+	}
+	sidx := el.Value.(*shardIdx)
+	if sidx.mtime != mtime || sidx.size != size {
+		c._del(el) // overwritten or appended
+		return nil, false
+	}
+	c.lru.MoveToFront(el)
+	return sidx, true
+}
+
+func (c *shardCache) put(sidx *shardIdx) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.m == nil {
+		c.m = make(map[string]*list.Element, 16)
+	}
+	if el, ok := c.m[sidx.fqn]; ok {
+		c._del(el)
+	}
+	c.m[sidx.fqn] = c.lru.PushFront(sidx)
+	c.nfil += sidx.nfil()
+
+	// evict least recently used (keeping the one just added)
+	for c.nfil > mossShardsMaxFiles && c.lru.Len() > 1 {
+		c._del(c.lru.Back())
+	}
+}
+
+func (c *shardCache) _del(el *list.Element) {
+	sidx := c.lru.Remove(el).(*shardIdx)
+	delete(c.m, sidx.fqn)
+	c.nfil -= sidx.nfil()
+}
+
+func (c *shardCache) len() int {
+	c.mu.Lock()
+	l := c.lru.Len()
+	c.mu.Unlock()
+	return l
+}
+
+func (c *shardCache) clear() {
+	c.mu.Lock()
+	clear(c.m)
+	c.lru.Init()
+	c.nfil = 0
+	c.mu.Unlock()
+}
+
+func (sidx *shardIdx) nfil() int {
+	if sidx.tidx == nil {
+		return 1
+	}
+	return max(sidx.tidx.Len(), 1)
+}
+
+//////////////
+// XactMoss //
+//////////////
+
+// (under rlock) returns shard's (mtime, size), or false when it cannot be indexed
+func _shardStat(lom *core.LOM) (mtime, size int64, ok bool) {
+	if !lom.IsPlain() || lom.IsChunked() {
+		return 0, 0, false
+	}
+	size, _, mt, err := lom.Fstat(false /*get-atime*/)
+	if err != nil {
+		return 0, 0, false
+	}
+	return mt.UnixNano(), size, true
+}
+
+// (under rlock) get or build shard index; returns nil if the shard is not an (indexable) TAR
+func (r *XactMoss) shardIndex(lom *core.LOM, lh cos.LomReader, mtime, size int64) *archive.TarIndex {
+	if sidx, ok := r.shards.get(lom.FQN, mtime, size); ok {
+		return sidx.tidx
+	}
+	sidx := &shardIdx{fqn: lom.FQN, mtime: mtime, size: size}
+	mime, err := archive.MimeFile(lh, r.smm, "", lom.ObjName)
+	if err == nil && mime == archive.ExtTar {
+		// persistent index (see core/larch.go), if possible; otherwise, in-memory only
+		if sidx.tidx = lom.ArchIdx(lh, mime); sidx.tidx == nil {
+			sidx.tidx, err = archive.IndexTar(lh, size)
+		}
+	}
+	if err != nil && cmn.Rom.V(4, cos.ModXs) {
+		nlog.Warningln(r.Name(), "failed to index", lom.Cname(), "- falling back to sequential scan:", err)
+	}
+	r.shards.put(sidx)
+	return sidx.tidx
+}
+
+// open archived file (under rlock):
+// ColocTwo - use (or build) shard index, if possible; otherwise, scan the shard
+func (r *XactMoss) archReader(lom *core.LOM, lh cos.LomReader, archpath string, coloc apc.ColocLevel) (cos.ReadCloseSizer, error) {
+	if coloc >= apc.ColocTwo {
+		if mtime, size, ok := _shardStat(lom); ok {
+			if tidx := r.shardIndex(lom, lh, mtime, size); tidx != nil {
+				return _readIdx(lom, lh, tidx, archpath)
+			}
+		}
+	}
+	return lom.NewArchpathReader(lh, archpath, "" /*mime*/)
+}
+
+func _readIdx(lom *core.LOM, lh cos.LomReader, tidx *archive.TarIndex, archpath string) (cos.ReadCloseSizer, error) {
+	if err := cos.ValidateArchpath(archpath); err != nil {
+		return nil, err
+	}
+	csl := tidx.ReadOne(lh, archpath)
+	if csl == nil {
+		return nil, cos.NewErrNotFound(core.T, archpath+" in "+lom.Cname())
+	}
+	return csl, nil
+}
+
+////////////
+// basewi //
+////////////
+
+// DT: group requested archpaths by local shard
+func (wi *basewi) initShards() {
+	r := wi.r
+	for i := range wi.req.In {
+		in := &wi.req.In[i]
+		if in.ArchPath == "" {
+			continue
+		}
+		lom, tsi, err := r._lom(in, wi.smap)
+		if err != nil || tsi != nil {
+			continue // (handled by next())
+		}
+		if wi.shards == nil {
+			wi.shards = make(map[string]*mossShard, 4)
+		}
+		sh, ok := wi.shards[lom.FQN]
+		if !ok {
+			sh = &mossShard{}
+			wi.shards[lom.FQN] = sh
+		}
+		sh.refs++
+	}
+}
+
+// (under rlock) returns open indexed shard, or nil to fall back to sequential scan
+func (wi *basewi) shard(lom *core.LOM) *mossShard {
+	sh, ok := wi.shards[lom.FQN]
+	if !ok {
+		return nil
+	}
+	mtime, size, ok := _shardStat(lom)
+	if !ok {
+		return nil
+	}
+	if sh.mtime == mtime && sh.size == size && sh.tidx != nil {
+		return sh // reuse
+	}
+	if sh.lh != nil {
+		cos.Close(sh.lh) // (overwritten)
+		sh.lh, sh.tidx = nil, nil
+	}
+	lh, err := lom.Open()
+	if err != nil {
+		return nil
+	}
+	tidx := wi.r.shardIndex(lom, lh, mtime, size)
+	if tidx == nil {
+		cos.Close(lh)
+		return nil
+	}
+	sh.lh, sh.tidx, sh.mtime, sh.size = lh, tidx, mtime, size
+	return sh
+}
+
+// (under rlock)
+func (wi *basewi) _writeShard(sh *mossShard, lom *core.LOM, in *apc.MossIn, out *apc.MossOut, nameInArch string) (size int64, err error) {
+	nameInArch = _withArchpath(nameInArch, in.ArchPath)
+	csl, err := _readIdx(lom, sh.lh, sh.tidx, in.ArchPath)
+	if err != nil {
+		if cos.IsNotExist(err) && wi.req.ContinueOnErr {
+			err = wi.addMissing(err, nameInArch, out)
+		}
+	} else {
+		size = csl.Size()
+		err = wi._txarch(csl, out, nameInArch, size)
+		csl.Close()
+	}
+
+	// done with this shard?
+	if sh.refs--; sh.refs <= 0 {
+		cos.Close(sh.lh)
+		delete(wi.shards, lom.FQN)
+	}
+	return size, err
+}
+
+func (wi *basewi) closeShards() {
+	for _, sh := range wi.shards {
+		if sh.lh != nil {
+			cos.Close(sh.lh)
+		}
+	}
+	wi.shards = nil
+}
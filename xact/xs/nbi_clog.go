@@ -0,0 +1,108 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// NBI change log: per-bucket, per-target record of object names that were PUT, deleted,
+// or renamed since the bucket's latest inventory was started (see XactNBI.Run).
+// - in-memory only; after restart (or overflow) the log is "counting only"
+//   and cannot be used for incremental inventory
+// - last op wins: the merge (see nbi_merge) re-evaluates current state of each changed name
+
+const nbiClogMaxNames = 4 * 1024 * 1024 // beyond which incremental makes no sense
+
+type (
+	nbiClog struct {
+		names map[string]bool // object name => deleted
+		mu    sync.Mutex
+		since int64 // recording since (ns); zero when counting only
+		n     atomic.Int64
+	}
+	nbiChange struct {
+		name    string
+		deleted bool
+	}
+)
+
+var nbiClogs sync.Map // bck.Props.BID => *nbiClog
+
+func NBIPut(lom *core.LOM) { nbiRecord(lom.Bck(), lom.ObjName, false) }
+func NBIDel(lom *core.LOM) { nbiRecord(lom.Bck(), lom.ObjName, true) }
+
+func nbiRecord(bck *meta.Bck, objName string, deleted bool) {
+	if bck.Props == nil || bck.Bucket().IsSystem() {
+		return
+	}
+	v, ok := nbiClogs.Load(bck.Props.BID)
+	if !ok {
+		v, _ = nbiClogs.LoadOrStore(bck.Props.BID, &nbiClog{}) // counting only
+	}
+	clog := v.(*nbiClog)
+	clog.n.Inc()
+	if clog.since == 0 {
+		return
+	}
+	clog.mu.Lock()
+	if clog.names != nil {
+		if len(clog.names) < nbiClogMaxNames {
+			clog.names[objName] = deleted
+		} else {
+			clog.names = nil // overflow
+		}
+	}
+	clog.mu.Unlock()
+}
+
+// (best-effort) number of changes since the latest inventory was started
+func NBIChanges(bck *meta.Bck) int64 {
+	if bck.Props == nil {
+		return 0
+	}
+	if v, ok := nbiClogs.Load(bck.Props.BID); ok {
+		return v.(*nbiClog).n.Load()
+	}
+	return 0
+}
+
+// start recording anew and return the previous log (nil if none)
+func nbiSwapClog(bck *meta.Bck, since int64) *nbiClog {
+	clog := &nbiClog{names: make(map[string]bool, 64), since: since}
+	if prev, loaded := nbiClogs.Swap(bck.Props.BID, clog); loaded {
+		return prev.(*nbiClog)
+	}
+	return nil
+}
+
+// whether the log contains all changes since `started`
+func (clog *nbiClog) complete(started int64) bool {
+	clog.mu.Lock()
+	ok := clog.since != 0 && clog.since <= started && clog.names != nil
+	clog.mu.Unlock()
+	return ok
+}
+
+// sorted changes under a given prefix
+func (clog *nbiClog) sorted(prefix string) []nbiChange {
+	clog.mu.Lock()
+	changes := make([]nbiChange, 0, len(clog.names))
+	for name, deleted := range clog.names {
+		if strings.HasPrefix(name, prefix) {
+			changes = append(changes, nbiChange{name: name, deleted: deleted})
+		}
+	}
+	clog.mu.Unlock()
+	sort.Slice(changes, func(i, j int) bool { return changes[i].name < changes[j].name })
+	return changes
+}
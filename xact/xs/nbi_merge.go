@@ -0,0 +1,183 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Incremental (delta) NBI: merge the local change log (see nbi_clog) into the base
+// inventory's (sorted) chunks to produce a new, self-contained inventory.
+// The base is the latest inventory of the same series; the resulting NBIMeta
+// points at it (Base) and increments its generation (Gen).
+// Each target decides on its own and falls back to full listing when it must.
+
+type nbiMerge struct {
+	r      *XactNBI
+	wi     *walkInfo
+	vals   []cmn.LsoEnt   // backing storage for the current output chunk
+	all    cmn.LsoEntries // current output chunk
+	num    int            // next chunk number (1-based)
+	ntotal int64
+}
+
+// returns nil when incremental is not requested or not possible
+func (r *XactNBI) incrBase(clog *nbiClog, smap *meta.Smap) *apc.NBIInfo {
+	if !r.msg.Incremental {
+		return nil
+	}
+	infos, err := fs.CollectNBI(r.Bck().Bucket())
+	if err != nil {
+		nlog.Warningln(r.Name(), "incremental: failed to collect inventories:", err)
+		return nil
+	}
+	series := make(apc.NBIInfoMap, len(infos))
+	for k, info := range infos {
+		if info.Series == r.msg.Series && info.Name != r.msg.Name {
+			series[k] = info
+		}
+	}
+	var (
+		reason string
+		base   = series.Latest()
+		lsmsg  = &r.msg.LsoMsg
+	)
+	switch {
+	case base == nil:
+		reason = "no base inventory"
+	case base.SmapVer != smap.Version:
+		reason = "cluster map changed"
+	case base.Prefix != lsmsg.Prefix || base.Props != lsmsg.Props || base.Flags != lsmsg.Flags:
+		reason = "base inventory " + base.Name + " was created with different listing parameters"
+	case clog == nil || !clog.complete(base.Started):
+		reason = "incomplete change log (e.g., restart or overflow) since " + base.Name
+	default:
+		return base
+	}
+	nlog.Infoln(r.Name(), "incremental not possible:", reason, "- falling back to full listing")
+	return nil
+}
+
+func (r *XactNBI) merge(base *apc.NBIInfo, clog *nbiClog) (int64, error) {
+	var (
+		changes = clog.sorted(r.msg.Prefix)
+		nbi     = &nbiCtx{bck: r.Bck()}
+		m       = &nbiMerge{
+			r:    r,
+			wi:   newWalkInfo(&r.msg.LsoMsg, noopCb, nil),
+			vals: make([]cmn.LsoEnt, r.msg.NamesPerChunk),
+			all:  make(cmn.LsoEntries, 0, r.msg.NamesPerChunk),
+			num:  1,
+		}
+		ci int
+	)
+	r.base = base
+
+	// r-lock the base for the duration (and note: init reads the first chunk)
+	if err := nbi.init(base.Name); err != nil {
+		return 0, err
+	}
+	defer nbi.cleanup()
+
+	for num := 1; num <= nbi.ufest.Count(); num++ {
+		if r.IsAborted() {
+			return 0, r.AbortErr()
+		}
+		if num > 1 {
+			nbi.chunkNum = num
+			if err := nbi.readChunk(); err != nil {
+				return 0, err
+			}
+		}
+		for _, en := range nbi.entries {
+			for ci < len(changes) && changes[ci].name < en.Name {
+				if err := m.apply(&changes[ci], nil); err != nil {
+					return 0, err
+				}
+				ci++
+			}
+			if ci < len(changes) && changes[ci].name == en.Name {
+				if err := m.apply(&changes[ci], en); err != nil {
+					return 0, err
+				}
+				ci++
+				continue
+			}
+			if err := m.add(en); err != nil {
+				return 0, err
+			}
+		}
+	}
+	for ; ci < len(changes); ci++ {
+		if err := m.apply(&changes[ci], nil); err != nil {
+			return 0, err
+		}
+	}
+	if err := m.flush(); err != nil {
+		return 0, err
+	}
+
+	nlog.Infoln(r.Name(), "incremental: base", base.Name, "gen", base.Gen+1, "changes", len(changes), "total", m.ntotal)
+	return m.ntotal, nil
+}
+
+// apply a single change given the (optional) base entry
+// - PUT: re-evaluate current state
+// - DELETE: drop
+func (m *nbiMerge) apply(ch *nbiChange, en *cmn.LsoEnt) error {
+	if ch.deleted {
+		return nil
+	}
+	bck := m.r.Bck()
+	lom := core.AllocLOM(ch.name)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck); err != nil {
+		return err
+	}
+	if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
+		switch {
+		case cos.IsNotExist(err) && bck.IsAIS():
+			return nil // deleted since
+		case en != nil:
+			return m.add(en) // e.g., evicted remote object: keep what we have
+		default:
+			return m.add(&cmn.LsoEnt{Name: ch.name})
+		}
+	}
+	return m.add(m.wi.ls(lom, apc.LocOK))
+}
+
+func (m *nbiMerge) add(en *cmn.LsoEnt) error {
+	idx := len(m.all)
+	m.vals[idx] = *en // (note: base entries are reused by the decoder)
+	m.all = append(m.all, &m.vals[idx])
+	if len(m.all) < cap(m.all) {
+		return nil
+	}
+	return m.flush()
+}
+
+func (m *nbiMerge) flush() error {
+	n := len(m.all)
+	if n == 0 {
+		return nil
+	}
+	if err := m.r.writeChunk(m.num, m.all); err != nil {
+		return err
+	}
+	m.num++
+	m.ntotal += int64(n)
+	m.r.ObjsAdd(n, 0)
+	clear(m.vals[:n])
+	m.all = m.all[:0]
+	return nil
+}
@@ -36,6 +36,9 @@ func (wi *walkInfo) setWanted(en *cmn.LsoEnt, lom *core.LOM) {
 		switch name {
 		case apc.GetPropsName:
 		case apc.GetPropsStatus:
+			if lom.IsDirtyWB() {
+				en.SetFlag(apc.EntryNotSynced)
+			}
 		case apc.GetPropsCached: // (apc.EntryIsCached)
 
 		case apc.GetPropsSize:
@@ -0,0 +1,552 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Write-back: remote bucket with `write_policy.data` = "delayed"
+//
+// - PUT completes once the object is persisted in-cluster and marked dirty
+//   (core.LOM.IsDirtyWB); the dirty flag is persistent and is the source of truth
+//   (the in-memory queue of this xaction is not) - see ais/tgtobj.go;
+// - XactWB (on-demand, one per bucket per target) writes dirty objects back
+//   to the remote backend, with retries and exponential backoff;
+// - per-object ordering: at most one upload of a given object at any point in time;
+//   PUTs arriving while the object is queued coalesce, while in-flight - requeue;
+//   the flag gets cleared only if the object hasn't been overwritten in the meantime;
+// - backpressure: when the number of pending objects reaches `wbMaxPending`
+//   PUT falls back to synchronous write-through;
+// - flush (XactFlushWB) walks the bucket, (re)queues all dirty objects, and waits
+//   for them to get written back;
+// - re-scan: dirty objects that are not (or no longer) queued - upon restart, abort,
+//   exhausted retries, etc. - get flushed by the target's housekeeping (see WBMark);
+// - rebalance migrates dirty objects along with their dirty flag, whereby the sender
+//   clears its own (see reb/recv.go).
+
+const (
+	wbMaxPending = 4096             // backpressure: max pending objects (per bucket, per target)
+	wbRetries    = 5                // max retries (retriable errors only)
+	wbBackoff    = time.Second      // initial retry backoff (doubles with each retry)
+	wbMaxBackoff = 30 * time.Second // max retry backoff
+	wbThrottle   = 100 * time.Millisecond
+)
+
+var errWBStopped = errors.New("write-back stopped")
+
+// buckets that may have dirty objects not queued for write-back (see WBMark)
+var wbRescan struct {
+	m  map[string]*meta.Bck // by bucket uname
+	mu sync.Mutex
+}
+
+type (
+	wbFactory struct {
+		xreg.RenewBase
+		xctn *XactWB
+	}
+	XactWB struct {
+		bp      core.Backend
+		ctx     context.Context
+		vlabs   map[string]string
+		pending map[string]*wbEntry // by object name
+		workCh  chan string
+		stopCh  cos.StopCh
+		xact.DemandBase
+		wg sync.WaitGroup
+		mu sync.Mutex
+		// stats
+		nretries   atomic.Int64
+		nfailed    atomic.Int64
+		nthrottled atomic.Int64
+		// runtime
+		reserved int // admitted PUTs (see Admit)
+		nworkers int
+		stopped  bool
+	}
+	wbEntry struct {
+		waiters  []*wbWaiter // flush
+		gen      int64       // incremented by each (coalesced) PUT
+		inflight bool
+	}
+	// flush waiter: number of objects remaining to write back (and failed)
+	wbWaiter struct {
+		n    atomic.Int64
+		errs atomic.Int64
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactWB)(nil)
+	_ xreg.Renewable = (*wbFactory)(nil)
+)
+
+///////////////
+// wbFactory //
+///////////////
+
+func (*wbFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &wbFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *wbFactory) Start() error {
+	bck := p.Bck
+	if err := cmn.ValidateRemoteBck(apc.ActWriteBack, bck.Bucket()); err != nil {
+		return err
+	}
+	nw, err := TuneNumWorkers(apc.ActWriteBack, NwpDflt, fs.NumAvail())
+	if err != nil {
+		return err
+	}
+	// target-local generation of a global UUID (compare with mirror.XactPut)
+	var (
+		uname = bck.MakeUname("")
+		l     = cos.PackedStrLen(p.Kind()) + 1 + cos.PackedBytesLen(uname)
+		pack  = cos.NewPacker(nil, l)
+		div   = uint64(xact.IdleDefault)
+		smap  = core.T.Sowner().Get()
+	)
+	pack.WriteString(p.Kind())
+	pack.WriteUint8('|')
+	pack.WriteBytes(uname)
+	beid, _, _ := xreg.GenBEID(div, smap.Version, pack.Bytes())
+	if beid == "" {
+		beid = cos.GenUUID()
+	}
+	r := newXactWB(beid, bck, core.T.Backend(bck), max(nw, NwpMin))
+	p.xctn = r
+
+	go r.Run(nil)
+	return nil
+}
+
+func (*wbFactory) Kind() string     { return apc.ActWriteBack }
+func (p *wbFactory) Get() core.Xact { return p.xctn }
+
+func (*wbFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) { return xreg.WprUse, nil }
+
+////////////
+// XactWB //
+////////////
+
+func newXactWB(id string, bck *meta.Bck, bp core.Backend, nworkers int) *XactWB {
+	r := &XactWB{
+		bp:       bp,
+		pending:  make(map[string]*wbEntry, 64),
+		workCh:   make(chan string, wbMaxPending<<1),
+		nworkers: nworkers,
+	}
+	r.stopCh.Init()
+	r.DemandBase.Init(id, apc.ActWriteBack, bck, xact.IdleDefault)
+	r.vlabs = map[string]string{
+		stats.VlabBucket: bck.Cname(""),
+		stats.VlabXkind:  r.Kind(),
+	}
+	r.ctx = xact.NewCtxVlabs(r.vlabs)
+	return r
+}
+
+func (r *XactWB) Run(*sync.WaitGroup) {
+	nlog.Infoln(r.Name(), "workers:", r.nworkers)
+	for range r.nworkers {
+		r.wg.Add(1)
+		go r.work()
+	}
+	select {
+	case <-r.IdleTimer():
+	case <-r.ChanAbort():
+	}
+	r.stop()
+	r.Finish()
+}
+
+func (r *XactWB) stop() {
+	r.DemandBase.Stop()
+
+	r.mu.Lock()
+	r.stopped = true
+	r.mu.Unlock()
+	r.stopCh.Close()
+	r.wg.Wait()
+
+	// drop (but do not forget - objects remain dirty)
+	var n int
+	r.mu.Lock()
+	for name, e := range r.pending {
+		e.done(errWBStopped)
+		delete(r.pending, name)
+		n++
+	}
+	r.mu.Unlock()
+	for range len(r.workCh) {
+		<-r.workCh
+	}
+	if n > 0 {
+		r.SubPending(n)
+		WBMark(r.Bck())
+		nlog.Warningln(r.Name(), "stopping with", n, "pending object"+cos.Plural(n), "- to be re-scanned")
+	}
+}
+
+// (PUT) admit the object for write-back; returns false when the object must be written through:
+// - the xaction is stopping, or
+// - there are too many pending objects (backpressure)
+// NOTE: the caller must subsequently call either Enqueue or Release
+func (r *XactWB) Admit(objName string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.stopped {
+		return false
+	}
+	if _, ok := r.pending[objName]; !ok && len(r.pending)+r.reserved >= wbMaxPending {
+		r.nthrottled.Inc()
+		return false
+	}
+	r.reserved++
+	r.IncPending()
+	return true
+}
+
+// (PUT) release admission when failing to PUT
+func (r *XactWB) Release() {
+	r.mu.Lock()
+	r.reserved--
+	r.mu.Unlock()
+	r.DecPending()
+}
+
+// (PUT) enqueue admitted and persisted (dirty) object; must be called under the object's wlock
+func (r *XactWB) Enqueue(lom *core.LOM) {
+	debug.Assert(lom.IsDirtyWB(), lom.Cname())
+	r.mu.Lock()
+	r.reserved--
+	r._add(lom.ObjName, nil)
+	r.mu.Unlock()
+}
+
+// (flush) enqueue dirty object and register flush-waiter; blocks while at capacity
+func (r *XactWB) Flush(objName string, w *wbWaiter, abortCh <-chan error) error {
+	for {
+		r.mu.Lock()
+		if r.stopped {
+			r.mu.Unlock()
+			return errWBStopped
+		}
+		if _, ok := r.pending[objName]; ok || len(r.pending)+r.reserved < wbMaxPending {
+			r.IncPending()
+			r._add(objName, w)
+			r.mu.Unlock()
+			return nil
+		}
+		r.mu.Unlock()
+		select {
+		case err := <-abortCh:
+			return err
+		case <-time.After(wbThrottle):
+		}
+	}
+}
+
+// under lock; the caller has already incremented pending
+func (r *XactWB) _add(objName string, w *wbWaiter) {
+	if w != nil {
+		w.n.Inc()
+	}
+	if r.stopped {
+		if w != nil {
+			w.n.Dec()
+			w.errs.Inc()
+		}
+		r.DecPending()
+		return
+	}
+	if e, ok := r.pending[objName]; ok {
+		if w == nil {
+			e.gen++ // coalesce or, if in-flight, requeue (see do)
+		} else {
+			e.waiters = append(e.waiters, w)
+		}
+		r.DecPending() // (one per entry)
+		return
+	}
+	e := &wbEntry{}
+	if w != nil {
+		e.waiters = append(e.waiters, w)
+	}
+	select {
+	case r.workCh <- objName:
+		r.pending[objName] = e
+	default:
+		// (unlikely) remains dirty until re-scanned
+		nlog.Warningln(r.Name(), "work channel full - not queuing", objName)
+		WBMark(r.Bck())
+		e.done(errWBStopped)
+		r.DecPending()
+	}
+}
+
+func (r *XactWB) work() {
+	defer r.wg.Done()
+	for {
+		select {
+		case objName := <-r.workCh:
+			r.do(objName)
+		case <-r.stopCh.Listen():
+			return
+		}
+	}
+}
+
+func (r *XactWB) do(objName string) {
+	r.mu.Lock()
+	e, ok := r.pending[objName]
+	if !ok {
+		r.mu.Unlock()
+		return // (stopping)
+	}
+	debug.Assert(!e.inflight, objName)
+	e.inflight = true
+	gen := e.gen
+	r.mu.Unlock()
+
+	err := r.wback(objName, gen)
+
+	r.mu.Lock()
+	e.inflight = false
+	if e.gen != gen {
+		// overwritten while in flight: write back again
+		if !r.stopped {
+			select {
+			case r.workCh <- objName:
+				r.mu.Unlock()
+				return
+			default:
+			}
+		}
+		err = errWBStopped // (remains dirty)
+		WBMark(r.Bck())
+	}
+	delete(r.pending, objName)
+	r.mu.Unlock()
+
+	e.done(err)
+	r.DecPending()
+}
+
+// upload with retries
+func (r *XactWB) wback(objName string, gen int64) (err error) {
+	var (
+		ecode int
+		sleep = wbBackoff
+		lom   = core.AllocLOM(objName)
+	)
+	defer core.FreeLOM(lom)
+	if err = lom.InitBck(r.Bck()); err != nil {
+		return err
+	}
+	for i := 0; ; i++ {
+		ecode, err = r.upload(lom, gen)
+		if err == nil || i == wbRetries || !wbRetriable(ecode, err) {
+			break
+		}
+		r.nretries.Inc()
+		if cmn.Rom.V(4, cos.ModXs) {
+			nlog.Warningln(r.Name(), "retrying", lom.Cname(), "in", sleep, "[", err, ecode, "]")
+		}
+		select {
+		case <-time.After(sleep):
+		case <-r.stopCh.Listen():
+			return err
+		}
+		sleep = min(sleep<<1, wbMaxBackoff)
+	}
+	if err != nil {
+		r.nfailed.Inc()
+		r.AddErr(err, 4, cos.ModXs)
+		WBMark(r.Bck()) // remains dirty
+	}
+	return err
+}
+
+func wbRetriable(ecode int, err error) bool {
+	switch ecode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return cos.IsErrRetriableConn(err)
+}
+
+// write back the current content under rlock (PUT cannot finalize meanwhile)
+func (r *XactWB) upload(lom *core.LOM, gen int64) (int, error) {
+	lom.Lock(false)
+	if err := lom.Load(true /*cache it*/, true /*locked*/); err != nil {
+		lom.Unlock(false)
+		if cos.IsNotExist(err) || cmn.IsErrObjNought(err) {
+			return 0, nil // deleted or evicted
+		}
+		return 0, err
+	}
+	if !lom.IsDirtyWB() {
+		lom.Unlock(false)
+		return 0, nil // written through or already written back
+	}
+	fh, err := lom.NewHandle(true /*loaded*/)
+	if err != nil {
+		lom.Unlock(false)
+		return 0, err
+	}
+	// private copy: loaded custom metadata may be shared with the LOM cache (see lom.Load)
+	// and must not be modified under rlock - the copy gets updated by backend.PutObj and
+	// then persisted (see clean)
+	lom.SetCustomMD(maps.Clone(lom.GetCustomMD()))
+	remais := lom.Bck().IsRemoteAIS()
+	if !remais {
+		lom.ObjAttrs().DelStdCustom() // backend.PutObj() will set updated values (compare w/ ais/tgtobj putRemote)
+	}
+	started := mono.NanoTime()
+	ecode, err := r.bp.PutObj(r.ctx, fh, lom, nil /*origReq*/)
+	lom.Unlock(false)
+	if err != nil {
+		return ecode, err
+	}
+	if !remais {
+		lom.SetCustomKey(cmn.SourceObjMD, r.bp.Provider())
+	}
+
+	size := lom.Lsize()
+	tstats := core.T.StatsUpdater()
+	tstats.IncWith(r.bp.MetricName(stats.PutCount), r.vlabs)
+	tstats.AddWith(
+		cos.NamedVal64{Name: r.bp.MetricName(stats.PutLatencyTotal), Value: mono.SinceNano(started), VarLabs: r.vlabs},
+		cos.NamedVal64{Name: r.bp.MetricName(stats.PutSize), Value: size, VarLabs: r.vlabs},
+	)
+	r.ObjsAdd(1, size)
+
+	return 0, r.clean(lom, gen)
+}
+
+// under wlock: clear the dirty flag unless the object has been overwritten in the meantime
+// (and update remote metadata that backend.PutObj has set)
+func (r *XactWB) clean(lom *core.LOM, gen int64) error {
+	nlom := core.AllocLOM(lom.ObjName)
+	defer core.FreeLOM(nlom)
+	if err := nlom.InitBck(r.Bck()); err != nil {
+		return err
+	}
+	nlom.Lock(true)
+	defer nlom.Unlock(true)
+
+	r.mu.Lock()
+	e, ok := r.pending[lom.ObjName]
+	regen := ok && e.gen != gen
+	r.mu.Unlock()
+	if regen {
+		return nil
+	}
+	if err := nlom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		if cos.IsNotExist(err) || cmn.IsErrObjNought(err) {
+			return nil
+		}
+		return err
+	}
+	if !nlom.IsDirtyWB() {
+		return nil
+	}
+	nlom.ObjAttrs().CopyVersion(lom.ObjAttrs())
+	nlom.SetCustomMD(lom.GetCustomMD()) // (private copy - see upload)
+	nlom.SetDirtyWB(false)
+	return nlom.PersistMain(nlom.IsChunked())
+}
+
+func (r *XactWB) CtlMsg() string {
+	r.mu.Lock()
+	n := len(r.pending)
+	r.mu.Unlock()
+	var sb cos.SB
+	sb.Init(64)
+	sb.WriteString("pending:")
+	sb.WriteString(strconv.Itoa(n))
+	if a := r.nretries.Load(); a > 0 {
+		sb.WriteString(", retries:")
+		sb.WriteString(strconv.FormatInt(a, 10))
+	}
+	if a := r.nfailed.Load(); a > 0 {
+		sb.WriteString(", failed:")
+		sb.WriteString(strconv.FormatInt(a, 10))
+	}
+	if a := r.nthrottled.Load(); a > 0 {
+		sb.WriteString(", written-through:")
+		sb.WriteString(strconv.FormatInt(a, 10))
+	}
+	return sb.String()
+}
+
+func (r *XactWB) Snap() *core.Snap { return r.Base.NewSnap(r) }
+
+/////////////
+// wbEntry //
+/////////////
+
+func (e *wbEntry) done(err error) {
+	for _, w := range e.waiters {
+		if err != nil {
+			w.errs.Inc()
+		}
+		w.n.Dec()
+	}
+	e.waiters = nil
+}
+
+////////////
+// rescan //
+////////////
+
+// mark bucket for re-scan (and flush) by the target's housekeeping
+func WBMark(bck *meta.Bck) {
+	uname := bck.MakeUname("")
+	wbRescan.mu.Lock()
+	if wbRescan.m == nil {
+		wbRescan.m = make(map[string]*meta.Bck, 4)
+	}
+	if _, ok := wbRescan.m[string(uname)]; !ok {
+		wbRescan.m[string(uname)] = meta.CloneBck(bck.Bucket())
+	}
+	wbRescan.mu.Unlock()
+}
+
+// return and unmark all marked buckets
+func WBMarked() (bcks []*meta.Bck) {
+	wbRescan.mu.Lock()
+	for uname, bck := range wbRescan.m {
+		bcks = append(bcks, bck)
+		delete(wbRescan.m, uname)
+	}
+	wbRescan.mu.Unlock()
+	return bcks
+}
@@ -0,0 +1,167 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// flush write-back: walk the bucket, queue all dirty (not yet written back) objects
+// to the bucket's XactWB, and wait for the latter to write them back (see wback.go)
+
+type (
+	wbfFactory struct {
+		xreg.RenewBase
+		xctn *XactFlushWB
+	}
+	XactFlushWB struct {
+		xwb *XactWB
+		w   wbWaiter
+		xact.BckJog
+		mu sync.Mutex
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactFlushWB)(nil)
+	_ xreg.Renewable = (*wbfFactory)(nil)
+)
+
+////////////////
+// wbfFactory //
+////////////////
+
+func (*wbfFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &wbfFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *wbfFactory) Start() error {
+	if err := cmn.ValidateRemoteBck(apc.ActFlushWB, p.Bck.Bucket()); err != nil {
+		return err
+	}
+	r := &XactFlushWB{}
+	mpopts := &mpather.JgroupOpts{
+		Parent:   r,
+		CTs:      []string{fs.ObjCT},
+		VisitObj: r.visit,
+		RW:       true,
+	}
+	mpopts.Bck.Copy(p.Bck.Bucket())
+	r.BckJog.Init(p.UUID(), apc.ActFlushWB, p.Bck, mpopts, cmn.GCO.Get())
+	p.xctn = r
+
+	go r.Run(nil)
+	return nil
+}
+
+func (*wbfFactory) Kind() string     { return apc.ActFlushWB }
+func (p *wbfFactory) Get() core.Xact { return p.xctn }
+
+func (*wbfFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) { return xreg.WprUse, nil }
+
+/////////////////
+// XactFlushWB //
+/////////////////
+
+func (r *XactFlushWB) Run(*sync.WaitGroup) {
+	nlog.Infoln(r.Name())
+	r.BckJog.Run()
+	err := r.BckJog.Wait()
+	if err == nil {
+		err = r.wait()
+	}
+	if err != nil {
+		r.AddErr(err)
+		WBMark(r.Bck()) // (not walked or not waited for - see XactWB re: the rest)
+	}
+	if n := r.w.errs.Load(); n > 0 {
+		r.AddErr(fmt.Errorf("%s: failed to write back %d object%s", r, n, cos.Plural(int(n))))
+	}
+	r.Finish()
+}
+
+func (r *XactFlushWB) visit(lom *core.LOM, _ []byte) error {
+	if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
+		if !cos.IsNotExist(err) && !cmn.IsErrObjNought(err) {
+			r.AddErr(err, 4, cos.ModXs)
+		}
+		return nil
+	}
+	if lom.IsCopy() || !lom.IsDirtyWB() {
+		return nil
+	}
+	for range 2 {
+		xwb, err := r.renew()
+		if err != nil {
+			return err
+		}
+		if err = xwb.Flush(lom.ObjName, &r.w, r.ChanAbort()); err != errWBStopped {
+			if err == nil {
+				r.ObjsAdd(1, lom.Lsize())
+			}
+			return err
+		}
+	}
+	return errWBStopped
+}
+
+// (re)start write-back if need be
+func (r *XactFlushWB) renew() (*XactWB, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.xwb != nil && !r.xwb.IsDone() {
+		return r.xwb, nil
+	}
+	rns := xreg.RenewWriteBack(r.Bck())
+	if rns.Err != nil {
+		return nil, rns.Err
+	}
+	r.xwb = rns.Entry.Get().(*XactWB)
+	return r.xwb, nil
+}
+
+// wait for all queued objects to get written back (or fail)
+func (r *XactFlushWB) wait() error {
+	for r.w.n.Load() > 0 {
+		select {
+		case errCause := <-r.ChanAbort():
+			return errCause
+		case <-time.After(wbThrottle):
+		}
+	}
+	return nil
+}
+
+func (r *XactFlushWB) CtlMsg() string {
+	nv := r.NumVisits()
+	if nv == 0 {
+		return ""
+	}
+	var sb cos.SB
+	sb.Init(48)
+	sb.WriteString("visited:")
+	sb.WriteString(strconv.FormatInt(nv, 10))
+	sb.WriteString(", remaining:")
+	sb.WriteString(strconv.FormatInt(r.w.n.Load(), 10))
+	return sb.String()
+}
+
+func (r *XactFlushWB) Snap() *core.Snap { return r.Base.NewSnap(r) }
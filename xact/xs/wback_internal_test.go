@@ -0,0 +1,259 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+type (
+	// remote backend that counts uploads, optionally blocks and/or fails them
+	wbBackend struct {
+		core.Backend
+		puts    map[string]int
+		block   map[string]chan struct{} // upload in flight until closed
+		fail    map[string]int           // (non-retriable) error status
+		started chan string
+		mu      sync.Mutex
+	}
+)
+
+func (*wbBackend) Provider() string           { return apc.AWS }
+func (*wbBackend) MetricName(n string) string { return n }
+
+func (be *wbBackend) PutObj(_ context.Context, r io.ReadCloser, lom *core.LOM, _ *http.Request) (int, error) {
+	io.Copy(io.Discard, r)
+	r.Close()
+
+	be.mu.Lock()
+	be.puts[lom.ObjName]++
+	n := be.puts[lom.ObjName]
+	ch := be.block[lom.ObjName]
+	delete(be.block, lom.ObjName) // (blocks once)
+	ecode := be.fail[lom.ObjName]
+	be.mu.Unlock()
+
+	be.started <- lom.ObjName
+	if ch != nil {
+		<-ch
+	}
+	if ecode != 0 {
+		return ecode, errors.New("failed to put " + lom.ObjName)
+	}
+	lom.SetCustomKey(cmn.ETag, "etag-"+strconv.Itoa(n))
+	return 0, nil
+}
+
+func (be *wbBackend) nputs(objName string) int {
+	be.mu.Lock()
+	defer be.mu.Unlock()
+	return be.puts[objName]
+}
+
+func TestMain(m *testing.M) {
+	mpath, err := os.MkdirTemp("", "wback-test")
+	if err != nil {
+		panic(err)
+	}
+	xreg.Init()
+	hk.Init(false)
+	fs.TestNew(mock.NewIOS())
+	if _, err := fs.Add(mpath, "daeID"); err != nil {
+		panic(err)
+	}
+	rc := m.Run()
+	os.RemoveAll(mpath)
+	os.Exit(rc)
+}
+
+func wbSetup(t *testing.T, nworkers int) (*XactWB, *wbBackend) {
+	bck := meta.NewBck("wb-"+cos.GenTie(), apc.AWS, cmn.NsGlobal, &cmn.Bprops{
+		Cksum:       cmn.CksumConf{Type: cos.ChecksumNone},
+		WritePolicy: cmn.WritePolicyConf{Data: apc.WriteDelayed},
+		BID:         0xa7b8c1d2,
+	})
+	core.T = mock.NewTarget(mock.NewBaseBownerMock(bck))
+
+	be := &wbBackend{
+		puts:    make(map[string]int, 4),
+		block:   make(map[string]chan struct{}, 4),
+		fail:    make(map[string]int, 4),
+		started: make(chan string, 64),
+	}
+	var (
+		r  = newXactWB(cos.GenUUID(), bck, be, nworkers)
+		wg sync.WaitGroup
+	)
+	wg.Add(1)
+	go func() {
+		r.Run(nil)
+		wg.Done()
+	}()
+	t.Cleanup(func() {
+		r.Abort(nil)
+		wg.Wait()
+		WBMarked()
+	})
+	return r, be
+}
+
+// create dirty object
+func wbDirty(t *testing.T, r *XactWB, objName string) {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(t, lom.InitBck(r.Bck()))
+	fh, err := cos.CreateFile(lom.FQN)
+	tassert.CheckFatal(t, err)
+	_, err = fh.WriteString(objName)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, fh.Close())
+	lom.SetSize(int64(len(objName)))
+	lom.SetAtimeUnix(time.Now().UnixNano())
+	lom.SetDirtyWB(true)
+	tassert.CheckFatal(t, lom.Persist())
+}
+
+// PUT: admit and enqueue
+func wbPut(t *testing.T, r *XactWB, objName string) {
+	tassert.Fatalf(t, r.Admit(objName), "%s: not admitted", objName)
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(t, lom.InitBck(r.Bck()))
+	tassert.CheckFatal(t, lom.Load(false, false))
+	r.Enqueue(lom)
+}
+
+func wbIsDirty(t *testing.T, r *XactWB, objName string) bool {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(t, lom.InitBck(r.Bck()))
+	lom.UncacheDel()
+	tassert.CheckFatal(t, lom.Load(false, false))
+	return lom.IsDirtyWB()
+}
+
+func wbWaitIdle(t *testing.T, r *XactWB) {
+	for range 500 {
+		r.mu.Lock()
+		n := len(r.pending)
+		r.mu.Unlock()
+		if n == 0 {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("timed out waiting for write-back")
+}
+
+func TestWriteBackCoalesce(t *testing.T) {
+	r, be := wbSetup(t, 1 /*worker*/)
+	wbDirty(t, r, "a")
+	wbDirty(t, r, "b")
+
+	// single worker busy with "a" while "b" gets PUT three times
+	unblock := make(chan struct{})
+	be.block["a"] = unblock
+	wbPut(t, r, "a")
+	<-be.started
+	for range 3 {
+		wbPut(t, r, "b")
+	}
+	close(unblock)
+	wbWaitIdle(t, r)
+
+	tassert.Errorf(t, be.nputs("a") == 1, "a: expected 1 upload, got %d", be.nputs("a"))
+	tassert.Errorf(t, be.nputs("b") == 1, "b: expected 1 (coalesced) upload, got %d", be.nputs("b"))
+	tassert.Errorf(t, !wbIsDirty(t, r, "a") && !wbIsDirty(t, r, "b"), "expected both written back")
+}
+
+func TestWriteBackRequeueInflight(t *testing.T) {
+	r, be := wbSetup(t, 2)
+	wbDirty(t, r, "a")
+
+	// PUT while in flight: must be written back again, and only then cleaned
+	unblock := make(chan struct{})
+	be.block["a"] = unblock
+	wbPut(t, r, "a")
+	<-be.started
+	wbPut(t, r, "a")
+	close(unblock)
+	<-be.started
+	wbWaitIdle(t, r)
+
+	tassert.Errorf(t, be.nputs("a") == 2, "expected 2 uploads, got %d", be.nputs("a"))
+	tassert.Errorf(t, !wbIsDirty(t, r, "a"), "expected written back")
+
+	// (cached) metadata: updated by the last upload
+	lom := core.AllocLOM("a")
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(t, lom.InitBck(r.Bck()))
+	tassert.CheckFatal(t, lom.Load(false, false))
+	etag, _ := lom.GetCustomKey(cmn.ETag)
+	tassert.Errorf(t, etag == "etag-2", "expected etag-2, got %q", etag)
+}
+
+func TestWriteBackBackpressure(t *testing.T) {
+	r, _ := wbSetup(t, 1)
+	for i := range wbMaxPending {
+		tassert.Fatalf(t, r.Admit("o"+strconv.Itoa(i)), "%d: expected admitted", i)
+	}
+	tassert.Errorf(t, !r.Admit("one-too-many"), "expected write-through (backpressure)")
+	tassert.Errorf(t, r.nthrottled.Load() == 1, "expected 1 throttled, got %d", r.nthrottled.Load())
+	r.Release()
+	tassert.Errorf(t, r.Admit("one-more"), "expected admitted upon release")
+	for range wbMaxPending {
+		r.Release()
+	}
+}
+
+func TestWriteBackFlush(t *testing.T) {
+	r, be := wbSetup(t, 2)
+	names := []string{"a", "b", "c", "fail"}
+	for _, name := range names {
+		wbDirty(t, r, name)
+	}
+	be.fail["fail"] = http.StatusForbidden
+
+	var (
+		w       wbWaiter
+		abortCh = make(chan error)
+	)
+	for _, name := range names {
+		tassert.CheckFatal(t, r.Flush(name, &w, abortCh))
+	}
+	for i := 0; w.n.Load() > 0; i++ {
+		tassert.Fatalf(t, i < 500, "timed out waiting for flush")
+		time.Sleep(10 * time.Millisecond)
+	}
+	tassert.Errorf(t, w.errs.Load() == 1, "expected 1 error, got %d", w.errs.Load())
+	for _, name := range names[:3] {
+		tassert.Errorf(t, be.nputs(name) == 1 && !wbIsDirty(t, r, name), "%s: expected written back", name)
+	}
+
+	// remains dirty, to be re-scanned
+	tassert.Errorf(t, wbIsDirty(t, r, "fail"), "expected dirty")
+	marked := WBMarked()
+	tassert.Errorf(t, len(marked) == 1 && marked[0].Equal(r.Bck(), false, false), "expected %s marked for re-scan", r.Bck())
+}
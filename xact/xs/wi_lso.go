@@ -28,6 +28,7 @@ type (
 		smap         *meta.Smap
 		msg          *apc.LsoMsg
 		lomVisitedCb lomVisitedCb
+		flt          *lsoFilter // optional (apc.LsoFilter)
 		custom       cos.StrKVs
 		markerDir    string
 		wanted       cos.BitFlags
@@ -39,10 +40,11 @@ func noopCb(*core.LOM) {}
 func isOK(status uint16) bool { return status == apc.LocOK }
 
 // TODO: `msg.StartAfter`
-func newWalkInfo(msg *apc.LsoMsg, lomVisitedCb lomVisitedCb) (wi *walkInfo) {
+func newWalkInfo(msg *apc.LsoMsg, lomVisitedCb lomVisitedCb, flt *lsoFilter) (wi *walkInfo) {
 	wi = &walkInfo{
 		smap:         core.T.Sowner().Get(),
 		lomVisitedCb: lomVisitedCb,
+		flt:          flt,
 		msg:          msg,
 		wanted:       wanted(msg),
 	}
@@ -84,7 +86,10 @@ func (wi *walkInfo) match(objName string) bool {
 	if wi.msg.Prefix != "" && !cmn.ObjHasPrefix(objName, wi.msg.Prefix) {
 		return false
 	}
-	return wi.msg.ContinuationToken == "" || !cmn.TokenGreaterEQ(wi.msg.ContinuationToken, objName)
+	if wi.msg.ContinuationToken != "" && cmn.TokenGreaterEQ(wi.msg.ContinuationToken, objName) {
+		return false
+	}
+	return wi.flt == nil || wi.flt.matchName(objName)
 }
 
 // new entry to be added to the listed page (note: slow path)
@@ -180,7 +185,7 @@ func (wi *walkInfo) _cb(lom *core.LOM, fqn string) (*cmn.LsoEnt, error) {
 	}
 
 	// [shortcut]: name-only optimizes-out loading md (NOTE: won't show misplaced and copies)
-	if wi.msg.IsFlagSet(apc.LsNameOnly) && !fs.HasPrefixFntl(lom.ObjName) {
+	if wi.msg.IsFlagSet(apc.LsNameOnly) && !fs.HasPrefixFntl(lom.ObjName) && (wi.flt == nil || !wi.flt.md) {
 		if !isOK(status) {
 			return nil, nil
 		}
@@ -194,6 +199,9 @@ func (wi *walkInfo) _cb(lom *core.LOM, fqn string) (*cmn.LsoEnt, error) {
 		}
 		return nil, err
 	}
+	if wi.flt != nil && wi.flt.md && !wi.flt.matchLOM(lom) {
+		return nil, nil
+	}
 	if lom.IsFntl() {
 		// FIXME: revisit
 		status = apc.LocOK